@@ -0,0 +1,153 @@
+package digest
+
+import (
+	"context"
+	"iter"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Result is the digest of one statement handed to DigestChan.
+type Result struct {
+	Digest Digest
+	Err    error
+}
+
+// DigestMany digests sqls on up to GOMAXPROCS goroutines. Both results
+// are in input order: errs[i] is the error for sqls[i], and is ctx.Err()
+// for statements that were not started before ctx was done.
+func (d *Digester) DigestMany(ctx context.Context, sqls []string) ([]Digest, []error) {
+	out := make([]Digest, len(sqls))
+	errs := make([]error, len(sqls))
+
+	var next atomic.Int64
+	work := func() {
+		for {
+			i := int(next.Add(1) - 1)
+			if i >= len(sqls) {
+				return
+			}
+			if err := ctx.Err(); err != nil {
+				errs[i] = err
+				continue
+			}
+			out[i], errs[i] = computeContext(ctx, sqls[i], d.opts)
+		}
+	}
+
+	workers := min(runtime.GOMAXPROCS(0), len(sqls))
+	if workers <= 1 {
+		work()
+		return out, errs
+	}
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			work()
+		}()
+	}
+	wg.Wait()
+	return out, errs
+}
+
+type batchJob struct {
+	sql  string
+	slot chan<- Result
+}
+
+// DigestChan digests the statements received from in on GOMAXPROCS
+// goroutines and sends their results, in the order the statements were
+// received, on the returned channel. The channel is closed once in is
+// closed and every result has been sent, or as soon as ctx is done; check
+// ctx.Err() to tell the two apart. At most about twice GOMAXPROCS
+// statements are in flight at a time.
+func (d *Digester) DigestChan(ctx context.Context, in <-chan string) <-chan Result {
+	workers := runtime.GOMAXPROCS(0)
+	out := make(chan Result, workers)
+	jobs := make(chan batchJob, workers)
+	// pending holds one slot per statement, in input order; the worker
+	// that digests the statement fills it.
+	pending := make(chan chan Result, workers)
+
+	go func() {
+		defer close(jobs)
+		defer close(pending)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case sql, ok := <-in:
+				if !ok {
+					return
+				}
+				slot := make(chan Result, 1)
+				select {
+				case pending <- slot:
+				case <-ctx.Done():
+					return
+				}
+				jobs <- batchJob{sql: sql, slot: slot}
+			}
+		}
+	}()
+
+	for range workers {
+		go func() {
+			for j := range jobs {
+				var r Result
+				r.Digest, r.Err = computeContext(ctx, j.sql, d.opts)
+				j.slot <- r
+			}
+		}()
+	}
+
+	go func() {
+		defer close(out)
+		for slot := range pending {
+			var r Result
+			select {
+			case r = <-slot:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case out <- r:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// DigestSeq is DigestChan for iterators: it yields the digest of every
+// statement of sqls in order. Iteration ends early when ctx is done, and
+// stopping it early stops the workers. sqls is iterated on another
+// goroutine.
+func (d *Digester) DigestSeq(ctx context.Context, sqls iter.Seq[string]) iter.Seq2[Digest, error] {
+	return func(yield func(Digest, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		in := make(chan string)
+		go func() {
+			defer close(in)
+			for sql := range sqls {
+				select {
+				case in <- sql:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		for r := range d.DigestChan(ctx, in) {
+			if !yield(r.Digest, r.Err) {
+				return
+			}
+		}
+	}
+}
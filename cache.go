@@ -0,0 +1,97 @@
+package digest
+
+import (
+	"container/list"
+	"sync"
+)
+
+// CachingDigester is a Digester that remembers the digests of the last
+// size distinct statements, for applications that send the same SQL text
+// over and over. It is safe for concurrent use.
+type CachingDigester struct {
+	d    *Digester
+	size int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List // of *cacheEntry, most recently used first
+	stats   CacheStats
+}
+
+type cacheEntry struct {
+	sql    string
+	digest Digest
+	err    error
+}
+
+// CacheStats counts how a CachingDigester has been used.
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Len       int // statements cached now
+}
+
+// NewCachingDigester returns a CachingDigester that digests with opts and
+// caches up to size statements. A size below 1 is taken as 1.
+func NewCachingDigester(opts Options, size int) *CachingDigester {
+	size = max(size, 1)
+	return &CachingDigester{
+		d:       NewDigester(opts),
+		size:    size,
+		entries: make(map[string]*list.Element, size),
+		lru:     list.New(),
+	}
+}
+
+// Digest returns the digest of sql, from the cache if the same text was
+// digested recently. Errors are cached along with digests. The cache keeps
+// a reference to sql, so it must not be a view of a buffer that is reused.
+func (c *CachingDigester) Digest(sql string) (Digest, error) {
+	c.mu.Lock()
+	if e, ok := c.entries[sql]; ok {
+		c.lru.MoveToFront(e)
+		c.stats.Hits++
+		ce := e.Value.(*cacheEntry)
+		c.mu.Unlock()
+		return ce.digest, ce.err
+	}
+	c.stats.Misses++
+	c.mu.Unlock()
+
+	// Digest outside the lock; two goroutines missing on the same
+	// statement both compute it; the first to finish stores it.
+	d, err := c.d.Digest(sql)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[sql]; ok {
+		c.lru.MoveToFront(e)
+		return d, err
+	}
+	if c.lru.Len() >= c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).sql)
+		c.stats.Evictions++
+	}
+	c.entries[sql] = c.lru.PushFront(&cacheEntry{sql: sql, digest: d, err: err})
+	return d, err
+}
+
+// Stats returns the hit, miss and eviction counts so far.
+func (c *CachingDigester) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.stats
+	s.Len = c.lru.Len()
+	return s
+}
+
+// Purge empties the cache. The counters are kept.
+func (c *CachingDigester) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+	c.lru.Init()
+}
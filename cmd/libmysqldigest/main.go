@@ -0,0 +1,181 @@
+// Command libmysqldigest builds the digest library as a C shared library,
+// for Python, Rust and other non-Go callers:
+//
+//	go build -buildmode=c-shared -o libmysqldigest.so ./cmd/libmysqldigest
+//
+// The build also writes libmysqldigest.h, the header to include. It
+// declares the option and result structs and the two functions:
+//
+//	int mysql_digest_compute(const char *sql, size_t len,
+//	                         const mysql_digest_options *opts,
+//	                         mysql_digest_result *res);
+//	void mysql_digest_free(mysql_digest_result *res);
+//
+// mysql_digest_compute fills res and returns MYSQL_DIGEST_OK, or an
+// error code with res->error set. The strings in res are allocated with
+// malloc and released by mysql_digest_free. A len above max_input_bytes,
+// or above what a Go string can hold, fails with MYSQL_DIGEST_ERR_LIMIT
+// before sql is read.
+package main
+
+/*
+#include <stddef.h>
+#include <stdlib.h>
+
+// Return codes of mysql_digest_compute.
+#define MYSQL_DIGEST_OK            0
+#define MYSQL_DIGEST_ERR_SYNTAX    1  // the statement could not be lexed
+#define MYSQL_DIGEST_ERR_LIMIT     2  // the statement exceeded a limit
+#define MYSQL_DIGEST_ERR_ARGUMENT  3  // a NULL pointer or an unknown version
+
+// Server versions, for mysql_digest_options.version.
+#define MYSQL_DIGEST_MYSQL80 0
+#define MYSQL_DIGEST_MYSQL84 1
+#define MYSQL_DIGEST_MYSQL90 2
+#define MYSQL_DIGEST_MYSQL57 3
+
+// SQL modes that change lexing, for mysql_digest_options.sql_mode.
+#define MYSQL_DIGEST_MODE_NO_BACKSLASH_ESCAPES 1
+#define MYSQL_DIGEST_MODE_ANSI_QUOTES          2
+
+// mysql_digest_options mirrors digest.Options. A zeroed struct, or a NULL
+// pointer, gives the defaults: MySQL 8.0, no SQL modes, no limits.
+typedef struct mysql_digest_options {
+	int version;
+	unsigned int sql_mode;
+	int max_length;       // bytes of digest text kept; 0 for no limit
+	int lenient;          // digest what lexes of a statement with a syntax error
+	int prepared;         // ? is a parameter marker, as for COM_STMT_PREPARE
+	int digest_text;      // the input is a DIGEST_TEXT
+	int max_input_bytes;  // longer statements fail with ERR_LIMIT; 0 for no limit
+	int max_tokens;       // statements with more tokens fail with ERR_LIMIT; 0 for no limit
+} mysql_digest_options;
+
+// mysql_digest_result receives a digest. hash and text are set on
+// success, and also with lenient, where truncated is then 1 and error
+// describes the syntax error. error_line and error_column are 1-based and
+// error_offset is a byte offset, for syntax errors only.
+typedef struct mysql_digest_result {
+	char *hash;
+	char *text;
+	int truncated;
+	char *error;
+	int error_line;
+	int error_column;
+	size_t error_offset;
+} mysql_digest_result;
+
+typedef const char mysql_digest_const_char;
+typedef const mysql_digest_options mysql_digest_const_options;
+*/
+import "C"
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"unsafe"
+
+	digest "github.com/rashiq/mysql-digest"
+)
+
+//export mysql_digest_compute
+func mysql_digest_compute(sql *C.mysql_digest_const_char, n C.size_t, opts *C.mysql_digest_const_options, res *C.mysql_digest_result) C.int {
+	if res == nil {
+		return C.MYSQL_DIGEST_ERR_ARGUMENT
+	}
+	*res = C.mysql_digest_result{}
+	if sql == nil && n > 0 {
+		return setError(res, C.MYSQL_DIGEST_ERR_ARGUMENT, errors.New("sql is NULL"))
+	}
+	opt, err := options(opts)
+	if err != nil {
+		return setError(res, C.MYSQL_DIGEST_ERR_ARGUMENT, err)
+	}
+	if limit := inputLimit(opt); uint64(n) > uint64(limit) {
+		return setError(res, C.MYSQL_DIGEST_ERR_LIMIT, &digest.LimitError{Err: digest.ErrInputTooLarge, Limit: limit})
+	}
+
+	d, err := digest.Compute(strings.Clone(unsafe.String((*byte)(unsafe.Pointer(sql)), int(n))), opt)
+	if err != nil {
+		var limitErr *digest.LimitError
+		if errors.As(err, &limitErr) {
+			return setError(res, C.MYSQL_DIGEST_ERR_LIMIT, err)
+		}
+		return setError(res, C.MYSQL_DIGEST_ERR_SYNTAX, err)
+	}
+	res.hash = C.CString(d.Hash)
+	res.text = C.CString(d.Text)
+	if d.Truncated {
+		res.truncated = 1
+		setError(res, C.MYSQL_DIGEST_OK, d.Err)
+	}
+	return C.MYSQL_DIGEST_OK
+}
+
+//export mysql_digest_free
+func mysql_digest_free(res *C.mysql_digest_result) {
+	if res == nil {
+		return
+	}
+	C.free(unsafe.Pointer(res.hash))
+	C.free(unsafe.Pointer(res.text))
+	C.free(unsafe.Pointer(res.error))
+	*res = C.mysql_digest_result{}
+}
+
+func options(opts *C.mysql_digest_const_options) (digest.Options, error) {
+	var opt digest.Options
+	if opts == nil {
+		return opt, nil
+	}
+	switch opts.version {
+	case C.MYSQL_DIGEST_MYSQL80:
+		opt.Version = digest.MySQL80
+	case C.MYSQL_DIGEST_MYSQL84:
+		opt.Version = digest.MySQL84
+	case C.MYSQL_DIGEST_MYSQL90:
+		opt.Version = digest.MySQL90
+	case C.MYSQL_DIGEST_MYSQL57:
+		opt.Version = digest.MySQL57
+	default:
+		return opt, errors.New("unknown version")
+	}
+	if opts.sql_mode&C.MYSQL_DIGEST_MODE_NO_BACKSLASH_ESCAPES != 0 {
+		opt.SQLMode |= digest.MODE_NO_BACKSLASH_ESCAPES
+	}
+	if opts.sql_mode&C.MYSQL_DIGEST_MODE_ANSI_QUOTES != 0 {
+		opt.SQLMode |= digest.MODE_ANSI_QUOTES
+	}
+	opt.MaxLength = int(opts.max_length)
+	opt.Lenient = opts.lenient != 0
+	opt.Prepared = opts.prepared != 0
+	opt.InputIsDigestText = opts.digest_text != 0
+	opt.MaxInputBytes = int(opts.max_input_bytes)
+	opt.MaxTokens = int(opts.max_tokens)
+	return opt, nil
+}
+
+// inputLimit returns the longest sql opt accepts, so that a longer one is
+// turned down without copying it or converting its length to an int.
+func inputLimit(opt digest.Options) int {
+	if opt.MaxInputBytes > 0 {
+		return opt.MaxInputBytes
+	}
+	return math.MaxInt
+}
+
+// setError stores err in res, with its position for a syntax error, and
+// returns code.
+func setError(res *C.mysql_digest_result, code C.int, err error) C.int {
+	res.error = C.CString(err.Error())
+	var syntaxErr *digest.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		res.error_line = C.int(syntaxErr.Line)
+		res.error_column = C.int(syntaxErr.Column)
+		res.error_offset = C.size_t(syntaxErr.Offset)
+	}
+	return code
+}
+
+func main() {}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	digest "github.com/rashiq/mysql-digest"
+)
+
+// TestABI builds the shared library, compiles testdata/abi.c against the
+// header the build generates with the cgo C compiler, and checks what the
+// program prints against the Go package.
+func TestABI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the shared library")
+	}
+	if runtime.GOOS != "linux" && runtime.GOOS != "darwin" {
+		t.Skipf("c-shared test not set up for %s", runtime.GOOS)
+	}
+	cc := goEnv(t, "CC")
+	if goEnv(t, "CGO_ENABLED") != "1" || cc == "" {
+		t.Skip("cgo is disabled")
+	}
+	if _, err := exec.LookPath(strings.Fields(cc)[0]); err != nil {
+		t.Skipf("no C compiler: %v", err)
+	}
+
+	dir := t.TempDir()
+	run(t, "go", "build", "-buildmode=c-shared", "-o", filepath.Join(dir, "libmysqldigest.so"), ".")
+	abi, err := filepath.Abs("testdata/abi.c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := append(strings.Fields(cc)[1:], "-Wall", "-Werror", "-o", filepath.Join(dir, "abi"), abi,
+		"-I", dir, "-L", dir, "-lmysqldigest", "-Wl,-rpath,"+dir)
+	run(t, strings.Fields(cc)[0], args...)
+	out := run(t, filepath.Join(dir, "abi"))
+
+	syntaxErr := func(sql string, opt digest.Options) string {
+		_, err := digest.Compute(sql, opt)
+		se := err.(*digest.SyntaxError)
+		return fmt.Sprintf("rc=1 hash= text= truncated=0 error=%v line=%d column=%d offset=%d", err, se.Line, se.Column, se.Offset)
+	}
+	limitErr := func(sql string, opt digest.Options) string {
+		_, err := digest.Compute(sql, opt)
+		if _, ok := err.(*digest.LimitError); !ok {
+			t.Fatalf("%q: got %v, want a *LimitError", sql, err)
+		}
+		return fmt.Sprintf("rc=2 hash= text= truncated=0 error=%v line=0 column=0 offset=0", err)
+	}
+	want := []string{
+		line(t, "SELECT * FROM t WHERE id = 42", digest.Options{}),
+		line(t, "SELECT * FROM t WHERE id = 42", digest.Options{Version: digest.MySQL57}),
+		line(t, `SELECT "a" FROM t`, digest.Options{SQLMode: digest.MODE_ANSI_QUOTES}),
+		line(t, "SELECT ?, ?", digest.Options{Prepared: true, Version: digest.MySQL84}),
+		line(t, "SELECT a, b, c FROM t", digest.Options{MaxLength: 10}),
+		line(t, "SELECT 1", digest.Options{}),
+		syntaxErr("SELECT 1,\n 'abc", digest.Options{}),
+		line(t, "SELECT 1,\n 'abc", digest.Options{Lenient: true}),
+		limitErr("SELECT 1", digest.Options{MaxInputBytes: 5}),
+		limitErr("SELECT 1, 2", digest.Options{MaxTokens: 3}),
+		line(t, "SELECT 1", digest.Options{MaxInputBytes: 8, MaxTokens: 2}),
+		fmt.Sprintf("rc=2 hash= text= truncated=0 error=%v line=0 column=0 offset=0", &digest.LimitError{Err: digest.ErrInputTooLarge, Limit: 5}),
+		fmt.Sprintf("rc=2 hash= text= truncated=0 error=%v line=0 column=0 offset=0", &digest.LimitError{Err: digest.ErrInputTooLarge, Limit: math.MaxInt}),
+		"rc=3 hash= text= truncated=0 error=unknown version line=0 column=0 offset=0",
+		line(t, "", digest.Options{}),
+		"null result rc=3",
+	}
+	got := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	if len(got) != len(want) {
+		t.Fatalf("got %d lines, want %d:\n%s", len(got), len(want), out)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("case %d:\n got %s\nwant %s", i, got[i], want[i])
+		}
+	}
+}
+
+// line returns what abi.c prints for a statement that digests.
+func line(t *testing.T, sql string, opt digest.Options) string {
+	t.Helper()
+	d, err := digest.Compute(sql, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	truncated, errText, pos := 0, "", "line=0 column=0 offset=0"
+	if d.Truncated {
+		se := d.Err.(*digest.SyntaxError)
+		truncated, errText = 1, d.Err.Error()
+		pos = fmt.Sprintf("line=%d column=%d offset=%d", se.Line, se.Column, se.Offset)
+	}
+	return fmt.Sprintf("rc=0 hash=%s text=%s truncated=%d error=%s %s", d.Hash, d.Text, truncated, errText, pos)
+}
+
+func goEnv(t *testing.T, name string) string {
+	t.Helper()
+	return strings.TrimSpace(run(t, "go", "env", name))
+}
+
+func run(t *testing.T, name string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command(name, args...)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("%s %s: %v", name, strings.Join(args, " "), err)
+	}
+	return string(out)
+}
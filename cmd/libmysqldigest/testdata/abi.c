@@ -0,0 +1,78 @@
+// abi.c calls libmysqldigest through its generated header and prints one
+// line per case, which main_test.go checks against the Go package.
+#include <stdio.h>
+#include <string.h>
+
+#include "libmysqldigest.h"
+
+static void run(const char *sql, size_t len, const mysql_digest_options *opts) {
+	mysql_digest_result res;
+	int rc = mysql_digest_compute(sql, len, opts, &res);
+	printf("rc=%d hash=%s text=%s truncated=%d error=%s line=%d column=%d offset=%zu\n",
+		rc, res.hash ? res.hash : "", res.text ? res.text : "", res.truncated,
+		res.error ? res.error : "", res.error_line, res.error_column, res.error_offset);
+	mysql_digest_free(&res);
+	mysql_digest_free(&res); // a freed result is zeroed, so this is a no-op
+}
+
+static void run_string(const char *sql, const mysql_digest_options *opts) {
+	run(sql, strlen(sql), opts);
+}
+
+int main(void) {
+	mysql_digest_options opts;
+
+	run_string("SELECT * FROM t WHERE id = 42", NULL);
+
+	memset(&opts, 0, sizeof opts);
+	opts.version = MYSQL_DIGEST_MYSQL57;
+	run_string("SELECT * FROM t WHERE id = 42", &opts);
+
+	memset(&opts, 0, sizeof opts);
+	opts.sql_mode = MYSQL_DIGEST_MODE_ANSI_QUOTES;
+	run_string("SELECT \"a\" FROM t", &opts);
+
+	memset(&opts, 0, sizeof opts);
+	opts.prepared = 1;
+	opts.version = MYSQL_DIGEST_MYSQL84;
+	run_string("SELECT ?, ?", &opts);
+
+	memset(&opts, 0, sizeof opts);
+	opts.max_length = 10;
+	run_string("SELECT a, b, c FROM t", &opts);
+
+	run("SELECT 1; not this", 8, NULL);
+
+	run_string("SELECT 1,\n 'abc", NULL);
+
+	memset(&opts, 0, sizeof opts);
+	opts.lenient = 1;
+	run_string("SELECT 1,\n 'abc", &opts);
+
+	memset(&opts, 0, sizeof opts);
+	opts.max_input_bytes = 5;
+	run_string("SELECT 1", &opts);
+
+	memset(&opts, 0, sizeof opts);
+	opts.max_tokens = 3;
+	run_string("SELECT 1, 2", &opts);
+
+	memset(&opts, 0, sizeof opts);
+	opts.max_input_bytes = 8;
+	opts.max_tokens = 2;
+	run_string("SELECT 1", &opts);
+
+	// Lengths over the limit are turned down before sql is read.
+	memset(&opts, 0, sizeof opts);
+	opts.max_input_bytes = 5;
+	run("SELECT 1", (size_t)-1, &opts);
+	run("SELECT 1", (size_t)-1, NULL);
+
+	memset(&opts, 0, sizeof opts);
+	opts.version = 99;
+	run_string("SELECT 1", &opts);
+
+	run(NULL, 0, NULL);
+	printf("null result rc=%d\n", mysql_digest_compute("SELECT 1", 8, NULL, NULL));
+	return 0;
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	digest "github.com/rashiq/mysql-digest"
+	"github.com/rashiq/mysql-digest/internal/binlog"
+	"github.com/spf13/cobra"
+)
+
+func newBinlogCmd() *cobra.Command {
+	b := &binlogDigester{}
+	var version string
+
+	cmd := &cobra.Command{
+		Use:   "binlog binlog.000001 ...",
+		Short: "Digest the statements in MySQL binary log files",
+		Long: `Read MySQL binary log files and digest the statements in them: those
+of QUERY_EVENTs, logged with binlog_format=STATEMENT or MIXED and for
+DDL, and the original statements of ROWS_QUERY_LOG_EVENTs, logged with
+binlog_format=ROW when binlog_rows_query_log_events is on.
+
+Each statement is printed on a tab separated line: the time it started
+on the source, the file and position of its event, the event type, the
+default database ("-" if none), "ok" or the error code the statement got
+on the source, the digest and the digest text. Statements are digested
+with the sql_mode they ran with, and as the version of the server that
+wrote the file, unless --mysql-version is given. The BEGIN and COMMIT
+events that frame transactions are left out unless --all is given.
+
+Encrypted binary logs, and transactions compressed with
+binlog_transaction_compression, cannot be read. Use "-" to read a file
+from stdin.`,
+		Example: `  mysql-digest binlog /var/lib/mysql/binlog.000042
+  mysql-digest binlog --json binlog.000041 binlog.000042
+  mysqlbinlog --read-from-remote-server --raw -h db binlog.000042 && mysql-digest binlog binlog.000042`,
+		Args:         cobra.MinimumNArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if version != "" {
+				v, err := digest.ParseVersion(version)
+				if err != nil {
+					return err
+				}
+				b.version = &v
+			}
+			w := bufio.NewWriter(os.Stdout)
+			defer w.Flush()
+			b.out = w
+			for _, name := range args {
+				if err := b.readFile(name); err != nil {
+					return err
+				}
+			}
+			if b.compressed > 0 {
+				fmt.Fprintf(os.Stderr, "warning: %d compressed transaction(s) not read\n", b.compressed)
+			}
+			if b.failed > 0 {
+				fmt.Fprintf(os.Stderr, "warning: %d statement(s) did not digest\n", b.failed)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&version, "mysql-version", "", "digest as this server version, e.g. 8.0 or 5.7, instead of the version that wrote the file")
+	cmd.Flags().BoolVar(&b.all, "all", false, "also print the BEGIN and COMMIT statements that frame transactions")
+	cmd.Flags().BoolVar(&b.lenient, "lenient", false, "digest statements with syntax errors up to the error")
+	cmd.Flags().BoolVar(&b.asJSON, "json", false, "output JSON lines")
+	return cmd
+}
+
+// binlogDigester turns binary logs into digested statements.
+type binlogDigester struct {
+	version *digest.MySQLVersion
+	all     bool
+	lenient bool
+	asJSON  bool
+
+	out        io.Writer
+	digesters  map[binlogDigesterKey]*digest.Digester
+	compressed int // transactions not read
+	failed     int // statements that did not digest
+}
+
+type binlogDigesterKey struct {
+	version digest.MySQLVersion
+	sqlMode digest.SQLMode
+}
+
+func (b *binlogDigester) readFile(name string) error {
+	var r io.Reader = os.Stdin
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return fmt.Errorf("reading binary log: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+	br, err := binlog.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	var version digest.MySQLVersion
+	switch v, err := digest.ParseVersion(br.ServerVersion); {
+	case b.version != nil:
+		version = *b.version
+	case err == nil:
+		version = v
+	default:
+		fmt.Fprintf(os.Stderr, "warning: %s: written by %q, digesting as 8.0; see --mysql-version\n", name, br.ServerVersion)
+	}
+
+	for {
+		s, err := br.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			b.compressed += br.Compressed
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if err := b.emit(name, version, s); err != nil {
+			return err
+		}
+	}
+	b.compressed += br.Compressed
+	return nil
+}
+
+// isTransactionControl reports whether sql is one of the statements the
+// server logs around the changes of a transaction.
+func isTransactionControl(sql string) bool {
+	switch strings.ToUpper(strings.TrimSpace(sql)) {
+	case "BEGIN", "COMMIT", "ROLLBACK":
+		return true
+	}
+	return false
+}
+
+func (b *binlogDigester) emit(name string, version digest.MySQLVersion, s binlog.Statement) error {
+	if !b.all && s.Event == binlog.QueryEvent && isTransactionControl(s.SQL) {
+		return nil
+	}
+	k := binlogDigesterKey{version, s.DigestSQLMode()}
+	d := b.digesters[k]
+	if d == nil {
+		if b.digesters == nil {
+			b.digesters = make(map[binlogDigesterKey]*digest.Digester)
+		}
+		d = digest.NewDigester(digest.Options{Version: k.version, SQLMode: k.sqlMode, Lenient: b.lenient})
+		b.digesters[k] = d
+	}
+	dg, err := d.Digest(s.SQL)
+	if err != nil {
+		b.failed++
+		fmt.Fprintf(os.Stderr, "%s:%d: %v\n", name, s.Pos, err)
+		return nil
+	}
+
+	event := "QUERY"
+	if s.Event == binlog.RowsQueryEvent {
+		event = "ROWS_QUERY"
+	}
+	if b.asJSON {
+		out := binlogStatement{
+			Time:       s.Time.UTC().Format(time.RFC3339),
+			File:       name,
+			Pos:        s.Pos,
+			Event:      event,
+			Schema:     s.Schema,
+			ErrorCode:  s.ErrorCode,
+			Digest:     dg.Hash,
+			DigestText: dg.Text,
+		}
+		line, _ := json.Marshal(out)
+		_, err = fmt.Fprintf(b.out, "%s\n", line)
+		return err
+	}
+
+	schema := s.Schema
+	if schema == "" {
+		schema = "-"
+	}
+	status := "ok"
+	if s.ErrorCode != 0 {
+		status = fmt.Sprintf("error %d", s.ErrorCode)
+	}
+	_, err = fmt.Fprintf(b.out, "%s\t%s:%d\t%s\t%s\t%s\t%s\t%s\n",
+		s.Time.UTC().Format(time.RFC3339), name, s.Pos, event, schema, status, dg.Hash, dg.Text)
+	return err
+}
+
+type binlogStatement struct {
+	Time       string `json:"time"`
+	File       string `json:"file"`
+	Pos        int64  `json:"pos"`
+	Event      string `json:"event"`
+	Schema     string `json:"schema"`
+	ErrorCode  uint16 `json:"error_code,omitempty"`
+	Digest     string `json:"digest"`
+	DigestText string `json:"digest_text"`
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	digest "github.com/rashiq/mysql-digest"
+)
+
+// ansiColors are the SGR codes each token class is printed in.
+var ansiColors = map[digest.TokenClass]string{
+	digest.ClassKeyword:     "1;34", // bold blue
+	digest.ClassIdentifier:  "36",   // cyan
+	digest.ClassPlaceholder: "33",   // yellow
+	digest.ClassLiteral:     "32",   // green
+	digest.ClassOperator:    "1",    // bold
+	digest.ClassHint:        "35",   // magenta
+	digest.ClassComment:     "90",   // grey
+}
+
+// useColor decides, from the --color flag, whether SQL and digest text
+// printed to stdout are highlighted. "auto" highlights on a terminal
+// unless NO_COLOR is set.
+func useColor() (bool, error) {
+	switch colorMode {
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	case "auto":
+		if os.Getenv("NO_COLOR") != "" {
+			return false, nil
+		}
+		stat, err := os.Stdout.Stat()
+		return err == nil && stat.Mode()&os.ModeCharDevice != 0, nil
+	}
+	return false, fmt.Errorf("invalid --color %q: want auto, always or never", colorMode)
+}
+
+// colorize returns text with ANSI colors. text is a digest text if
+// digestText is set, and SQL as it was written otherwise, whose literals
+// and comments are highlighted too.
+func colorize(text string, digestText bool) string {
+	var b strings.Builder
+	for _, s := range digest.Highlight(text, digest.Options{InputIsDigestText: digestText}) {
+		code, ok := ansiColors[s.Class]
+		if !ok {
+			b.WriteString(text[s.Start:s.End])
+			continue
+		}
+		fmt.Fprintf(&b, "\x1b[%sm%s\x1b[0m", code, text[s.Start:s.End])
+	}
+	return b.String()
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	digest "github.com/rashiq/mysql-digest"
+	"github.com/spf13/cobra"
+)
+
+func newDiffCmd() *cobra.Command {
+	var (
+		asJSON   bool
+		version  string
+		sqlMode  string
+		prepared bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "diff sql1 sql2",
+		Short: "Explain why two statements have different digests",
+		Long: `Digest two statements, align their reduced token sequences and list the
+tokens that differ, first divergence first, with the usual cause when it is
+a common one: a different alias, IN (...) against = ?, LIMIT ?, ? against
+LIMIT ? OFFSET ?, a versioned comment in only one of them.
+
+Positions count the tokens of the digest text from 1, in the first and the
+second statement. Both are digested as 8.0 with no @@sql_mode, unless
+--mysql-version or --sql-mode is given.`,
+		Example: `  mysql-digest diff "SELECT * FROM t WHERE id IN (1)" "SELECT * FROM t WHERE id = 1"
+  mysql-digest diff --json "SELECT * FROM t LIMIT 1, 2" "SELECT * FROM t LIMIT 2 OFFSET 1"
+  mysql-digest diff --mysql-version 5.7 "SELECT /*!80000 SQL_NO_CACHE */ a FROM t" "SELECT a FROM t"`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := digest.Options{SQLMode: digest.ParseSQLMode(sqlMode), Prepared: prepared}
+			if version != "" {
+				v, err := digest.ParseVersion(version)
+				if err != nil {
+					return err
+				}
+				opts.Version = v
+			}
+			d, err := digest.Diff(args[0], args[1], opts)
+			if err != nil {
+				return err
+			}
+			if !asJSON {
+				fmt.Print(d)
+				return nil
+			}
+			return writeDiffJSON(d)
+		},
+	}
+
+	cmd.Flags().BoolVar(&asJSON, "json", false, "output in JSON format")
+	cmd.Flags().StringVar(&version, "mysql-version", "", "digest as this server version, e.g. 8.0 or 5.7")
+	cmd.Flags().StringVar(&sqlMode, "sql-mode", "", "the @@sql_mode the statements run with, e.g. ANSI_QUOTES")
+	cmd.Flags().BoolVar(&prepared, "prepared", false, "read ? as a parameter marker, as in statements sent with COM_STMT_PREPARE")
+	return cmd
+}
+
+func writeDiffJSON(d *digest.DigestDiff) error {
+	type edit struct {
+		Op     string   `json:"op"`
+		APos   int      `json:"a_pos"`
+		BPos   int      `json:"b_pos"`
+		A      []string `json:"a"`
+		B      []string `json:"b"`
+		ANames []string `json:"a_names"`
+		BNames []string `json:"b_names"`
+		Reason string   `json:"reason,omitempty"`
+	}
+	type side struct {
+		Digest     string   `json:"digest"`
+		DigestText string   `json:"digest_text"`
+		Tokens     []string `json:"tokens"`
+	}
+	out := struct {
+		Same  bool   `json:"same"`
+		A     side   `json:"a"`
+		B     side   `json:"b"`
+		Edits []edit `json:"edits"`
+	}{
+		Same:  d.Same(),
+		A:     side{d.A.Hash, d.A.Text, d.ATokens},
+		B:     side{d.B.Hash, d.B.Text, d.BTokens},
+		Edits: []edit{},
+	}
+	for _, e := range d.Edits {
+		out.Edits = append(out.Edits, edit{e.Op.String(), e.APos + 1, e.BPos + 1, e.A, e.B, e.ANames, e.BNames, e.Reason})
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
@@ -12,11 +12,17 @@ import (
 )
 
 var (
-	sqlInput   string
-	fileInput  string
-	jsonOutput bool
-	textOnly   bool
-	hashOnly   bool
+	sqlInput    string
+	fileInput   string
+	jsonOutput  bool
+	textOnly    bool
+	hashOnly    bool
+	allVersions bool
+	lenient     bool
+	trace       bool
+	prettyWidth int
+	colorMode   string
+	prepared    bool
 )
 
 func main() {
@@ -28,7 +34,11 @@ func main() {
   mysql-digest --sql "SELECT * FROM users WHERE id = 123"
   mysql-digest --file query.sql
   echo "SELECT 1" | mysql-digest
-  mysql-digest "SELECT 1" --json`,
+  mysql-digest "SELECT 1" --json
+  mysql-digest "SELECT 1" --all-versions --hash-only
+  mysql-digest --lenient "SELECT * FROM t WHERE a = 'cut of"
+  mysql-digest --trace "SELECT * FROM t WHERE a IN (1, 2)"
+  mysql-digest --pretty=100 --file report.sql`,
 		Args:         cobra.MaximumNArgs(1),
 		SilenceUsage: true,
 		RunE:         run,
@@ -39,6 +49,20 @@ func main() {
 	cmd.Flags().BoolVar(&jsonOutput, "json", false, "output in JSON format")
 	cmd.Flags().BoolVar(&textOnly, "text-only", false, "output only the normalized text")
 	cmd.Flags().BoolVar(&hashOnly, "hash-only", false, "output only the digest hash")
+	cmd.Flags().BoolVar(&allVersions, "all-versions", false, "output the digest for every supported MySQL version")
+	cmd.Flags().BoolVar(&prepared, "prepared", false, "read ? as a parameter marker, as in statements sent with COM_STMT_PREPARE")
+	cmd.Flags().BoolVar(&lenient, "lenient", false, "digest statements with syntax errors up to the error, as the server does")
+	cmd.Flags().IntVar(&prettyWidth, "pretty", 0, "lay out the digest text over several lines, wrapped at the given width")
+	cmd.Flags().Lookup("pretty").NoOptDefVal = "80"
+	cmd.PersistentFlags().StringVar(&colorMode, "color", "auto", "highlight SQL and digest text: auto (on a terminal), always or never")
+	cmd.Flags().BoolVar(&trace, "trace", false, "print the lexer tokens, reductions and hash records to stderr")
+
+	cmd.AddCommand(newVerifyCmd())
+	cmd.AddCommand(newDiffCmd())
+	cmd.AddCommand(newTokensCmd())
+	cmd.AddCommand(newPcapCmd())
+	cmd.AddCommand(newProxyCmd())
+	cmd.AddCommand(newBinlogCmd())
 
 	if err := cmd.Execute(); err != nil {
 		os.Exit(1)
@@ -51,14 +75,38 @@ func run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	result, err := digest.Compute(sql)
+	opts := digest.Options{Lenient: lenient, Prepared: prepared}
+	if trace {
+		_, events, _ := digest.Trace(sql, opts)
+		if err := writeTrace(os.Stderr, events); err != nil {
+			return err
+		}
+	}
+	if allVersions {
+		results, err := digest.ForVersions(sql, digest.AllVersions, opts)
+		if err != nil {
+			return fmt.Errorf("computing digest: %w", err)
+		}
+		warnTruncated(results[0])
+		return outputVersions(results)
+	}
+
+	result, err := digest.Compute(sql, opts)
 	if err != nil {
 		return fmt.Errorf("computing digest: %w", err)
 	}
 
+	warnTruncated(result)
 	return output(result)
 }
 
+// warnTruncated tells on stderr that a --lenient digest stopped early.
+func warnTruncated(d digest.Digest) {
+	if d.Truncated {
+		fmt.Fprintf(os.Stderr, "warning: digest truncated: %v\n", d.Err)
+	}
+}
+
 func getSQL(args []string) (string, error) {
 	var sql string
 
@@ -97,21 +145,80 @@ func isPipe() bool {
 }
 
 func output(result digest.Digest) error {
-	switch {
-	case jsonOutput:
+	if jsonOutput {
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
-		return enc.Encode(map[string]string{
+		out := map[string]string{
 			"digest":      result.Hash,
 			"digest_text": result.Text,
-		})
+		}
+		if prettyWidth > 0 {
+			out["digest_text_pretty"] = result.Pretty(prettyWidth)
+		}
+		return enc.Encode(out)
+	}
+
+	text := result.Text
+	if prettyWidth > 0 {
+		text = result.Pretty(prettyWidth)
+	}
+	color, err := useColor()
+	if err != nil {
+		return err
+	}
+	if color {
+		text = colorize(text, true)
+	}
+
+	switch {
 	case textOnly:
-		fmt.Println(result.Text)
+		fmt.Println(text)
 	case hashOnly:
 		fmt.Println(result.Hash)
+	case prettyWidth > 0:
+		fmt.Printf("DIGEST: %s\n", result.Hash)
+		fmt.Printf("DIGEST_TEXT:\n%s\n", text)
 	default:
 		fmt.Printf("DIGEST: %s\n", result.Hash)
-		fmt.Printf("DIGEST_TEXT: %s\n", result.Text)
+		fmt.Printf("DIGEST_TEXT: %s\n", text)
+	}
+	return nil
+}
+
+// outputVersions prints one digest per entry of digest.AllVersions. The
+// --hash-only and --text-only forms are tab separated, one version per
+// line, for building mapping tables.
+func outputVersions(results []digest.Digest) error {
+	switch {
+	case jsonOutput:
+		out := make([]map[string]string, len(results))
+		for i, r := range results {
+			out[i] = map[string]string{
+				"version":     digest.AllVersions[i].String(),
+				"digest":      r.Hash,
+				"digest_text": r.Text,
+			}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	case textOnly:
+		for i, r := range results {
+			fmt.Printf("%s\t%s\n", digest.AllVersions[i], r.Text)
+		}
+	case hashOnly:
+		for i, r := range results {
+			fmt.Printf("%s\t%s\n", digest.AllVersions[i], r.Hash)
+		}
+	default:
+		for i, r := range results {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Printf("VERSION: %s\n", digest.AllVersions[i])
+			fmt.Printf("DIGEST: %s\n", r.Hash)
+			fmt.Printf("DIGEST_TEXT: %s\n", r.Text)
+		}
 	}
 	return nil
 }
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"slices"
+	"time"
+
+	digest "github.com/rashiq/mysql-digest"
+	"github.com/rashiq/mysql-digest/internal/mysqlproto"
+	"github.com/rashiq/mysql-digest/internal/pcap"
+	"github.com/spf13/cobra"
+)
+
+func newPcapCmd() *cobra.Command {
+	p := &pcapDigester{}
+	var version, sqlMode string
+
+	cmd := &cobra.Command{
+		Use:   "pcap capture.pcap",
+		Short: "Digest the statements in a tcpdump capture of MySQL traffic",
+		Long: `Read a libpcap capture file, put the TCP streams to the MySQL port back
+together, and digest the statements clients sent with COM_QUERY and
+COM_STMT_PREPARE, the latter in --prepared mode.
+
+Each statement is printed on a tab separated line: the time it was sent,
+the client address, the command, the default database (set by the
+handshake and COM_INIT_DB, "-" if none), the time from the command to the
+end of the server's response, "ok" or the server's error
+code, the digest and the digest text. Connections that switch to TLS or use the compressed protocol are
+skipped. The server version comes from the greeting of connections the
+capture saw open, unless --mysql-version is given.
+
+Capture with, for example: tcpdump -i any -s 0 -w capture.pcap port 3306
+Files in the pcapng format must be converted to pcap first. Use "-" to
+read the capture from stdin.`,
+		Example: `  mysql-digest pcap capture.pcap
+  mysql-digest pcap --json --port 3306 --port 3307 capture.pcap
+  tcpdump -i any -s 0 -w - port 3306 | mysql-digest pcap -`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if version != "" {
+				v, err := digest.ParseVersion(version)
+				if err != nil {
+					return err
+				}
+				p.version = &v
+			}
+			p.sqlMode = digest.ParseSQLMode(sqlMode)
+
+			var r io.Reader = os.Stdin
+			if args[0] != "-" {
+				f, err := os.Open(args[0])
+				if err != nil {
+					return fmt.Errorf("reading capture: %w", err)
+				}
+				defer f.Close()
+				r = f
+			}
+			w := bufio.NewWriter(os.Stdout)
+			defer w.Flush()
+			return p.read(r, w)
+		},
+	}
+
+	cmd.Flags().IntSliceVar(&p.ports, "port", []int{3306}, "MySQL server port; repeat for several")
+	cmd.Flags().StringVar(&version, "mysql-version", "", "digest as this server version, e.g. 8.0 or 5.7, instead of the version in the greeting")
+	cmd.Flags().StringVar(&sqlMode, "sql-mode", "", "the @@sql_mode the statements were run with, e.g. ANSI_QUOTES")
+	cmd.Flags().BoolVar(&p.lenient, "lenient", false, "digest statements with syntax errors up to the error")
+	cmd.Flags().BoolVar(&p.asJSON, "json", false, "output JSON lines")
+	return cmd
+}
+
+// pcapDigester turns a capture into digested statements.
+type pcapDigester struct {
+	ports   []int
+	version *digest.MySQLVersion
+	sqlMode digest.SQLMode
+	lenient bool
+	asJSON  bool
+
+	out      io.Writer
+	err      error // the first write error
+	sessions map[pcap.Flow]*mysqlproto.Session
+	stopped  map[error]int   // connections not decoded, by reason
+	warned   map[string]bool // server versions digested as 8.0
+	failed   int             // statements that did not digest
+}
+
+func (p *pcapDigester) read(r io.Reader, w io.Writer) error {
+	pr, err := pcap.NewReader(r)
+	if err != nil {
+		return err
+	}
+	p.out = w
+	p.sessions = make(map[pcap.Flow]*mysqlproto.Session)
+	p.stopped = make(map[error]int)
+	p.warned = make(map[string]bool)
+
+	a := &pcap.Assembler{
+		Data: func(f pcap.Flow, data []byte, t time.Time) {
+			conn, toServer, ok := p.conn(f)
+			if !ok {
+				return
+			}
+			s := p.sessions[conn]
+			if s == nil {
+				s = &mysqlproto.Session{Emit: func(c mysqlproto.Command) { p.emit(conn, c) }}
+				p.sessions[conn] = s
+			}
+			if toServer {
+				s.Client(data, t)
+			} else {
+				s.Server(data, t)
+			}
+		},
+		Gap: func(f pcap.Flow) {
+			if conn, _, ok := p.conn(f); ok && p.sessions[conn] != nil {
+				p.sessions[conn].Gap()
+			}
+		},
+		Close: func(f pcap.Flow) {
+			conn, _, ok := p.conn(f)
+			if s := p.sessions[conn]; ok && s != nil {
+				s.Close()
+				if s.Err != nil {
+					p.stopped[s.Err]++
+				}
+				delete(p.sessions, conn)
+			}
+		},
+	}
+	for p.err == nil {
+		pkt, err := pr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			a.Flush()
+			return err
+		}
+		if seg, ok := pcap.Decode(pr.LinkType, pkt.Data); ok {
+			a.Add(seg, pkt.Time)
+		}
+	}
+	a.Flush()
+
+	for reason, n := range p.stopped {
+		fmt.Fprintf(os.Stderr, "warning: %d connection(s) not decoded: %v\n", n, reason)
+	}
+	if p.failed > 0 {
+		fmt.Fprintf(os.Stderr, "warning: %d statement(s) did not digest\n", p.failed)
+	}
+	return p.err
+}
+
+// conn returns the client to server direction of the connection f is
+// part of, and whether f is that direction. It is false for connections
+// to other ports.
+func (p *pcapDigester) conn(f pcap.Flow) (conn pcap.Flow, toServer, ok bool) {
+	switch {
+	case p.isServer(f.Dst):
+		return f, true, true
+	case p.isServer(f.Src):
+		return f.Reverse(), false, true
+	}
+	return pcap.Flow{}, false, false
+}
+
+func (p *pcapDigester) isServer(addr netip.AddrPort) bool {
+	return slices.Contains(p.ports, int(addr.Port()))
+}
+
+func (p *pcapDigester) emit(conn pcap.Flow, c mysqlproto.Command) {
+	if c.Type != mysqlproto.ComQuery && c.Type != mysqlproto.ComStmtPrepare || p.err != nil {
+		return
+	}
+	opts := digest.Options{
+		SQLMode:  p.sqlMode,
+		Lenient:  p.lenient,
+		Prepared: c.Type == mysqlproto.ComStmtPrepare,
+	}
+	switch {
+	case p.version != nil:
+		opts.Version = *p.version
+	case c.ServerVersion != "":
+		v, err := digest.ParseVersion(c.ServerVersion)
+		if err == nil {
+			opts.Version = v
+		} else if !p.warned[c.ServerVersion] {
+			p.warned[c.ServerVersion] = true
+			fmt.Fprintf(os.Stderr, "warning: server version %q, digesting as 8.0; see --mysql-version\n", c.ServerVersion)
+		}
+	}
+	d, err := digest.Compute(c.SQL, opts)
+	if err != nil {
+		p.failed++
+		fmt.Fprintf(os.Stderr, "%s %s: %v\n", c.Time.UTC().Format(time.RFC3339Nano), conn.Src, err)
+		return
+	}
+
+	if p.asJSON {
+		out := pcapStatement{
+			Time:       c.Time.UTC().Format(time.RFC3339Nano),
+			Client:     conn.Src.String(),
+			Server:     conn.Dst.String(),
+			Command:    mysqlproto.CommandName(c.Type),
+			Schema:     c.Schema,
+			LatencyUS:  c.Latency.Microseconds(),
+			Digest:     d.Hash,
+			DigestText: d.Text,
+		}
+		if c.Err != nil {
+			out.Error = c.Err.Error()
+		}
+		b, _ := json.Marshal(out)
+		_, p.err = fmt.Fprintf(p.out, "%s\n", b)
+		return
+	}
+
+	schema := c.Schema
+	if schema == "" {
+		schema = "-"
+	}
+	status := "ok"
+	if c.Err != nil {
+		status = fmt.Sprintf("error %d", c.Err.Code)
+	}
+	_, p.err = fmt.Fprintf(p.out, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+		c.Time.UTC().Format(time.RFC3339Nano), conn.Src, mysqlproto.CommandName(c.Type),
+		schema, c.Latency, status, d.Hash, d.Text)
+}
+
+type pcapStatement struct {
+	Time       string `json:"time"`
+	Client     string `json:"client"`
+	Server     string `json:"server"`
+	Command    string `json:"command"`
+	Schema     string `json:"schema"`
+	LatencyUS  int64  `json:"latency_us"`
+	Digest     string `json:"digest"`
+	DigestText string `json:"digest_text"`
+	Error      string `json:"error,omitempty"`
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+
+	digest "github.com/rashiq/mysql-digest"
+	"github.com/rashiq/mysql-digest/internal/proxy"
+	"github.com/spf13/cobra"
+)
+
+func newProxyCmd() *cobra.Command {
+	p := &proxy.Proxy{Stats: &proxy.Stats{}}
+	var listen, httpAddr, version, sqlMode string
+
+	cmd := &cobra.Command{
+		Use:   "proxy --upstream host:port",
+		Short: "Relay MySQL connections to a server and aggregate their statements by digest",
+		Long: `Accept MySQL client connections, relay each to the upstream server
+unchanged, and digest the statements clients send with COM_QUERY,
+COM_STMT_PREPARE and COM_STMT_EXECUTE, the latter two in --prepared mode.
+
+Statements are aggregated by default database and digest: the number of
+executions and errors, and the total, average, minimum and maximum
+latency, from the command to the end of the server's response. The
+aggregates are served as JSON at /digests on the --http address, highest
+total latency first; ?limit=N keeps the first N. Up to --max-digests
+digests are kept; the statements of further ones are aggregated in a
+single entry with an empty digest, as the server does.
+
+Connections that switch to TLS or use the compressed protocol are relayed
+without being digested: have clients connect with --ssl-mode=DISABLED.
+The server version comes from the greeting, unless --mysql-version is
+given.`,
+		Example: `  mysql-digest proxy --upstream db.internal:3306
+  mysql-digest proxy --listen :3307 --upstream 127.0.0.1:3306 --http :9104
+  curl -s 'localhost:9104/digests?limit=10'`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if p.Upstream == "" {
+				return errors.New("--upstream is required")
+			}
+			if version != "" {
+				v, err := digest.ParseVersion(version)
+				if err != nil {
+					return err
+				}
+				p.Version = &v
+			}
+			p.SQLMode = digest.ParseSQLMode(sqlMode)
+			p.ErrorLog = log.New(os.Stderr, "", log.LstdFlags)
+
+			l, err := net.Listen("tcp", listen)
+			if err != nil {
+				return err
+			}
+			mux := http.NewServeMux()
+			mux.Handle("/digests", p.Stats)
+			hl, err := net.Listen("tcp", httpAddr)
+			if err != nil {
+				l.Close()
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "relaying %s to %s, digests at http://%s/digests\n", l.Addr(), p.Upstream, hl.Addr())
+
+			errc := make(chan error, 2)
+			go func() { errc <- http.Serve(hl, mux) }()
+			go func() { errc <- p.Serve(l) }()
+			return <-errc
+		},
+	}
+
+	cmd.Flags().StringVar(&listen, "listen", ":3307", "address to accept MySQL clients on")
+	cmd.Flags().StringVar(&p.Upstream, "upstream", "", "host:port of the MySQL server to relay to")
+	cmd.Flags().StringVar(&httpAddr, "http", "localhost:9104", "address to serve the aggregates on")
+	cmd.Flags().StringVar(&version, "mysql-version", "", "digest as this server version, e.g. 8.0 or 5.7, instead of the version in the greeting")
+	cmd.Flags().StringVar(&sqlMode, "sql-mode", "", "the @@sql_mode the statements run with, e.g. ANSI_QUOTES")
+	cmd.Flags().IntVar(&p.Stats.MaxDigests, "max-digests", proxy.DefaultMaxDigests, "number of digests to aggregate, as performance_schema_digests_size")
+	cmd.Flags().BoolVar(&p.Lenient, "lenient", false, "digest statements with syntax errors up to the error")
+	return cmd
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	digest "github.com/rashiq/mysql-digest"
+	"github.com/spf13/cobra"
+)
+
+func newTokensCmd() *cobra.Command {
+	var asJSON bool
+
+	cmd := &cobra.Command{
+		Use:   "tokens [sql]",
+		Short: "Trace the lexer tokens, reductions and hash input of a digest",
+		Long: `Digest a statement and print every step: each token the lexer returns, with
+its span, text and the lexer states it went through; each token pushed on
+the digest stack, with the 2-byte (4 bytes and the name, for identifiers)
+record it adds to the hash input; and each reduction, with the rule that
+fired and the stack it left.
+
+The statement is printed first, as the spans count its bytes, and the
+steps after it, up to the error for a statement that cannot be lexed.`,
+		Example: `  mysql-digest tokens "SELECT * FROM t WHERE id IN (1, 2)"
+  echo "SELECT -1" | mysql-digest tokens --json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sql, err := getSQL(args)
+			if err != nil {
+				return err
+			}
+			d, events, err := digest.Trace(sql)
+			if asJSON {
+				if jsonErr := writeTraceJSON(os.Stdout, d, events, err); jsonErr != nil {
+					return jsonErr
+				}
+				return err
+			}
+			color, colorErr := useColor()
+			if colorErr != nil {
+				return colorErr
+			}
+			text := d.Text
+			if color {
+				sql, text = colorize(sql, false), colorize(text, true)
+			}
+			fmt.Printf("SQL: %s\n", sql)
+			if writeErr := writeTrace(os.Stdout, events); writeErr != nil {
+				return writeErr
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Printf("DIGEST: %s\n", d.Hash)
+			fmt.Printf("DIGEST_TEXT: %s\n", text)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&asJSON, "json", false, "output in JSON format")
+	return cmd
+}
+
+// writeTrace prints one line per event, in columns: the kind, the token
+// name or reduction rule, the input span or hash record, then the token
+// text and lexer states, or the stack after a reduction.
+func writeTrace(w io.Writer, events []digest.TraceEvent) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, e := range events {
+		switch e.Kind {
+		case digest.TraceLex:
+			states := make([]string, len(e.States))
+			for i, s := range e.States {
+				states[i] = s.String()
+			}
+			fmt.Fprintf(tw, "lex\t%s\t%d-%d\t%q\t%s\n", e.Name, e.Start, e.End, e.Text, strings.Join(states, " > "))
+		case digest.TracePush:
+			fmt.Fprintf(tw, "push\t%s\t%x\n", e.Name, e.Record)
+		case digest.TraceReduce:
+			rule := e.Rule
+			if e.Name != "" {
+				rule += " -> " + e.Name
+			}
+			fmt.Fprintf(tw, "reduce\t%s\t%x\t\t%s\n", rule, e.Record, strings.Join(e.Stack, " "))
+		}
+	}
+	return tw.Flush()
+}
+
+func writeTraceJSON(w io.Writer, d digest.Digest, events []digest.TraceEvent, err error) error {
+	type event struct {
+		Kind   string   `json:"kind"`
+		Type   int      `json:"type,omitempty"`
+		Name   string   `json:"name,omitempty"`
+		Start  *int     `json:"start,omitempty"`
+		End    *int     `json:"end,omitempty"`
+		Text   *string  `json:"text,omitempty"`
+		States []string `json:"states,omitempty"`
+		Rule   string   `json:"rule,omitempty"`
+		Record string   `json:"record,omitempty"`
+		Stack  []string `json:"stack,omitempty"`
+	}
+	out := struct {
+		Digest     string  `json:"digest,omitempty"`
+		DigestText string  `json:"digest_text,omitempty"`
+		Error      string  `json:"error,omitempty"`
+		Events     []event `json:"events"`
+	}{Digest: d.Hash, DigestText: d.Text, Events: make([]event, len(events))}
+	if err != nil {
+		out.Error = err.Error()
+	}
+	for i, e := range events {
+		ev := event{Kind: e.Kind.String(), Type: e.Type, Name: e.Name, Rule: e.Rule, Record: hex.EncodeToString(e.Record)}
+		if e.Kind == digest.TraceLex {
+			ev.Start, ev.End, ev.Text = &e.Start, &e.End, &e.Text
+			for _, s := range e.States {
+				ev.States = append(ev.States, s.String())
+			}
+		} else {
+			ev.Stack = e.Stack
+		}
+		out.Events[i] = ev
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
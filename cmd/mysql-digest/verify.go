@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rashiq/mysql-digest/internal/conformance"
+	"github.com/spf13/cobra"
+)
+
+func newVerifyCmd() *cobra.Command {
+	var verbose bool
+
+	cmd := &cobra.Command{
+		Use:   "verify corpus...",
+		Short: "Check digests against captured performance_schema data",
+		Long: `Check computed digests against DIGEST and DIGEST_TEXT values captured from
+performance_schema.events_statements_history_long.
+
+Each corpus is either JSON lines with version, sql_mode, sql, digest and
+digest_text fields, or mysql --batch output with a header row naming those
+columns. Use "-" to read a corpus from stdin.`,
+		Example: `  mysql-digest verify history.jsonl
+  mysql -B -e "SELECT @@version, @@sql_mode, SQL_TEXT, DIGEST, DIGEST_TEXT FROM performance_schema.events_statements_history_long" | mysql-digest verify -`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var cases []conformance.Case
+			for _, path := range args {
+				c, err := loadCorpus(path)
+				if err != nil {
+					return err
+				}
+				cases = append(cases, c...)
+			}
+
+			report := conformance.Run(cases)
+			if err := report.Write(os.Stdout, verbose); err != nil {
+				return err
+			}
+			if len(report.Mismatches) > 0 {
+				return fmt.Errorf("%d of %d cases did not match", len(report.Mismatches), report.Total)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "list every mismatch instead of one per group")
+	return cmd
+}
+
+func loadCorpus(path string) ([]conformance.Case, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading corpus: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+	return conformance.Load(r, path)
+}
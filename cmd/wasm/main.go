@@ -1,24 +1,44 @@
 //go:build js && wasm
 
+// Command wasm exposes the digest functions to JavaScript as globals:
+// computeDigest, computeMany, tokenize, redact and highlightHTML. They
+// take an options object and return promises that resolve to plain
+// objects, and reject with an Error that carries the details of a syntax
+// or limit error. mysql-digest.d.ts declares them for TypeScript.
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"syscall/js"
 
 	digest "github.com/rashiq/mysql-digest"
 )
 
+// computeDigest(sql, options) resolves to {hash, text, truncated}. Called
+// the old way, with a version number, it returns a JSON string at once.
 func computeDigest(this js.Value, args []js.Value) any {
-	if len(args) < 2 {
-		return `{"error":"missing arguments"}`
+	if len(args) >= 2 && args[1].Type() == js.TypeNumber {
+		return computeDigestJSON(args)
 	}
+	return promise(func() (any, error) {
+		sql, opts, err := sqlArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		d, err := digest.Compute(sql, opts)
+		if err != nil {
+			return nil, err
+		}
+		return digestObject(d), nil
+	})
+}
 
-	sql := args[0].String()
-	version := args[1].Int()
-
-	result, err := digest.Compute(sql, digest.Options{
-		Version: digest.MySQLVersion(version),
+func computeDigestJSON(args []js.Value) any {
+	result, err := digest.Compute(args[0].String(), digest.Options{
+		Version: digest.MySQLVersion(args[1].Int()),
 	})
 
 	if err != nil {
@@ -33,7 +53,238 @@ func computeDigest(this js.Value, args []js.Value) any {
 	return string(b)
 }
 
-func main() {
+// computeMany(sqls, options) resolves to one result per statement, in
+// order: {hash, text, truncated}, or {error} for a statement that failed.
+func computeMany(this js.Value, args []js.Value) any {
+	return promise(func() (any, error) {
+		if len(args) < 1 || args[0].Type() != js.TypeObject || !js.Global().Get("Array").Call("isArray", args[0]).Bool() {
+			return nil, errors.New("computeMany: the first argument must be an array of strings")
+		}
+		opts, err := parseOptions(optionsArg(args, 1))
+		if err != nil {
+			return nil, err
+		}
+		sqls := make([]string, args[0].Length())
+		for i := range sqls {
+			v := args[0].Index(i)
+			if v.Type() != js.TypeString {
+				return nil, fmt.Errorf("computeMany: element %d is not a string", i)
+			}
+			sqls[i] = v.String()
+		}
+
+		digests, errs := digest.NewDigester(opts).DigestMany(context.Background(), sqls)
+		out := make([]any, len(sqls))
+		for i, d := range digests {
+			if errs[i] != nil {
+				out[i] = map[string]any{"error": jsError(errs[i])}
+				continue
+			}
+			out[i] = digestObject(d)
+		}
+		return out, nil
+	})
+}
+
+// tokenize(sql, options) resolves to the lexer tokens of sql, each
+// {type, name, class, start, end, text}. start and end are UTF-8 byte
+// offsets.
+func tokenize(this js.Value, args []js.Value) any {
+	return promise(func() (any, error) {
+		sql, opts, err := sqlArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		_, events, err := digest.Trace(sql, opts)
+		if err != nil {
+			return nil, err
+		}
+		spans := digest.Highlight(sql, opts)
+		var out []any
+		for _, e := range events {
+			if e.Kind != digest.TraceLex || e.Start == len(sql) {
+				continue
+			}
+			for len(spans) > 1 && spans[0].End <= e.Start {
+				spans = spans[1:]
+			}
+			out = append(out, map[string]any{
+				"type":  e.Type,
+				"name":  e.Name,
+				"class": spans[0].Class.String(),
+				"start": e.Start,
+				"end":   e.End,
+				"text":  e.Text,
+			})
+		}
+		return out, nil
+	})
+}
+
+// redact(sql, options) resolves to {text}, sql with its literals replaced.
+// options also takes stripComments, typedPlaceholders and collapseInLists.
+func redact(this js.Value, args []js.Value) any {
+	return promise(func() (any, error) {
+		sql, opts, err := sqlArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		o := optionsArg(args, 1)
+		text, err := digest.Redact(sql, digest.RedactOptions{
+			Options:           opts,
+			StripComments:     boolOption(o, "stripComments"),
+			TypedPlaceholders: boolOption(o, "typedPlaceholders"),
+			CollapseInLists:   boolOption(o, "collapseInLists"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"text": text}, nil
+	})
+}
+
+// highlightHTML returns the SQL, or the digest text when the second
+// argument is true, as HTML with a <span class="sql-..."> per token.
+func highlightHTML(this js.Value, args []js.Value) any {
+	if len(args) < 1 {
+		return ""
+	}
+	digestText := len(args) > 1 && args[1].Truthy()
+	return digest.HTML(args[0].String(), digest.Options{InputIsDigestText: digestText})
+}
+
+// promise runs fn and returns a Promise that resolves to its result or
+// rejects with its error.
+func promise(fn func() (any, error)) js.Value {
+	var executor js.Func
+	executor = js.FuncOf(func(this js.Value, args []js.Value) any {
+		defer executor.Release()
+		resolve, reject := args[0], args[1]
+		result, err := fn()
+		if err != nil {
+			reject.Invoke(jsError(err))
+			return nil
+		}
+		resolve.Invoke(result)
+		return nil
+	})
+	return js.Global().Get("Promise").New(executor)
+}
+
+// jsError converts err to a JavaScript Error. A syntax error also has
+// kind, offset, line, column and snippet, and a limit error has limit.
+func jsError(err error) js.Value {
+	e := js.Global().Get("Error").New(err.Error())
+	e.Set("name", "DigestError")
+	var syntaxErr *digest.SyntaxError
+	var limitErr *digest.LimitError
+	switch {
+	case errors.As(err, &syntaxErr):
+		e.Set("kind", syntaxErr.Kind.String())
+		e.Set("offset", syntaxErr.Offset)
+		e.Set("line", syntaxErr.Line)
+		e.Set("column", syntaxErr.Column)
+		e.Set("snippet", syntaxErr.Snippet)
+	case errors.As(err, &limitErr):
+		e.Set("kind", limitErr.Err.Error())
+		e.Set("limit", limitErr.Limit)
+	}
+	return e
+}
+
+func digestObject(d digest.Digest) map[string]any {
+	out := map[string]any{
+		"hash":      d.Hash,
+		"text":      d.Text,
+		"truncated": d.Truncated,
+	}
+	if d.Err != nil {
+		out["error"] = d.Err.Error()
+	}
+	return out
+}
+
+// sqlArgs reads the (sql, options) arguments most functions take.
+func sqlArgs(args []js.Value) (string, digest.Options, error) {
+	if len(args) < 1 || args[0].Type() != js.TypeString {
+		return "", digest.Options{}, errors.New("the first argument must be the SQL string")
+	}
+	opts, err := parseOptions(optionsArg(args, 1))
+	return args[0].String(), opts, err
+}
+
+func optionsArg(args []js.Value, i int) js.Value {
+	if i < len(args) {
+		return args[i]
+	}
+	return js.Undefined()
+}
+
+// parseOptions reads an options object: version ("8.0" or a
+// MySQLVersion number), sqlMode (an @@sql_mode string), maxLength,
+// prepared, lenient and digestText. undefined and null mean defaults.
+func parseOptions(o js.Value) (digest.Options, error) {
+	var opts digest.Options
+	if o.IsUndefined() || o.IsNull() {
+		return opts, nil
+	}
+	if o.Type() != js.TypeObject {
+		return opts, fmt.Errorf("options must be an object, not %s", o.Type())
+	}
+
+	switch v := o.Get("version"); v.Type() {
+	case js.TypeUndefined, js.TypeNull:
+	case js.TypeNumber:
+		opts.Version = digest.MySQLVersion(v.Int())
+	case js.TypeString:
+		version, err := digest.ParseVersion(v.String())
+		if err != nil {
+			return opts, err
+		}
+		opts.Version = version
+	default:
+		return opts, fmt.Errorf("options.version must be a string or number, not %s", v.Type())
+	}
+
+	switch v := o.Get("sqlMode"); v.Type() {
+	case js.TypeUndefined, js.TypeNull:
+	case js.TypeString:
+		opts.SQLMode = digest.ParseSQLMode(v.String())
+	default:
+		return opts, fmt.Errorf("options.sqlMode must be a string, not %s", v.Type())
+	}
+
+	switch v := o.Get("maxLength"); v.Type() {
+	case js.TypeUndefined, js.TypeNull:
+	case js.TypeNumber:
+		opts.MaxLength = v.Int()
+	default:
+		return opts, fmt.Errorf("options.maxLength must be a number, not %s", v.Type())
+	}
+
+	opts.Prepared = boolOption(o, "prepared")
+	opts.Lenient = boolOption(o, "lenient")
+	opts.InputIsDigestText = boolOption(o, "digestText")
+	return opts, nil
+}
+
+func boolOption(o js.Value, name string) bool {
+	if o.Type() != js.TypeObject {
+		return false
+	}
+	return o.Get(name).Truthy()
+}
+
+// register sets the globals.
+func register() {
 	js.Global().Set("computeDigest", js.FuncOf(computeDigest))
+	js.Global().Set("computeMany", js.FuncOf(computeMany))
+	js.Global().Set("tokenize", js.FuncOf(tokenize))
+	js.Global().Set("redact", js.FuncOf(redact))
+	js.Global().Set("highlightHTML", js.FuncOf(highlightHTML))
+}
+
+func main() {
+	register()
 	select {}
 }
@@ -0,0 +1,219 @@
+//go:build js && wasm
+
+// Run with the wasm_exec.js of the Go distribution on the PATH:
+//
+//	PATH="$(go env GOROOT)/lib/wasm:$PATH" GOOS=js GOARCH=wasm go test ./cmd/wasm
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"syscall/js"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	register()
+	os.Exit(m.Run())
+}
+
+// await waits for the promise p to settle and returns its value, or the
+// error it was rejected with.
+func await(t *testing.T, p js.Value) (value, rejected js.Value) {
+	t.Helper()
+	if p.Type() != js.TypeObject || p.Get("then").Type() != js.TypeFunction {
+		t.Fatalf("got %v, want a promise", p)
+	}
+	type result struct {
+		v  js.Value
+		ok bool
+	}
+	done := make(chan result, 1)
+	then := js.FuncOf(func(this js.Value, args []js.Value) any {
+		done <- result{args[0], true}
+		return nil
+	})
+	catch := js.FuncOf(func(this js.Value, args []js.Value) any {
+		done <- result{args[0], false}
+		return nil
+	})
+	defer then.Release()
+	defer catch.Release()
+	p.Call("then", then, catch)
+	r := <-done
+	if r.ok {
+		return r.v, js.Undefined()
+	}
+	return js.Undefined(), r.v
+}
+
+func call(t *testing.T, fn string, args ...any) js.Value {
+	t.Helper()
+	v, rejected := await(t, js.Global().Call(fn, args...))
+	if !rejected.IsUndefined() {
+		t.Fatalf("%s rejected: %s", fn, rejected.Get("message").String())
+	}
+	return v
+}
+
+func options(o map[string]any) js.Value {
+	return js.ValueOf(o)
+}
+
+func TestComputeDigest(t *testing.T) {
+	v := call(t, "computeDigest", "SELECT * FROM t WHERE id = 42")
+	if got, want := v.Get("text").String(), "SELECT * FROM `t` WHERE `id` = ?"; got != want {
+		t.Errorf("text = %q, want %q", got, want)
+	}
+	if got := v.Get("hash").String(); len(got) != 64 {
+		t.Errorf("hash = %q, want 64 hex digits", got)
+	}
+	if v.Get("truncated").Bool() {
+		t.Error("truncated = true")
+	}
+
+	v = call(t, "computeDigest", "SELECT ?, ?", options(map[string]any{"prepared": true}))
+	if got, want := v.Get("text").String(), "SELECT ?, ..."; got != want {
+		t.Errorf("prepared text = %q, want %q", got, want)
+	}
+
+	v = call(t, "computeDigest", `SELECT "a"`, options(map[string]any{"sqlMode": "ANSI_QUOTES"}))
+	if got, want := v.Get("text").String(), "SELECT `a`"; got != want {
+		t.Errorf("ANSI_QUOTES text = %q, want %q", got, want)
+	}
+
+	v = call(t, "computeDigest", "SELECT a, b, c FROM t", options(map[string]any{"maxLength": 10}))
+	if got, want := v.Get("text").String(), "SELECT `a`..."; got != want {
+		t.Errorf("maxLength 10 text = %q, want %q", got, want)
+	}
+
+	v = call(t, "computeDigest", "SELECT 'x", options(map[string]any{"lenient": true}))
+	if !v.Get("truncated").Bool() || v.Get("error").Type() != js.TypeString {
+		t.Errorf("lenient: truncated = %v, error = %v", v.Get("truncated"), v.Get("error"))
+	}
+
+	v57 := call(t, "computeDigest", "SELECT 1", options(map[string]any{"version": "5.7"}))
+	v80 := call(t, "computeDigest", "SELECT 1", options(map[string]any{"version": "8.0"}))
+	if v57.Get("hash").String() == v80.Get("hash").String() {
+		t.Error("5.7 and 8.0 give the same hash")
+	}
+}
+
+func TestComputeDigest_Legacy(t *testing.T) {
+	v := js.Global().Call("computeDigest", "SELECT 1", 0)
+	if v.Type() != js.TypeString {
+		t.Fatalf("got %v, want a JSON string", v.Type())
+	}
+	var out map[string]string
+	if err := json.Unmarshal([]byte(v.String()), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out["text"] != "SELECT ?" || len(out["hash"]) != 64 {
+		t.Errorf("got %v", out)
+	}
+}
+
+func TestComputeDigest_Errors(t *testing.T) {
+	_, rejected := await(t, js.Global().Call("computeDigest", "SELECT 1,\n 'abc"))
+	if rejected.IsUndefined() {
+		t.Fatal("unterminated string resolved")
+	}
+	if got := rejected.Get("name").String(); got != "DigestError" {
+		t.Errorf("name = %q", got)
+	}
+	if !rejected.InstanceOf(js.Global().Get("Error")) {
+		t.Error("rejected with a value that is not an Error")
+	}
+	if got := rejected.Get("line").Int(); got != 2 {
+		t.Errorf("line = %d, want 2", got)
+	}
+	if got := rejected.Get("column").Int(); got != 2 {
+		t.Errorf("column = %d, want 2", got)
+	}
+	if got := rejected.Get("offset").Int(); got != 11 {
+		t.Errorf("offset = %d, want 11", got)
+	}
+	if rejected.Get("kind").String() == "" {
+		t.Error("no kind")
+	}
+
+	for _, o := range []map[string]any{
+		{"version": "4.1"},
+		{"version": true},
+		{"maxLength": "10"},
+	} {
+		if _, rejected := await(t, js.Global().Call("computeDigest", "SELECT 1", options(o))); rejected.IsUndefined() {
+			t.Errorf("options %v resolved", o)
+		}
+	}
+	if _, rejected := await(t, js.Global().Call("computeDigest", 1)); rejected.IsUndefined() {
+		t.Error("a number as SQL resolved")
+	}
+}
+
+func TestComputeMany(t *testing.T) {
+	v := call(t, "computeMany", js.ValueOf([]any{"SELECT 1", "SELECT 'x", "SELECT 2"}))
+	if got := v.Length(); got != 3 {
+		t.Fatalf("got %d results, want 3", got)
+	}
+	if v.Index(0).Get("hash").String() != v.Index(2).Get("hash").String() {
+		t.Error("SELECT 1 and SELECT 2 differ")
+	}
+	if e := v.Index(1).Get("error"); !e.InstanceOf(js.Global().Get("Error")) {
+		t.Errorf("error = %v, want an Error", e)
+	}
+
+	if _, rejected := await(t, js.Global().Call("computeMany", "SELECT 1")); rejected.IsUndefined() {
+		t.Error("a string instead of an array resolved")
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	v := call(t, "tokenize", "SELECT a FROM t WHERE b = 'x' /* c */")
+	want := []struct {
+		name, class, text string
+		start, end        int
+	}{
+		{"SELECT", "keyword", "SELECT", 0, 6},
+		{"(id)", "identifier", "a", 7, 8},
+		{"FROM", "keyword", "FROM", 9, 13},
+		{"(id)", "identifier", "t", 14, 15},
+		{"WHERE", "keyword", "WHERE", 16, 21},
+		{"(id)", "identifier", "b", 22, 23},
+		{"=", "operator", "=", 24, 25},
+		{"(text)", "literal", "'x'", 26, 29},
+	}
+	if got := v.Length(); got != len(want) {
+		t.Fatalf("got %d tokens, want %d", got, len(want))
+	}
+	for i, w := range want {
+		tok := v.Index(i)
+		got := struct {
+			name, class, text string
+			start, end        int
+		}{
+			tok.Get("name").String(), tok.Get("class").String(), tok.Get("text").String(),
+			tok.Get("start").Int(), tok.Get("end").Int(),
+		}
+		if got != w {
+			t.Errorf("token %d = %+v, want %+v", i, got, w)
+		}
+		if tok.Get("type").Type() != js.TypeNumber {
+			t.Errorf("token %d: type is not a number", i)
+		}
+	}
+}
+
+func TestRedact(t *testing.T) {
+	v := call(t, "redact", "select Name from t where id in (1, 2) -- x", options(map[string]any{
+		"stripComments":   true,
+		"collapseInLists": true,
+	}))
+	if got, want := v.Get("text").String(), "select Name from t where id in (?, ...)"; got != want {
+		t.Errorf("text = %q, want %q", got, want)
+	}
+
+	if _, rejected := await(t, js.Global().Call("redact", "select 'x")); rejected.IsUndefined() {
+		t.Error("unterminated string resolved")
+	}
+}
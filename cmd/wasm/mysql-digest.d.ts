@@ -0,0 +1,80 @@
+// Globals set by the mysql-digest WebAssembly module (cmd/wasm) once
+// go.run(instance) has started it.
+
+export {};
+
+declare global {
+  interface DigestOptions {
+    /** "5.7", "8.0", "8.4", "9.x", or a server version such as "8.0.36". Defaults to 8.0. */
+    version?: string | number;
+    /** An @@sql_mode value; ANSI_QUOTES and NO_BACKSLASH_ESCAPES change how SQL is lexed. */
+    sqlMode?: string;
+    /** Length of the digest text, as performance_schema_max_digest_length. */
+    maxLength?: number;
+    /** Read ? as the parameter marker of a prepared statement. */
+    prepared?: boolean;
+    /** Digest what lexes of a statement with a syntax error instead of rejecting. */
+    lenient?: boolean;
+    /** Read the input as a DIGEST_TEXT. */
+    digestText?: boolean;
+  }
+
+  interface RedactOptions extends DigestOptions {
+    stripComments?: boolean;
+    typedPlaceholders?: boolean;
+    collapseInLists?: boolean;
+  }
+
+  interface DigestResult {
+    hash: string;
+    text: string;
+    /** Set with lenient for a statement cut short by a syntax error, described by error. */
+    truncated: boolean;
+    error?: string;
+  }
+
+  /** What the promises reject with. */
+  interface DigestError extends Error {
+    name: "DigestError";
+    /** The kind of syntax or limit error, e.g. "unterminated string literal". */
+    kind?: string;
+    /** Byte offset, 1-based line and 1-based byte column of a syntax error. */
+    offset?: number;
+    line?: number;
+    column?: number;
+    snippet?: string;
+    limit?: number;
+  }
+
+  type TokenClass =
+    | "plain"
+    | "keyword"
+    | "identifier"
+    | "placeholder"
+    | "literal"
+    | "operator"
+    | "hint"
+    | "comment";
+
+  interface SQLToken {
+    type: number;
+    /** The token's name, e.g. "SELECT", "(id)" or "(num)". */
+    name: string;
+    class: TokenClass;
+    /** UTF-8 byte offsets in the SQL. */
+    start: number;
+    end: number;
+    text: string;
+  }
+
+  function computeDigest(sql: string, options?: DigestOptions): Promise<DigestResult>;
+  /** @deprecated Returns {hash, text} or {error} as a JSON string. */
+  function computeDigest(sql: string, version: number): string;
+  function computeMany(
+    sqls: string[],
+    options?: DigestOptions,
+  ): Promise<Array<DigestResult | { error: DigestError }>>;
+  function tokenize(sql: string, options?: DigestOptions): Promise<SQLToken[]>;
+  function redact(sql: string, options?: RedactOptions): Promise<{ text: string }>;
+  function highlightHTML(sql: string, digestText?: boolean): string;
+}
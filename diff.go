@@ -0,0 +1,288 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rashiq/mysql-digest/internal"
+)
+
+// DiffOp is the kind of a DiffEdit.
+type DiffOp int
+
+const (
+	DiffReplace DiffOp = iota
+	DiffInsert
+	DiffDelete
+)
+
+func (op DiffOp) String() string {
+	switch op {
+	case DiffInsert:
+		return "insert"
+	case DiffDelete:
+		return "delete"
+	}
+	return "replace"
+}
+
+// DiffEdit is one step of the edit script that turns the reduced tokens of
+// the first statement into those of the second. A and B hold the tokens it
+// removes and adds, starting at APos and BPos, as the digest text spells
+// them; ANames and BNames hold their token names, which tell apart tokens
+// spelled alike.
+type DiffEdit struct {
+	Op             DiffOp
+	APos, BPos     int
+	A, B           []string
+	ANames, BNames []string
+	Reason         string // a common cause of this difference, if it is one
+}
+
+// DigestDiff explains why two statements have different digests.
+type DigestDiff struct {
+	A, B             Digest
+	ATokens, BTokens []string // reduced tokens, spelled as in the text
+	Edits            []DiffEdit
+}
+
+// Same reports whether both statements have the same digest.
+func (d *DigestDiff) Same() bool {
+	return d.A.Hash == d.B.Hash
+}
+
+// String describes the difference for people: both digests, then one line
+// per edit, the first divergence first.
+func (d *DigestDiff) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "A: %s %s\n", d.A.Hash, d.A.Text)
+	fmt.Fprintf(&b, "B: %s %s\n", d.B.Hash, d.B.Text)
+	if d.Same() {
+		b.WriteString("same digest\n")
+		return b.String()
+	}
+	for _, e := range d.Edits {
+		fmt.Fprintf(&b, "token %d/%d: ", e.APos+1, e.BPos+1)
+		switch e.Op {
+		case DiffInsert:
+			fmt.Fprintf(&b, "B adds %s", strings.Join(e.B, " "))
+		case DiffDelete:
+			fmt.Fprintf(&b, "A adds %s", strings.Join(e.A, " "))
+		default:
+			fmt.Fprintf(&b, "%s vs %s", strings.Join(e.A, " "), strings.Join(e.B, " "))
+		}
+		if e.Reason != "" {
+			fmt.Fprintf(&b, " (%s)", e.Reason)
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// maxDiffCells bounds the table used to align two token sequences; longer
+// differing stretches are reported as a single replacement.
+const maxDiffCells = 4 << 20
+
+// Diff digests a and b with opts and aligns their reduced token sequences,
+// to show why two statements that look alike get different digests: a
+// different alias, IN (...) against = ?, LIMIT ?, ? against LIMIT ? OFFSET
+// ?, a versioned comment that is only in one of them, and so on.
+func Diff(a, b string, opts ...Options) (*DigestDiff, error) {
+	var opt Options
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	d := &DigestDiff{}
+	var aToks, bToks []internal.DigestToken
+	var err error
+	if d.A, aToks, err = diffTokens(a, opt); err != nil {
+		return nil, fmt.Errorf("first statement: %w", err)
+	}
+	if d.B, bToks, err = diffTokens(b, opt); err != nil {
+		return nil, fmt.Errorf("second statement: %w", err)
+	}
+	d.ATokens, d.BTokens = tokenTexts(aToks), tokenTexts(bToks)
+	if !d.Same() {
+		d.Edits = align(aToks, bToks)
+		for i := range d.Edits {
+			d.Edits[i].Reason = diffReason(&d.Edits[i], aToks, bToks)
+		}
+	}
+	return d, nil
+}
+
+func diffTokens(sql string, opt Options) (Digest, []internal.DigestToken, error) {
+	s := getState()
+	defer putState(s)
+
+	d, err := s.result(opt, s.run(context.Background(), sql, opt))
+	if err != nil {
+		return Digest{}, nil, err
+	}
+	return d, s.state.Store().Tokens(), nil
+}
+
+func tokenTexts(toks []internal.DigestToken) []string {
+	out := make([]string, len(toks))
+	for i, t := range toks {
+		out[i] = t.Text
+	}
+	return out
+}
+
+// tokenNames returns the names of toks in the token tables, as in a Trace.
+func tokenNames(toks []internal.DigestToken) []string {
+	out := make([]string, len(toks))
+	for i, t := range toks {
+		out[i] = internal.TokenName(t.Type)
+	}
+	return out
+}
+
+// sameToken reports whether a and b are the same token of the digest,
+// wherever they were lexed.
+func sameToken(a, b internal.DigestToken) bool {
+	return a.Type == b.Type && a.Text == b.Text
+}
+
+// align returns the edits of a shortest edit script from a to b, after
+// their common prefix and suffix.
+func align(a, b []internal.DigestToken) []DiffEdit {
+	pre := 0
+	for pre < len(a) && pre < len(b) && sameToken(a[pre], b[pre]) {
+		pre++
+	}
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && sameToken(a[len(a)-1-suf], b[len(b)-1-suf]) {
+		suf++
+	}
+	am, bm := a[pre:len(a)-suf], b[pre:len(b)-suf]
+	if (len(am)+1)*(len(bm)+1) > maxDiffCells {
+		return []DiffEdit{newEdit(pre, pre, am, bm)}
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of
+	// am[i:] and bm[j:].
+	w := len(bm) + 1
+	lcs := make([]int32, (len(am)+1)*w)
+	for i := len(am) - 1; i >= 0; i-- {
+		for j := len(bm) - 1; j >= 0; j-- {
+			if sameToken(am[i], bm[j]) {
+				lcs[i*w+j] = lcs[(i+1)*w+j+1] + 1
+			} else {
+				lcs[i*w+j] = max(lcs[(i+1)*w+j], lcs[i*w+j+1])
+			}
+		}
+	}
+
+	var edits []DiffEdit
+	i, j, si, sj := 0, 0, 0, 0
+	flush := func() {
+		if si < i || sj < j {
+			edits = append(edits, newEdit(pre+si, pre+sj, am[si:i], bm[sj:j]))
+		}
+	}
+	for i < len(am) || j < len(bm) {
+		switch {
+		case i < len(am) && j < len(bm) && sameToken(am[i], bm[j]):
+			flush()
+			i, j = i+1, j+1
+			si, sj = i, j
+		case j < len(bm) && (i == len(am) || lcs[i*w+j+1] >= lcs[(i+1)*w+j]):
+			j++
+		default:
+			i++
+		}
+	}
+	flush()
+	return edits
+}
+
+func newEdit(apos, bpos int, a, b []internal.DigestToken) DiffEdit {
+	e := DiffEdit{
+		APos: apos, BPos: bpos,
+		A: tokenTexts(a), B: tokenTexts(b),
+		ANames: tokenNames(a), BNames: tokenNames(b),
+	}
+	switch {
+	case len(a) == 0:
+		e.Op = DiffInsert
+	case len(b) == 0:
+		e.Op = DiffDelete
+	}
+	return e
+}
+
+// diffReason names the usual causes of an edit.
+func diffReason(e *DiffEdit, a, b []internal.DigestToken) string {
+	ea, eb := a[e.APos:e.APos+len(e.A)], b[e.BPos:e.BPos+len(e.B)]
+	switch {
+	case len(ea) == 1 && len(eb) == 1 && ea[0].Type == internal.TOK_IDENT && eb[0].Type == internal.TOK_IDENT:
+		if strings.EqualFold(ea[0].Text, eb[0].Text) {
+			return "identifiers are case sensitive"
+		}
+		return "different identifier or alias"
+	case len(ea) == 1 && len(eb) == 1 && ea[0].Text == eb[0].Text:
+		return "same spelling, different token"
+	case hasToken(ea, internal.IN_SYM, internal.TOK_IN_GENERIC_VALUE_EXPRESSION) && hasToken(eb, internal.EQ) ||
+		hasToken(eb, internal.IN_SYM, internal.TOK_IN_GENERIC_VALUE_EXPRESSION) && hasToken(ea, internal.EQ):
+		return "IN list against a comparison"
+	case hasToken(ea, internal.OFFSET_SYM) != hasToken(eb, internal.OFFSET_SYM) &&
+		(after(a, e.APos, internal.LIMIT) || after(b, e.BPos, internal.LIMIT)):
+		return "LIMIT offset written differently"
+	case valueCountDiffers(ea, eb):
+		return "different number of values"
+	case e.Op == DiffInsert && versioned(eb), e.Op == DiffDelete && versioned(ea):
+		return "tokens from a versioned comment"
+	}
+	return ""
+}
+
+// versioned reports whether all of toks were lexed in a versioned comment.
+func versioned(toks []internal.DigestToken) bool {
+	for _, t := range toks {
+		if !t.Versioned {
+			return false
+		}
+	}
+	return len(toks) > 0
+}
+
+func hasToken(toks []internal.DigestToken, types ...int) bool {
+	for _, t := range toks {
+		for _, typ := range types {
+			if t.Type == typ {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// after reports whether typ is among the few tokens before pos.
+func after(toks []internal.DigestToken, pos, typ int) bool {
+	for i := pos - 1; i >= 0 && i >= pos-3; i-- {
+		if toks[i].Type == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// valueCountDiffers reports whether a and b are both one value token, for
+// lists or rows of a different length: ? against ?, ..., (?) against (...).
+func valueCountDiffers(a, b []internal.DigestToken) bool {
+	return len(a) == 1 && len(b) == 1 && a[0].Type != b[0].Type &&
+		isValueToken(a[0].Type) && isValueToken(b[0].Type)
+}
+
+func isValueToken(tok int) bool {
+	switch tok {
+	case internal.TOK_GENERIC_VALUE, internal.TOK_GENERIC_VALUE_LIST,
+		internal.TOK_ROW_SINGLE_VALUE, internal.TOK_ROW_SINGLE_VALUE_LIST,
+		internal.TOK_ROW_MULTIPLE_VALUE, internal.TOK_ROW_MULTIPLE_VALUE_LIST:
+		return true
+	}
+	return false
+}
@@ -6,12 +6,28 @@
 package digest
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
 	"github.com/rashiq/mysql-digest/internal"
 )
 
 type Digest struct {
 	Hash string
 	Text string
+
+	// Truncated is set in Lenient mode for a statement that could not be
+	// lexed to its end, such as one cut off inside a string literal. Its
+	// digest ends with the ABORT_SYM token the server's lexer gives up
+	// with; like other tokens without a spelling it only shows in Hash.
+	// Err is the *SyntaxError that stopped it.
+	Truncated bool
+	Err       error
+
+	version MySQLVersion // Text is read back as this version's
 }
 
 type MySQLVersion = internal.MySQLVersion
@@ -23,6 +39,33 @@ const (
 	MySQL57 = internal.MySQL57
 )
 
+// ParseVersion maps a server version string such as "5.7", "8.0.36" or
+// "8.4.2-log" (as reported by @@version) to a MySQLVersion. The 8.1 to 8.3
+// innovation releases map to MySQL80.
+func ParseVersion(s string) (MySQLVersion, error) {
+	v := strings.TrimSpace(s)
+	release := func(r string) bool { return v == r || strings.HasPrefix(v, r+".") }
+	switch {
+	case release("5.7"):
+		return MySQL57, nil
+	case release("8.0"), release("8.1"), release("8.2"), release("8.3"):
+		return MySQL80, nil
+	case release("8.4"):
+		return MySQL84, nil
+	case release("9"):
+		return MySQL90, nil
+	}
+	return 0, fmt.Errorf("unsupported MySQL version %q", s)
+}
+
+type LiteralMode = internal.LiteralMode
+
+const (
+	LiteralNormalize = internal.LiteralNormalize
+	LiteralVerbatim  = internal.LiteralVerbatim
+	LiteralHMAC      = internal.LiteralHMAC
+)
+
 type SQLMode = internal.SQLMode
 
 const (
@@ -30,12 +73,68 @@ const (
 	MODE_ANSI_QUOTES          = internal.MODE_ANSI_QUOTES
 )
 
+// ParseSQLMode converts a comma separated @@sql_mode value into the modes
+// that affect lexing. Modes that do not change digests are ignored.
+func ParseSQLMode(s string) SQLMode {
+	var mode SQLMode
+	for _, name := range strings.Split(s, ",") {
+		switch strings.ToUpper(strings.TrimSpace(name)) {
+		case "NO_BACKSLASH_ESCAPES":
+			mode |= MODE_NO_BACKSLASH_ESCAPES
+		case "ANSI_QUOTES", "ANSI":
+			mode |= MODE_ANSI_QUOTES
+		}
+	}
+	return mode
+}
+
 type Options struct {
 	SQLMode   SQLMode
 	MaxLength int
 	Version   MySQLVersion
+
+	// InputIsDigestText reads the input as a DIGEST_TEXT, so the
+	// placeholders it contains ("?", "?, ...", "(?)", "(...)",
+	// "IN (...)" and the "/* , ... */" row lists) digest as the values
+	// they replaced. Compute(d.Text) then reproduces d.Hash, unless the
+	// text was cut short by MaxLength or spells two different tokens the
+	// same way (an unknown-token gap, or '<' next to the LT operator).
+	InputIsDigestText bool
+
+	// Prepared lexes the statement as one sent with COM_STMT_PREPARE,
+	// where ? is a parameter marker that digests as a value, so that
+	// SELECT ?, ? gives SELECT ?, ... as the server shows it for
+	// prepared statements.
+	Prepared bool
+
+	// MaxInputBytes and MaxTokens, when above 0, make statements longer
+	// than this many bytes or lexer tokens fail with a *LimitError
+	// instead of being digested. MaxInputBytes is checked before any
+	// lexing.
+	MaxInputBytes int
+	MaxTokens     int
+
+	// LiteralMode chooses what Text shows for literals: ? and folded
+	// lists like the server (LiteralNormalize), each literal as written
+	// (LiteralVerbatim), or ?# and a 64-bit keyed HMAC of its spelling,
+	// which tells equal values apart without storing them (LiteralHMAC,
+	// keyed with LiteralKey, which must not be empty: ErrNoLiteralKey).
+	// Only holders of the key can test guesses at a literal's value.
+	// Hash is the same in every mode.
+	LiteralMode LiteralMode
+	LiteralKey  []byte
+
+	// Lenient digests a statement that cannot be lexed, for instance one
+	// cut short by max_allowed_packet or a log line limit, up to where
+	// lexing failed instead of returning its SyntaxError. The Digest has
+	// Truncated and Err set; errors other than syntax errors are still
+	// returned.
+	Lenient bool
 }
 
+// Digester computes digests with a fixed set of Options. It is safe for
+// concurrent use; lexer and token buffers are pooled and reused between
+// calls.
 type Digester struct {
 	opts Options
 }
@@ -52,6 +151,49 @@ func (d *Digester) Digest(sql string) (Digest, error) {
 	return compute(sql, d.opts)
 }
 
+// DigestContext digests sql, giving up once ctx is done; see
+// ComputeContext.
+func (d *Digester) DigestContext(ctx context.Context, sql string) (Digest, error) {
+	return computeContext(ctx, sql, d.opts)
+}
+
+// AppendText appends the normalized text of sql to dst and returns the
+// extended buffer. sql is only read during the call. With Lenient set, a
+// statement that cannot be lexed to its end has the text up to the error
+// appended, and its *SyntaxError is still returned: the ABORT_SYM marker
+// that ends it has no spelling, so the text alone looks complete.
+func (d *Digester) AppendText(dst, sql []byte) ([]byte, error) {
+	s := getState()
+	defer putState(s)
+
+	err := s.run(context.Background(), bytesToString(sql), d.opts)
+	if err != nil && !truncated(err, d.opts) {
+		return dst, err
+	}
+	return s.state.Store().AppendText(dst, d.opts.MaxLength), err
+}
+
+// AppendSum appends the raw digest hash of sql to dst, the bytes
+// Digest.Hash is the hex encoding of: 32 for SHA-256, 16 for the MD5 of
+// MySQL 5.7. sql is only read during the call. Errors are as for
+// AppendText.
+func (d *Digester) AppendSum(dst, sql []byte) ([]byte, error) {
+	s := getState()
+	defer putState(s)
+
+	err := s.run(context.Background(), bytesToString(sql), d.opts)
+	if err != nil && !truncated(err, d.opts) {
+		return dst, err
+	}
+	sum, n := s.state.Store().Sum()
+	return append(dst, sum[:n]...), err
+}
+
+// DigestReader digests the statement read from r; see ComputeReader.
+func (d *Digester) DigestReader(r io.Reader) (Digest, error) {
+	return computeReader(r, d.opts)
+}
+
 func Compute(sql string, opts ...Options) (Digest, error) {
 	var opt Options
 	if len(opts) > 0 {
@@ -60,19 +202,112 @@ func Compute(sql string, opts ...Options) (Digest, error) {
 	return compute(sql, opt)
 }
 
+// ComputeContext is Compute, giving up with ctx's error once ctx is done.
+// Cancellation is checked every few hundred tokens; use MaxInputBytes to
+// bound the time a single huge token can take.
+func ComputeContext(ctx context.Context, sql string, opts ...Options) (Digest, error) {
+	var opt Options
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	return computeContext(ctx, sql, opt)
+}
+
 func compute(sql string, opt Options) (Digest, error) {
+	return computeContext(context.Background(), sql, opt)
+}
+
+func computeContext(ctx context.Context, sql string, opt Options) (Digest, error) {
+	s := getState()
+	defer putState(s)
+
+	err := s.run(ctx, sql, opt)
+	return s.result(opt, err)
+}
+
+// ComputeReader digests the statement read from r, which need not fit in
+// memory. Only a window around the current token and the part of the
+// statement that can still be folded into a value list are kept, so a
+// multi-row INSERT of any size is digested in constant memory. The result
+// is the same as Compute's for the whole statement.
+func ComputeReader(r io.Reader, opts ...Options) (Digest, error) {
+	var opt Options
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	return computeReader(r, opt)
+}
+
+func computeReader(r io.Reader, opt Options) (Digest, error) {
+	s := getState()
+	defer putState(s)
+
+	if opt.MaxInputBytes > 0 {
+		r = &limitReader{r: r, left: opt.MaxInputBytes, limit: opt.MaxInputBytes}
+	}
+	s.state.ResetReader(r, opt.Version, opt.MaxLength)
+	if err := s.configure(context.Background(), opt); err != nil {
+		return Digest{}, err
+	}
+	err := s.state.Run()
+	return s.result(opt, err)
+}
+
+// limitReader fails with a LimitError once more than limit bytes are read.
+type limitReader struct {
+	r           io.Reader
+	left, limit int
+}
+
+func (l *limitReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if l.left == 0 {
+		// Only an error if the statement goes on.
+		var one [1]byte
+		n, err := l.r.Read(one[:])
+		if n > 0 {
+			return 0, &LimitError{Err: ErrInputTooLarge, Limit: l.limit}
+		}
+		return 0, err
+	}
+	n, err := l.r.Read(p[:min(len(p), l.left)])
+	l.left -= n
+	return n, err
+}
+
+func newLexer(sql string, opt Options) *internal.Lexer {
 	lexer := internal.NewLexer(sql)
-	lexer.SetSQLMode(opt.SQLMode)
 	lexer.SetDigestVersion(opt.Version)
+	configureLexer(lexer, opt)
+	return lexer
+}
 
-	store := internal.NewTokenStore(opt.Version)
-	reducer := internal.NewReducer(store)
-	handler := internal.NewTokenHandler(lexer, store, reducer)
+func configureLexer(lexer *internal.Lexer, opt Options) {
+	lexer.SetSQLMode(opt.SQLMode)
+	lexer.SetDigestTextMode(opt.InputIsDigestText)
+	lexer.SetPrepareMode(opt.Prepared)
+}
 
-	err := handler.ProcessAll()
+// Pretty returns d.Text laid out over several lines for reading: a line
+// per clause, joins and conditions indented, subqueries indented between
+// their parentheses, and lines wrapped to width where they can be. It is
+// built from the reduced tokens d.Text digests to, so it holds no literal
+// the text does not. A width of 0 or less means no wrapping.
+func (d Digest) Pretty(width int) string {
+	s := getState()
+	defer putState(s)
 
-	return Digest{
-		Hash: store.ComputeHash(),
-		Text: store.BuildText(opt.MaxLength),
-	}, err
+	err := s.run(context.Background(), d.Text, Options{Version: d.version, InputIsDigestText: true, Lenient: true})
+	toks := s.state.Store().Tokens()
+	rest := ""
+	var syntaxErr *SyntaxError
+	if errors.As(err, &syntaxErr) {
+		// A text cut by MaxLength can end inside a token; the digest of
+		// the rest ends with ABORT_SYM.
+		toks = toks[:len(toks)-1]
+		rest = d.Text[syntaxErr.Offset:]
+	}
+	return internal.Pretty(toks, rest, width)
 }
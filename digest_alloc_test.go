@@ -0,0 +1,47 @@
+//go:build !race
+
+package digest
+
+import "testing"
+
+// Allocation counts are only meaningful without the race detector, under
+// which sync.Pool drops items at random.
+
+func TestDigester_Allocs(t *testing.T) {
+	queries := []string{
+		"SELECT * FROM users WHERE id = 1",
+		"select u.id, u.name from users u join orders o on u.id = o.user_id where o.status = 'active' limit 10",
+		"SELECT * FROM t WHERE a IN (1, 2, 3, 4, 5) AND b IS NOT NULL",
+		"INSERT INTO t (a, b) VALUES (1, 'x'), (2, 'y'), (3, 'z')",
+	}
+
+	d := NewDigester()
+	for _, sql := range queries {
+		d.Digest(sql) //nolint:errcheck // warm the pool
+		allocs := testing.AllocsPerRun(100, func() {
+			d.Digest(sql) //nolint:errcheck
+		})
+		// Only the returned Hash and Text, which share one string.
+		if allocs > 1 {
+			t.Errorf("%q: %v allocations per Digest, want at most 1", sql, allocs)
+		}
+	}
+}
+
+func TestDigester_AppendTextAndSum_Allocs(t *testing.T) {
+	d := NewDigester()
+	sql := []byte("SELECT u.id, u.name FROM users u WHERE u.status IN ('a', 'b') AND u.id = 42")
+	buf := make([]byte, 0, 256)
+	d.AppendSum(buf, sql) //nolint:errcheck // warm the pool
+
+	if allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = d.AppendText(buf[:0], sql)
+	}); allocs != 0 {
+		t.Errorf("AppendText: %v allocations per run, want 0", allocs)
+	}
+	if allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = d.AppendSum(buf[:0], sql)
+	}); allocs != 0 {
+		t.Errorf("AppendSum: %v allocations per run, want 0", allocs)
+	}
+}
@@ -0,0 +1,251 @@
+package digest
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/rashiq/mysql-digest/internal"
+)
+
+var fuzzSeeds = []string{
+	"SELECT * FROM users WHERE id = 123",
+	"SELECT * FROM t WHERE x IN (1, 2, 3) AND y NOT IN ('a', 'b')",
+	"INSERT INTO t (a, b) VALUES (1, 'x'), (2, 'y'), (3, 'z')",
+	"INSERT INTO t VALUES (1), (2), (3)",
+	"UPDATE accounts SET balance = balance - 50.00 WHERE id = -123",
+	"SELECT a FROM t WHERE b IS NOT NULL AND c = NULL",
+	"SELECT /*+ MAX_EXECUTION_TIME(5000) */ id FROM large_table LIMIT 10, 20",
+	"SELECT @a, @@global.max_connections FROM dual",
+	"SELECT COUNT(*) FROM t GROUP BY a HAVING COUNT(*) > 10 ORDER BY 1 DESC",
+	"SELECT a /* c */ FROM t -- trailing\n WHERE b = 1 # hash\n",
+	"SELECT /*!50000 STRAIGHT_JOIN */ a FROM t",
+	"SELECT `用户`, 名字 FROM `员工表` WHERE `部门` = '技术'",
+	"SELECT x'4D7953', b'0101', 0x1F, 1e10, .5, -+-1",
+	"SELECT a->'$.b', a->>'$.c' FROM t WHERE a <=> b",
+	"SELECT $$dollar$$, $tag$ x $tag$",
+	"WITH r AS (SELECT *, ROW_NUMBER() OVER (PARTITION BY c ORDER BY p DESC) AS rn FROM p) SELECT * FROM r WHERE rn <= 3",
+	"SELECT 1;",
+	"SELECT 'unterminated",
+}
+
+var fuzzVersions = []MySQLVersion{MySQL57, MySQL80, MySQL84, MySQL90}
+
+// fuzzDigests adds the seeds to f and fuzzes check with every version a
+// statement digests under, and its digest.
+func fuzzDigests(f *testing.F, check func(t *testing.T, sql string, opts Options, d Digest)) {
+	for _, s := range fuzzSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, sql string) {
+		for _, v := range fuzzVersions {
+			opts := Options{Version: v}
+			d, err := Compute(sql, opts)
+			if err != nil {
+				continue
+			}
+			check(t, sql, opts, d)
+		}
+	})
+}
+
+// FuzzCompute checks that a digest text, read back with InputIsDigestText
+// or digested again as SQL, reaches a fixed point.
+func FuzzCompute(f *testing.F) {
+	fuzzDigests(f, func(t *testing.T, sql string, opts Options, d Digest) {
+		if quotedHint(d.Text) {
+			return
+		}
+		// A digest text only reproduces the original hash when every
+		// token has its own spelling ('<' and LT print alike), but
+		// reading it back must always reach a fixed point.
+		dt := Options{Version: opts.Version, InputIsDigestText: true}
+		rt, err := Compute(d.Text, dt)
+		if err != nil {
+			t.Fatalf("reading back %q (from %q) failed: %v", d.Text, sql, err)
+		}
+		rt2, err := Compute(rt.Text, dt)
+		if err != nil {
+			t.Fatalf("reading back %q failed: %v", rt.Text, err)
+		}
+		if rt2.Hash != rt.Hash {
+			t.Fatalf("reading back the digest text of %q is not stable:\n  once:  %q\n  twice: %q", sql, rt.Text, rt2.Text)
+		}
+
+		once, err := Compute(d.Text, opts)
+		if err != nil {
+			t.Fatalf("re-digesting %q (from %q) failed: %v", d.Text, sql, err)
+		}
+		twice, err := Compute(once.Text, opts)
+		if err != nil {
+			t.Fatalf("re-digesting %q failed: %v", once.Text, err)
+		}
+		// Only one trailing ';' is dropped per pass, so a run of them
+		// shrinks on every re-digest.
+		if strings.TrimRight(once.Text, "; ") != strings.TrimRight(twice.Text, "; ") {
+			t.Fatalf("re-digest is not stable for %q:\n  once:  %q\n  twice: %q", sql, once.Text, twice.Text)
+		}
+	})
+}
+
+// FuzzComputeReader checks that streaming a statement one byte at a time
+// gives Compute's digest.
+func FuzzComputeReader(f *testing.F) {
+	fuzzDigests(f, func(t *testing.T, sql string, opts Options, d Digest) {
+		r, err := ComputeReader(iotest.OneByteReader(strings.NewReader(sql)), opts)
+		if err != nil || r != d {
+			t.Fatalf("ComputeReader differs from Compute for %q: %v\n  got:  %q\n  want: %q", sql, err, r.Text, d.Text)
+		}
+	})
+}
+
+// FuzzPadding checks that whitespace or comments between tokens never
+// change the hash.
+func FuzzPadding(f *testing.F) {
+	fuzzDigests(f, func(t *testing.T, sql string, opts Options, d Digest) {
+		for _, pad := range []string{"  ", "\n\t", " /* fuzz */ "} {
+			padded := padGaps(sql, opts.Version, pad)
+			if padded == sql {
+				continue
+			}
+			p, err := Compute(padded, opts)
+			if err != nil {
+				t.Fatalf("padded %q failed: %v (original %q)", padded, err, sql)
+			}
+			if p.Hash != d.Hash {
+				t.Fatalf("padding changed the digest:\n  sql:    %q\n  padded: %q\n  want:   %s\n  got:    %s",
+					sql, padded, d.Text, p.Text)
+			}
+		}
+	})
+}
+
+// FuzzForVersions checks that ForVersions agrees with computing each
+// version on its own.
+func FuzzForVersions(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, sql string) {
+		all, _ := ForVersions(sql, fuzzVersions)
+		for i, v := range fuzzVersions {
+			if d, _ := Compute(sql, Options{Version: v}); all[i] != d {
+				t.Fatalf("ForVersions differs from Compute for %v on %q:\n  got:  %q\n  want: %q", v, sql, all[i].Text, d.Text)
+			}
+		}
+	})
+}
+
+// FuzzRedact checks that a redacted statement can itself be digested.
+func FuzzRedact(f *testing.F) {
+	fuzzDigests(f, func(t *testing.T, sql string, opts Options, d Digest) {
+		redacted, err := Redact(sql, RedactOptions{Options: opts, StripComments: true, CollapseInLists: true})
+		if err != nil {
+			t.Fatalf("Redact failed for %q: %v", sql, err)
+		}
+		if _, err := Compute(redacted, opts); err != nil {
+			t.Fatalf("digesting %q (redacted from %q) failed: %v", redacted, sql, err)
+		}
+	})
+}
+
+// FuzzLiteralHMAC checks that LiteralHMAC changes the text but never the
+// hash.
+func FuzzLiteralHMAC(f *testing.F) {
+	fuzzDigests(f, func(t *testing.T, sql string, opts Options, d Digest) {
+		opts.LiteralMode, opts.LiteralKey = LiteralHMAC, []byte("k")
+		if h, err := Compute(sql, opts); err != nil || h.Hash != d.Hash {
+			t.Fatalf("LiteralHMAC changed the hash of %q: %q vs %q (%v)", sql, h.Text, d.Text, err)
+		}
+	})
+}
+
+// FuzzPretty checks that the pretty layout of a digest text reads back as
+// the same tokens.
+func FuzzPretty(f *testing.F) {
+	fuzzDigests(f, func(t *testing.T, sql string, opts Options, d Digest) {
+		if quotedHint(d.Text) {
+			return
+		}
+		dt := Options{Version: opts.Version, InputIsDigestText: true}
+		rt, err := Compute(d.Text, dt)
+		if err != nil {
+			t.Fatalf("reading back %q (from %q) failed: %v", d.Text, sql, err)
+		}
+		pretty, err := Compute(d.Pretty(40), dt)
+		if err != nil || pretty.Hash != rt.Hash {
+			t.Fatalf("pretty layout of %q changed its tokens: %v\n%s", d.Text, err, d.Pretty(40))
+		}
+	})
+}
+
+// FuzzHighlight checks that the highlighted spans of any input cover it
+// in order.
+func FuzzHighlight(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, sql string) {
+		end := 0
+		for _, s := range Highlight(sql) {
+			if s.Start != end || s.End <= s.Start {
+				t.Fatalf("Highlight span %+v of %q does not follow offset %d", s, sql, end)
+			}
+			end = s.End
+		}
+		if end != len(sql) {
+			t.Fatalf("Highlight spans of %q end at %d of %d", sql, end, len(sql))
+		}
+	})
+}
+
+// quotedHint reports whether a hint in a digest text has a quoted
+// argument. Its quotes are kept and quoted again by every read back.
+func quotedHint(text string) bool {
+	for {
+		i := strings.Index(text, "/*+")
+		if i < 0 {
+			return false
+		}
+		text = text[i+3:]
+		end := strings.Index(text, "*/")
+		if end < 0 {
+			end = len(text)
+		}
+		if strings.Contains(text[:end], "`") {
+			return true
+		}
+		text = text[end:]
+	}
+}
+
+// padGaps inserts pad into every whitespace-only gap between two tokens,
+// outside optimizer hints, where separation cannot change how the
+// neighbouring tokens are lexed.
+func padGaps(sql string, v MySQLVersion, pad string) string {
+	l := internal.NewLexer(sql)
+	l.SetDigestVersion(v)
+
+	var b strings.Builder
+	last, prevEnd, inHint := 0, -1, false
+	for {
+		tok := l.Lex()
+		if tok.Type == internal.END_OF_INPUT || tok.Type == internal.ABORT_SYM {
+			break
+		}
+		if prevEnd >= 0 && !inHint && tok.Start > prevEnd && strings.TrimSpace(sql[prevEnd:tok.Start]) == "" {
+			b.WriteString(sql[last:prevEnd])
+			b.WriteString(pad)
+			last = prevEnd
+		}
+		switch tok.Type {
+		case internal.TOK_HINT_COMMENT_OPEN:
+			inHint = true
+		case internal.TOK_HINT_COMMENT_CLOSE:
+			inHint = false
+		}
+		prevEnd = tok.End
+	}
+	b.WriteString(sql[last:])
+	return b.String()
+}
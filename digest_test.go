@@ -1,7 +1,18 @@
 package digest
 
 import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 func TestDigest_LiteralReplacement(t *testing.T) {
@@ -612,6 +623,36 @@ func TestDigest_UserAndSystemVariables(t *testing.T) {
 
 // Benchmarks for performance testing
 
+func BenchmarkDigester_Simple(b *testing.B) {
+	d := NewDigester()
+	sql := "SELECT * FROM users WHERE id = 1"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.Digest(sql) //nolint:errcheck
+	}
+}
+
+func BenchmarkDigester_AppendSum(b *testing.B) {
+	d := NewDigester()
+	sql := []byte("SELECT * FROM users WHERE id = 1")
+	buf := make([]byte, 0, 32)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf, _ = d.AppendSum(buf[:0], sql)
+	}
+}
+
+func BenchmarkDigester_Parallel(b *testing.B) {
+	d := NewDigester()
+	sql := "SELECT u.id, u.name, o.total FROM users u JOIN orders o ON u.id = o.user_id WHERE o.status = 'active' AND o.total > 100 ORDER BY o.created_at DESC LIMIT 10"
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			d.Digest(sql) //nolint:errcheck
+		}
+	})
+}
+
 func BenchmarkCompute_Simple(b *testing.B) {
 	sql := "SELECT * FROM users WHERE id = 1"
 	b.ResetTimer()
@@ -1219,3 +1260,1157 @@ func TestMySQL57MultiRowInsert(t *testing.T) {
 		})
 	}
 }
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    MySQLVersion
+		wantErr bool
+	}{
+		{in: "5.7", want: MySQL57},
+		{in: "5.7.44-log", want: MySQL57},
+		{in: "8.0.36", want: MySQL80},
+		{in: "8.4", want: MySQL84},
+		{in: "9.1.0", want: MySQL90},
+		{in: "8.1", want: MySQL80},
+		{in: "8.3.0-log", want: MySQL80},
+		{in: "5.6.51", wantErr: true},
+		{in: "8.10", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseVersion(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseVersion(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParseVersion(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSQLMode(t *testing.T) {
+	tests := []struct {
+		in   string
+		want SQLMode
+	}{
+		{in: "", want: 0},
+		{in: "STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION", want: 0},
+		{in: "ANSI_QUOTES", want: MODE_ANSI_QUOTES},
+		{in: "REAL_AS_FLOAT,PIPES_AS_CONCAT,ANSI_QUOTES,IGNORE_SPACE,ANSI", want: MODE_ANSI_QUOTES},
+		{in: "no_backslash_escapes, ansi_quotes", want: MODE_NO_BACKSLASH_ESCAPES | MODE_ANSI_QUOTES},
+	}
+
+	for _, tt := range tests {
+		if got := ParseSQLMode(tt.in); got != tt.want {
+			t.Errorf("ParseSQLMode(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+// Only the last ';' of a statement is dropped.
+func TestDigest_TrailingSemicolons(t *testing.T) {
+	for sql, want := range map[string]string{
+		"SELECT 1;":           "SELECT ?",
+		"SELECT 1;;":          "SELECT ? ;",
+		"SELECT 1 ; ;\n;":     "SELECT ? ; ;",
+		";;":                  ";",
+		"SELECT 1; SELECT 2;": "SELECT ? ; SELECT ?",
+	} {
+		d, err := Compute(sql)
+		if err != nil {
+			t.Fatalf("Compute(%q) error: %v", sql, err)
+		}
+		if d.Text != want {
+			t.Errorf("Compute(%q).Text = %q, want %q", sql, d.Text, want)
+		}
+	}
+}
+
+func TestDigest_InputIsDigestText(t *testing.T) {
+	tests := []string{
+		"SELECT * FROM users WHERE id = 42",
+		"SELECT 1, 2, 3",
+		"SELECT * FROM t WHERE a IN (1, 2, 3) AND b NOT IN ('x')",
+		"INSERT INTO t VALUES (1), (2), (3)",
+		"INSERT INTO t (a, b) VALUES (1, 'x'), (2, 'y')",
+		"INSERT INTO t (a, b) VALUES (1, 'x')",
+		"SELECT @a, @@session.sql_mode, -1, a - 2",
+		"SELECT NULL, a IS NULL, b IS NOT NULL FROM t",
+		"SELECT /*+ MAX_EXECUTION_TIME(1000) */ `a b`.c FROM `a b`",
+		"SELECT a FROM t JOIN u ON t.x = u.y WHERE b IN (SELECT 1) LIMIT 10, 20",
+		"SELECT 1;;",
+	}
+
+	for _, v := range []MySQLVersion{MySQL57, MySQL80, MySQL84, MySQL90} {
+		for _, sql := range tests {
+			d, err := Compute(sql, Options{Version: v})
+			if err != nil {
+				t.Fatalf("Compute(%q) error: %v", sql, err)
+			}
+			r, err := Compute(d.Text, Options{Version: v, InputIsDigestText: true})
+			if err != nil {
+				t.Fatalf("Compute(%q) as digest text error: %v", d.Text, err)
+			}
+			if r.Hash != d.Hash || r.Text != d.Text {
+				t.Errorf("version %v: round trip of %q changed the digest:\n  text: %s -> %s\n  hash: %s -> %s",
+					v, sql, d.Text, r.Text, d.Hash, r.Hash)
+			}
+		}
+	}
+}
+
+// Versioned comments open for the digest version's own server version.
+// MySQL90 has none yet, so no numbered versioned comment opens under it.
+func TestDigest_VersionedCommentsByVersion(t *testing.T) {
+	tests := []struct {
+		sql  string
+		v    MySQLVersion
+		want string
+	}{
+		{"SELECT /*!50000 STRAIGHT_JOIN */ a FROM t", MySQL57, "SELECT STRAIGHT_JOIN `a` FROM `t`"},
+		{"SELECT /*!50000 STRAIGHT_JOIN */ a FROM t", MySQL90, "SELECT `a` FROM `t`"},
+		{"SELECT /*!80400 STRAIGHT_JOIN */ a FROM t", MySQL80, "SELECT `a` FROM `t`"},
+		{"SELECT /*!80400 STRAIGHT_JOIN */ a FROM t", MySQL84, "SELECT STRAIGHT_JOIN `a` FROM `t`"},
+		{"SELECT /*!90000 STRAIGHT_JOIN */ a FROM t", MySQL84, "SELECT `a` FROM `t`"},
+		{"SELECT /*!90000 STRAIGHT_JOIN */ a FROM t", MySQL90, "SELECT `a` FROM `t`"},
+		{"SELECT /*! STRAIGHT_JOIN */ a FROM t", MySQL90, "SELECT STRAIGHT_JOIN `a` FROM `t`"},
+	}
+	for _, tt := range tests {
+		d, err := Compute(tt.sql, Options{Version: tt.v})
+		if err != nil {
+			t.Fatalf("Compute(%q) error: %v", tt.sql, err)
+		}
+		if d.Text != tt.want {
+			t.Errorf("%v: Compute(%q).Text = %q, want %q", tt.v, tt.sql, d.Text, tt.want)
+		}
+	}
+}
+
+func TestForVersions(t *testing.T) {
+	tests := []string{
+		"SELECT * FROM users WHERE id = 42",
+		"SELECT * FROM t WHERE a IN (1, 2, 3)",
+		"INSERT INTO t VALUES (1, 'a'), (2, 'b')",
+		"SELECT /*!80000 SQL_NO_CACHE */ a FROM t",
+		"SELECT * FROM t QUALIFY x = 1",
+		"CHANGE MASTER TO MASTER_HOST = 'h'",
+		"SELECT 'unterminated",
+	}
+
+	for _, sql := range tests {
+		t.Run(sql, func(t *testing.T) {
+			got, gotErr := ForVersions(sql, AllVersions)
+			if len(got) != len(AllVersions) {
+				t.Fatalf("got %d digests, want %d", len(got), len(AllVersions))
+			}
+			for i, v := range AllVersions {
+				want, err := Compute(sql, Options{Version: v})
+				if (err != nil) != (gotErr != nil) {
+					t.Fatalf("version %v: error = %v, ForVersions error = %v", v, err, gotErr)
+				}
+				if got[i] != want {
+					t.Errorf("version %v:\n  got:  %+v\n  want: %+v", v, got[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	sql := "SELECT * FROM users WHERE id = 42"
+	from, to, err := Translate(sql, MySQL57, MySQL80)
+	if err != nil {
+		t.Fatalf("Translate(%q) error: %v", sql, err)
+	}
+	if from.Hash != "731d9efe96031900ba2a36667f4718d0" {
+		t.Errorf("5.7 hash = %s", from.Hash)
+	}
+	if to.Hash != "840a880ebd1642e8a0c4926cfbaf7d4da9616b03025a080fafd43a732800fab5" {
+		t.Errorf("8.0 hash = %s", to.Hash)
+	}
+}
+
+func TestDigester_AppendTextAndSum(t *testing.T) {
+	tests := []struct {
+		sql     string
+		version MySQLVersion
+	}{
+		{"SELECT * FROM users WHERE id = 42", MySQL80},
+		{"SELECT * FROM users WHERE id = 42", MySQL57},
+		{"INSERT INTO t VALUES (1, 'a'), (2, 'b')", MySQL84},
+	}
+
+	for _, tt := range tests {
+		d := NewDigester(Options{Version: tt.version})
+		want, err := d.Digest(tt.sql)
+		if err != nil {
+			t.Fatalf("Digest(%q) error: %v", tt.sql, err)
+		}
+
+		prefix := []byte("text: ")
+		got, err := d.AppendText(prefix, []byte(tt.sql))
+		if err != nil {
+			t.Fatalf("AppendText(%q) error: %v", tt.sql, err)
+		}
+		if string(got) != "text: "+want.Text {
+			t.Errorf("AppendText(%q) = %q, want %q", tt.sql, got, "text: "+want.Text)
+		}
+
+		sum, err := d.AppendSum(prefix, []byte(tt.sql))
+		if err != nil {
+			t.Fatalf("AppendSum(%q) error: %v", tt.sql, err)
+		}
+		if got := hex.EncodeToString(sum[len(prefix):]); got != want.Hash {
+			t.Errorf("AppendSum(%q) = %s, want %s", tt.sql, got, want.Hash)
+		}
+	}
+}
+
+func TestDigester_AppendTextError(t *testing.T) {
+	sql := []byte("SELECT 'unterminated")
+	_, err := NewDigester().AppendText(nil, sql)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	msg := err.Error()
+	copy(sql, "XXXXXXXXXXXXXXXXXXXX")
+	if err.Error() != msg {
+		t.Errorf("error changed after the input was reused: %q -> %q", msg, err.Error())
+	}
+}
+
+// rowsReader streams "INSERT INTO t (a, b) VALUES (0, 'row 0'), ..." with n
+// rows without holding the statement in memory.
+type rowsReader struct {
+	n, row int
+	buf    []byte
+}
+
+func (r *rowsReader) Read(p []byte) (int, error) {
+	for len(r.buf) < len(p) && r.row <= r.n {
+		switch {
+		case r.row == 0:
+			r.buf = append(r.buf, "INSERT INTO t (a, b) VALUES "...)
+		case r.row < r.n:
+			r.buf = fmt.Appendf(r.buf, "(%d, 'row %d'), ", r.row, r.row)
+		default:
+			r.buf = fmt.Appendf(r.buf, "(%d, 'row %d');", r.row, r.row)
+		}
+		r.row++
+	}
+	if len(r.buf) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func TestComputeReader(t *testing.T) {
+	tests := []string{
+		"SELECT * FROM users WHERE id = 42",
+		"select `a``b`, \"c\" from t where x in (1, 2, 3) and y = 'it''s' -- done",
+		"SELECT /*+ MAX_EXECUTION_TIME(1000) */ a FROM t /* comment */ WHERE b IS NOT NULL;",
+		"SELECT /*!80000 SQL_NO_CACHE */ $$dollar$$, 0x1F, b'01', 1.5e3, @a, @@version",
+		"SELECT - - 1, a - 2, CASE WHEN a THEN 'x' ELSE NULL END FROM t",
+		"INSERT INTO t VALUES (1, NOW()), (2, NOW()), (3, NOW())",
+		strings.Repeat("SELECT a, b, c FROM t WHERE d = 1 UNION ALL ", 200) + "SELECT 1",
+		"SELECT 'unterminated",
+	}
+
+	for _, v := range []MySQLVersion{MySQL57, MySQL80} {
+		for _, maxLen := range []int{0, 40} {
+			opts := Options{Version: v, MaxLength: maxLen}
+			for _, sql := range tests {
+				want, wantErr := Compute(sql, opts)
+				got, err := ComputeReader(iotest.OneByteReader(strings.NewReader(sql)), opts)
+				if (err != nil) != (wantErr != nil) {
+					t.Fatalf("%v %q: error = %v, want %v", v, sql, err, wantErr)
+				}
+				if got != want {
+					t.Errorf("%v maxLen %d %.40q:\n  got:  %+v\n  want: %+v", v, maxLen, sql, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestComputeReader_LargeInsert(t *testing.T) {
+	sql, err := io.ReadAll(&rowsReader{n: 20000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := Compute(string(sql))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ComputeReader(&rowsReader{n: 20000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if want.Text != "INSERT INTO `t` ( `a` , `b` ) VALUES (...) /* , ... */" {
+		t.Errorf("unexpected text %q", want.Text)
+	}
+}
+
+func TestComputeReader_ReadError(t *testing.T) {
+	errBroken := errors.New("broken pipe")
+	r := io.MultiReader(strings.NewReader("SELECT * FROM t WHERE a = "), iotest.ErrReader(errBroken))
+	if _, err := ComputeReader(r); !errors.Is(err, errBroken) {
+		t.Errorf("error = %v, want %v", err, errBroken)
+	}
+}
+
+func batchInput(n int) []string {
+	sqls := make([]string, n)
+	for i := range sqls {
+		if i%7 == 3 {
+			sqls[i] = fmt.Sprintf("SELECT 'unterminated %d", i)
+		} else {
+			sqls[i] = fmt.Sprintf("SELECT c%d FROM t WHERE id = %d", i%50, i)
+		}
+	}
+	return sqls
+}
+
+func TestDigester_DigestMany(t *testing.T) {
+	d := NewDigester(Options{Version: MySQL57})
+	sqls := batchInput(500)
+	got, errs := d.DigestMany(context.Background(), sqls)
+	if len(got) != len(sqls) || len(errs) != len(sqls) {
+		t.Fatalf("got %d digests and %d errors for %d statements", len(got), len(errs), len(sqls))
+	}
+	for i, sql := range sqls {
+		want, wantErr := d.Digest(sql)
+		if got[i] != want || (errs[i] == nil) != (wantErr == nil) {
+			t.Errorf("%d: DigestMany = %v, %v; Digest = %v, %v", i, got[i], errs[i], want, wantErr)
+		}
+	}
+}
+
+func TestDigester_DigestManyCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, errs := NewDigester().DigestMany(ctx, batchInput(100))
+	for i, err := range errs {
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("%d: error = %v, want %v", i, err, context.Canceled)
+		}
+	}
+}
+
+func TestDigester_DigestChan(t *testing.T) {
+	d := NewDigester()
+	sqls := batchInput(500)
+	in := make(chan string)
+	go func() {
+		defer close(in)
+		for _, sql := range sqls {
+			in <- sql
+		}
+	}()
+
+	i := 0
+	for r := range d.DigestChan(context.Background(), in) {
+		want, wantErr := d.Digest(sqls[i])
+		if r.Digest != want || (r.Err == nil) != (wantErr == nil) {
+			t.Errorf("%d: DigestChan = %v, %v; Digest = %v, %v", i, r.Digest, r.Err, want, wantErr)
+		}
+		i++
+	}
+	if i != len(sqls) {
+		t.Errorf("got %d results for %d statements", i, len(sqls))
+	}
+}
+
+func TestDigester_DigestChanCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan string) // never closed
+	out := NewDigester().DigestChan(ctx, in)
+	in <- "SELECT 1"
+	if r := <-out; r.Err != nil || r.Digest.Text != "SELECT ?" {
+		t.Fatalf("first result = %v, %v", r.Digest, r.Err)
+	}
+	cancel()
+	if _, ok := <-out; ok {
+		t.Error("results channel still open after cancel")
+	}
+}
+
+func TestDigester_DigestSeq(t *testing.T) {
+	d := NewDigester()
+	sqls := batchInput(200)
+
+	i := 0
+	for got, err := range d.DigestSeq(context.Background(), slices.Values(sqls)) {
+		want, wantErr := d.Digest(sqls[i])
+		if got != want || (err == nil) != (wantErr == nil) {
+			t.Errorf("%d: DigestSeq = %v, %v; Digest = %v, %v", i, got, err, want, wantErr)
+		}
+		i++
+	}
+	if i != len(sqls) {
+		t.Errorf("got %d results for %d statements", i, len(sqls))
+	}
+
+	// Stopping early must not leave the producer blocked.
+	n := 0
+	for range d.DigestSeq(context.Background(), slices.Values(sqls)) {
+		if n++; n == 10 {
+			break
+		}
+	}
+}
+
+func BenchmarkDigester_DigestMany(b *testing.B) {
+	d := NewDigester()
+	sqls := batchInput(1000)
+	ctx := context.Background()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.DigestMany(ctx, sqls)
+	}
+}
+
+func TestCachingDigester(t *testing.T) {
+	c := NewCachingDigester(Options{Version: MySQL57}, 2)
+	plain := NewDigester(Options{Version: MySQL57})
+
+	for _, sql := range []string{"SELECT 1", "SELECT 2", "SELECT 1", "SELECT 3", "SELECT 2", "SELECT 'x"} {
+		got, err := c.Digest(sql)
+		want, wantErr := plain.Digest(sql)
+		if got != want || (err == nil) != (wantErr == nil) {
+			t.Errorf("%q: got %v, %v; want %v, %v", sql, got, err, want, wantErr)
+		}
+	}
+	// "SELECT 1" hits; "SELECT 3" evicts "SELECT 2", which then misses and
+	// evicts "SELECT 1"; the unterminated string evicts "SELECT 3".
+	want := CacheStats{Hits: 1, Misses: 5, Evictions: 3, Len: 2}
+	if got := c.Stats(); got != want {
+		t.Errorf("stats = %+v, want %+v", got, want)
+	}
+
+	if _, err := c.Digest("SELECT 'x"); err == nil {
+		t.Error("cached error lost")
+	}
+	c.Purge()
+	if got := c.Stats(); got.Len != 0 || got.Hits != 2 {
+		t.Errorf("stats after Purge = %+v", got)
+	}
+}
+
+func TestCachingDigester_Concurrent(t *testing.T) {
+	c := NewCachingDigester(Options{}, 16)
+	sqls := batchInput(64)
+	done := make(chan struct{})
+	for w := range 8 {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for i := range 500 {
+				sql := sqls[(i*(w+1))%len(sqls)]
+				got, _ := c.Digest(sql)
+				if want, _ := Compute(sql); got != want {
+					t.Errorf("%q: got %v, want %v", sql, got, want)
+					return
+				}
+			}
+		}()
+	}
+	for range 8 {
+		<-done
+	}
+	if s := c.Stats(); s.Hits+s.Misses != 8*500 || s.Len > 16 {
+		t.Errorf("stats = %+v", s)
+	}
+}
+
+func TestSyntaxError(t *testing.T) {
+	long := strings.Repeat("x", 40)
+	tests := []struct {
+		sql      string
+		opts     Options
+		kind     ErrorKind
+		sentinel error
+		offset   int
+		line     int
+		column   int
+		snippet  string
+	}{
+		{"SELECT 'abc", Options{}, UnterminatedString, ErrUnterminatedString, 7, 1, 8, "'abc"},
+		{"SELECT a,\n  b FROM t\nWHERE c = \"x", Options{}, UnterminatedString, ErrUnterminatedString, 31, 3, 11, `"x`},
+		{"SELECT `col", Options{}, UnterminatedIdentifier, ErrUnterminatedIdentifier, 7, 1, 8, "`col"},
+		{`SELECT "col`, Options{SQLMode: MODE_ANSI_QUOTES}, UnterminatedIdentifier, ErrUnterminatedIdentifier, 7, 1, 8, `"col`},
+		{"SELECT 1 /* open", Options{}, UnterminatedComment, ErrUnterminatedComment, 9, 1, 10, "/* open"},
+		{"SELECT /*+ BKA(t1) ", Options{}, UnterminatedHint, ErrUnterminatedHint, 7, 1, 8, "/*+ BKA(t1) "},
+		{"SELECT /*+ QB_NAME(`qb) */ 1", Options{}, UnterminatedIdentifier, ErrUnterminatedIdentifier, 19, 1, 20, "`qb) */ 1"},
+		{"SELECT $tag$ body", Options{}, UnterminatedDollarQuote, ErrUnterminatedDollarQuote, 7, 1, 8, "$tag$ body"},
+		{"SELECT X'4g'", Options{}, InvalidHexLiteral, ErrInvalidHexLiteral, 7, 1, 8, "X'4g'"},
+		{"SELECT B'102'", Options{}, InvalidBinaryLiteral, ErrInvalidBinaryLiteral, 7, 1, 8, "B'102'"},
+		{"SELECT '" + long, Options{}, UnterminatedString, ErrUnterminatedString, 7, 1, 8, "'" + long[:31]},
+		{"SELECT 'ééééééééééééééééé", Options{}, UnterminatedString, ErrUnterminatedString, 7, 1, 8, "'ééééééééééééééé"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			_, err := Compute(tt.sql, tt.opts)
+			var se *SyntaxError
+			if !errors.As(err, &se) {
+				t.Fatalf("error = %v, want a *SyntaxError", err)
+			}
+			want := SyntaxError{Kind: tt.kind, Offset: tt.offset, Line: tt.line, Column: tt.column, Snippet: tt.snippet}
+			if *se != want {
+				t.Errorf("error = %+v, want %+v", *se, want)
+			}
+			if !errors.Is(err, tt.sentinel) {
+				t.Errorf("errors.Is(%v, %v) = false", err, tt.sentinel)
+			}
+
+			// Streaming reports the same location.
+			_, err = ComputeReader(iotest.OneByteReader(strings.NewReader(tt.sql)), tt.opts)
+			if !errors.As(err, &se) || *se != want {
+				t.Errorf("ComputeReader error = %v, want %+v", err, want)
+			}
+		})
+	}
+}
+
+func TestSyntaxError_StreamPosition(t *testing.T) {
+	// The error is far past anything the streaming window still holds.
+	line := "SELECT a FROM t WHERE b = 1;\n"
+	sql := strings.Repeat(line, 5000) + "SELECT 'open"
+	_, err := ComputeReader(strings.NewReader(sql))
+	var se *SyntaxError
+	if !errors.As(err, &se) {
+		t.Fatalf("error = %v, want a *SyntaxError", err)
+	}
+	want := SyntaxError{Kind: UnterminatedString, Offset: 5000*len(line) + 7, Line: 5001, Column: 8, Snippet: "'open"}
+	if *se != want {
+		t.Errorf("error = %+v, want %+v", *se, want)
+	}
+}
+
+func TestSyntaxError_Message(t *testing.T) {
+	_, err := Compute("SELECT 1,\n 'abc")
+	want := `syntax error at line 2, column 2: unterminated string literal near "'abc"`
+	if err == nil || err.Error() != want {
+		t.Errorf("error = %v, want %s", err, want)
+	}
+}
+
+func TestDigest_Lenient(t *testing.T) {
+	tests := []struct {
+		sql  string
+		kind ErrorKind
+		text string
+	}{
+		{"SELECT * FROM t WHERE a = 'cut off here", UnterminatedString, "SELECT * FROM `t` WHERE `a` ="},
+		{"INSERT INTO t VALUES (1, 'a'), (2, 'b'), (3, 'c", UnterminatedString, "INSERT INTO `t` VALUES (...) /* , ... */ , ( ? ,"},
+		{"SELECT a FROM t /* trailing", UnterminatedComment, "SELECT `a` FROM `t`"},
+		{"SELECT `unfinished", UnterminatedIdentifier, "SELECT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			for _, v := range []MySQLVersion{MySQL57, MySQL80} {
+				opts := Options{Version: v, Lenient: true}
+				d, err := Compute(tt.sql, opts)
+				if err != nil {
+					t.Fatalf("%v: error = %v, want nil", v, err)
+				}
+				if !d.Truncated || !errors.Is(d.Err, tt.kind.Err()) {
+					t.Errorf("%v: Truncated = %v, Err = %v; want %v", v, d.Truncated, d.Err, tt.kind)
+				}
+				if d.Text != tt.text {
+					t.Errorf("%v: text = %q, want %q", v, d.Text, tt.text)
+				}
+
+				// Strict mode keeps failing. The marker has no text, but it
+				// is part of the hash.
+				strict, err := Compute(tt.sql, Options{Version: v})
+				if err == nil || strict.Truncated || strict.Hash == d.Hash {
+					t.Errorf("%v: strict = %+v, %v", v, strict, err)
+				}
+
+				r, err := ComputeReader(iotest.OneByteReader(strings.NewReader(tt.sql)), opts)
+				if err != nil || r.Hash != d.Hash || r.Text != d.Text || !r.Truncated {
+					t.Errorf("%v: ComputeReader = %+v, %v; want %+v", v, r, err, d)
+				}
+				all, err := ForVersions(tt.sql, []MySQLVersion{v}, opts)
+				if err != nil || all[0].Hash != d.Hash || !all[0].Truncated {
+					t.Errorf("%v: ForVersions = %+v, %v; want %+v", v, all[0], err, d)
+				}
+
+				// Without a Digest to carry it, the error is returned
+				// with the truncated result.
+				dg := NewDigester(opts)
+				text, err := dg.AppendText(nil, []byte(tt.sql))
+				if !errors.Is(err, tt.kind.Err()) || string(text) != d.Text {
+					t.Errorf("%v: AppendText = %q, %v; want %q, %v", v, text, err, d.Text, tt.kind)
+				}
+				sum, err := dg.AppendSum(nil, []byte(tt.sql))
+				if !errors.Is(err, tt.kind.Err()) || hex.EncodeToString(sum) != d.Hash {
+					t.Errorf("%v: AppendSum = %x, %v; want %s, %v", v, sum, err, d.Hash, tt.kind)
+				}
+			}
+		})
+	}
+
+	// A complete statement is not affected.
+	d, err := Compute("SELECT 1", Options{Lenient: true})
+	if want, _ := Compute("SELECT 1"); err != nil || d != want {
+		t.Errorf("lenient = %+v, %v; want %+v", d, err, want)
+	}
+
+	// Read errors are not syntax errors.
+	errBroken := errors.New("broken pipe")
+	r := io.MultiReader(strings.NewReader("SELECT 'a"), iotest.ErrReader(errBroken))
+	if _, err := ComputeReader(r, Options{Lenient: true}); !errors.Is(err, errBroken) {
+		t.Errorf("error = %v, want %v", err, errBroken)
+	}
+}
+
+func TestComputeContext(t *testing.T) {
+	sql := "SELECT " + strings.Repeat("a + ", 1000) + "1"
+	want, _ := Compute(sql)
+	got, err := ComputeContext(context.Background(), sql)
+	if err != nil || got != want {
+		t.Errorf("ComputeContext = %+v, %v; want %+v", got, err, want)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := ComputeContext(ctx, sql); !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want %v", err, context.Canceled)
+	}
+	if _, err := NewDigester().DigestContext(ctx, sql); !errors.Is(err, context.Canceled) {
+		t.Errorf("DigestContext error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestOptions_Limits(t *testing.T) {
+	sql := "SELECT a, b FROM t WHERE c = 1" // 10 tokens, 30 bytes
+	tests := []struct {
+		name string
+		opts Options
+		want error
+	}{
+		{"no limits", Options{}, nil},
+		{"bytes at limit", Options{MaxInputBytes: 30}, nil},
+		{"bytes over limit", Options{MaxInputBytes: 29}, ErrInputTooLarge},
+		{"tokens at limit", Options{MaxTokens: 10}, nil},
+		{"tokens over limit", Options{MaxTokens: 9}, ErrTooManyTokens},
+		{"tokens over limit, lenient", Options{MaxTokens: 9, Lenient: true}, ErrTooManyTokens},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(what string, err error) {
+				t.Helper()
+				if !errors.Is(err, tt.want) || (tt.want == nil) != (err == nil) {
+					t.Errorf("%s: error = %v, want %v", what, err, tt.want)
+				}
+				var limitErr *LimitError
+				if tt.want != nil && !errors.As(err, &limitErr) {
+					t.Errorf("%s: error %v is not a *LimitError", what, err)
+				}
+			}
+			_, err := Compute(sql, tt.opts)
+			check("Compute", err)
+			_, err = NewDigester(tt.opts).AppendSum(nil, []byte(sql))
+			check("AppendSum", err)
+			_, err = ComputeReader(iotest.OneByteReader(strings.NewReader(sql)), tt.opts)
+			check("ComputeReader", err)
+			_, err = ForVersions(sql, AllVersions, tt.opts)
+			check("ForVersions", err)
+		})
+	}
+
+	_, err := Compute(sql, Options{MaxTokens: 9})
+	if want := "statement has too many tokens: limit is 9 tokens"; err == nil || err.Error() != want {
+		t.Errorf("error = %v, want %s", err, want)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	typed := RedactOptions{TypedPlaceholders: true}
+	strip := RedactOptions{StripComments: true}
+	collapse := RedactOptions{CollapseInLists: true}
+	tests := []struct {
+		sql  string
+		opts RedactOptions
+		want string
+	}{
+		{"select Name from users  where id = 42 -- lookup", RedactOptions{}, "select Name from users  where id = ? -- lookup"},
+		{"SELECT *\n  FROM `Orders`\n WHERE total > -12.5 AND note = 'it''s'", RedactOptions{}, "SELECT *\n  FROM `Orders`\n WHERE total > -? AND note = ?"},
+		{"SELECT 'a' 'b'  \"c\", 'd'", RedactOptions{}, "SELECT ?, ?"},
+		{"SELECT * FROM t WHERE a = ? AND b IS NULL", RedactOptions{}, "SELECT * FROM t WHERE a = ? AND b IS NULL"},
+		{"SELECT 1, 1.5, 1e3, 0x1F, b'101', N'x', 'y', ?", typed, "SELECT ?int, ?dec, ?float, ?hex, ?bin, ?str, ?str, ?"},
+		{"SELECT /*+ SET_VAR(sort_buffer_size = 16) */ a FROM t WHERE b = 1", RedactOptions{}, "SELECT /*+ SET_VAR(sort_buffer_size = ?) */ a FROM t WHERE b = ?"},
+		{"SELECT /*+ SET_VAR(sort_buffer_size='secret') */ a FROM t", RedactOptions{}, "SELECT /*+ SET_VAR(sort_buffer_size=?) */ a FROM t"},
+		{"SELECT /*+ MAX_EXECUTION_TIME(1000) QB_NAME(qb) SET_VAR(sql_mode = 'ANSI') */ a IN (1, 2) FROM t", RedactOptions{TypedPlaceholders: true, CollapseInLists: true}, "SELECT /*+ MAX_EXECUTION_TIME(?int) QB_NAME(qb) SET_VAR(sql_mode = ?str) */ a IN (?int, ...) FROM t"},
+		{"/* app:web */ SELECT a /* inline */ FROM t # trailing", strip, "SELECT a FROM t"},
+		{"SELECT a/**/FROM t -- x\nWHERE b = 'c'", strip, "SELECT a FROM t\nWHERE b = ?"},
+		{"SELECT /*!40001 SQL_NO_CACHE */ a FROM t /* x */", strip, "SELECT /*!40001 SQL_NO_CACHE */ a FROM t"},
+		{"SELECT a FROM t /*!80000 WHERE pwd = 'secret' */", RedactOptions{Options: Options{Version: MySQL57}}, "SELECT a FROM t /*!80000 WHERE pwd = ? */"},
+		{"SELECT a FROM t /*!80000 WHERE pwd = 'secret' */", RedactOptions{}, "SELECT a FROM t /*!80000 WHERE pwd = ? */"},
+		{"SELECT a FROM t /*!99999 WHERE pwd = 'secret' # x\n */", RedactOptions{Options: Options{Version: MySQL90}, StripComments: true}, "SELECT a FROM t /*!99999 WHERE pwd = ?\n */"},
+		{"SELECT a /*!99999 , 'secret */ FROM t", RedactOptions{}, "SELECT a /*!99999 */ FROM t"},
+		{"SELECT a /*!123 'secret' */ FROM t", RedactOptions{}, "SELECT a /*!123 ? */ FROM t"},
+		{"SELECT a FROM t WHERE id IN (1, 2, -3, 'four')", collapse, "SELECT a FROM t WHERE id IN (?, ...)"},
+		{"SELECT a FROM t WHERE id NOT IN ( 7 ,8 )", RedactOptions{CollapseInLists: true, TypedPlaceholders: true}, "SELECT a FROM t WHERE id NOT IN (?int, ...)"},
+		{"SELECT a FROM t WHERE id IN (1)", collapse, "SELECT a FROM t WHERE id IN (?)"},
+		{"SELECT a FROM t WHERE id IN (1, b)", collapse, "SELECT a FROM t WHERE id IN (?, b)"},
+		{"SELECT a FROM t WHERE id IN (SELECT 1)", collapse, "SELECT a FROM t WHERE id IN (SELECT ?)"},
+		{`SELECT "x" FROM t`, RedactOptions{Options: Options{SQLMode: MODE_ANSI_QUOTES}}, `SELECT "x" FROM t`},
+		{"SELECT 1 \x00 'not lexed'", RedactOptions{}, "SELECT ? "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			got, err := Redact(tt.sql, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Redact = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedact_Error(t *testing.T) {
+	got, err := Redact("SELECT * FROM t WHERE password = 'hunter2")
+	if !errors.Is(err, ErrUnterminatedString) || got != "" {
+		t.Errorf("Redact = %q, %v; want no text and %v", got, err, ErrUnterminatedString)
+	}
+}
+
+func TestDigest_LiteralMode(t *testing.T) {
+	tests := []struct {
+		sql      string
+		verbatim string
+	}{
+		{"SELECT * FROM t WHERE a = -5 AND b IN (1, 'x', NULL) AND c IS NULL",
+			"SELECT * FROM `t` WHERE `a` = -5 AND `b` IN (1, 'x', NULL) AND `c` IS NULL"},
+		{"INSERT INTO t (a, b) VALUES (1,'a'),(2,\"b\")",
+			"INSERT INTO `t` ( `a` , `b` ) VALUES (1, 'a'), (2, \"b\")"},
+		{"SELECT x'4F', 1.5e3 FROM t LIMIT 10",
+			"SELECT x'4F', 1.5e3 FROM `t` LIMIT 10"},
+	}
+	for _, tt := range tests {
+		for _, v := range []MySQLVersion{MySQL57, MySQL80} {
+			want, err := Compute(tt.sql, Options{Version: v})
+			if err != nil {
+				t.Fatal(err)
+			}
+			verbatim, _ := Compute(tt.sql, Options{Version: v, LiteralMode: LiteralVerbatim})
+			hmac, _ := Compute(tt.sql, Options{Version: v, LiteralMode: LiteralHMAC, LiteralKey: []byte("k1")})
+			if verbatim.Hash != want.Hash || hmac.Hash != want.Hash {
+				t.Errorf("%v %q: literal modes changed the hash", v, tt.sql)
+			}
+			if v == MySQL80 && verbatim.Text != tt.verbatim {
+				t.Errorf("verbatim text = %q, want %q", verbatim.Text, tt.verbatim)
+			}
+			all, _ := ForVersions(tt.sql, []MySQLVersion{v}, Options{LiteralMode: LiteralVerbatim})
+			if all[0] != verbatim {
+				t.Errorf("%v: ForVersions = %+v, want %+v", v, all[0], verbatim)
+			}
+		}
+	}
+
+	hmacText := func(sql string, key string) string {
+		d, err := Compute(sql, Options{LiteralMode: LiteralHMAC, LiteralKey: []byte(key)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d.Text
+	}
+	a := hmacText("SELECT * FROM t WHERE id = 42 AND name = 'bob'", "k1")
+	if a != hmacText("select * from t where id = 42 and name = 'bob'", "k1") {
+		t.Error("equal literals gave different HMAC tokens")
+	}
+	if a == hmacText("SELECT * FROM t WHERE id = 43 AND name = 'bob'", "k1") {
+		t.Error("different literals gave the same HMAC tokens")
+	}
+	if a == hmacText("SELECT * FROM t WHERE id = 42 AND name = 'bob'", "k2") {
+		t.Error("the key does not change the HMAC tokens")
+	}
+	if strings.Contains(a, "42") || strings.Contains(a, "bob") || strings.Count(a, "?#") != 2 {
+		t.Errorf("HMAC text = %q", a)
+	}
+	// A 64-bit tag: short ones collide across a workload.
+	got := hmacText("SELECT 42", "secret")
+	if tag, ok := strings.CutPrefix(got, "SELECT ?#"); !ok || len(tag) != 16 {
+		t.Errorf("HMAC text = %q, want a tag of 16 hex digits", got)
+	}
+}
+
+func TestDigest_LiteralModeErrors(t *testing.T) {
+	opts := Options{LiteralMode: LiteralHMAC}
+	sql := "SELECT * FROM t WHERE id = 42"
+	if _, err := Compute(sql, opts); !errors.Is(err, ErrNoLiteralKey) {
+		t.Errorf("Compute: error = %v, want %v", err, ErrNoLiteralKey)
+	}
+	if _, err := ComputeReader(strings.NewReader(sql), opts); !errors.Is(err, ErrNoLiteralKey) {
+		t.Errorf("ComputeReader: error = %v, want %v", err, ErrNoLiteralKey)
+	}
+	if _, err := ForVersions(sql, AllVersions, opts); !errors.Is(err, ErrNoLiteralKey) {
+		t.Errorf("ForVersions: error = %v, want %v", err, ErrNoLiteralKey)
+	}
+	if _, _, err := Trace(sql, opts); !errors.Is(err, ErrNoLiteralKey) {
+		t.Errorf("Trace: error = %v, want %v", err, ErrNoLiteralKey)
+	}
+}
+
+func TestDigest_LiteralModeLongList(t *testing.T) {
+	// Folding must not respell the list for every value: 200k values
+	// took minutes when it did.
+	const n = 200000
+	var sql, want strings.Builder
+	sql.WriteString("SELECT * FROM t WHERE id IN (")
+	want.WriteString("SELECT * FROM `t` WHERE `id` IN (")
+	for i := range n {
+		if i > 0 {
+			sql.WriteString(",")
+			want.WriteString(", ")
+		}
+		fmt.Fprintf(&sql, "%d", i)
+		fmt.Fprintf(&want, "%d", i)
+	}
+	sql.WriteString(")")
+	want.WriteString(")")
+
+	d, err := Compute(sql.String(), Options{LiteralMode: LiteralVerbatim})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Text != want.String() {
+		t.Errorf("text = %.80q..., want %.80q...", d.Text, want.String())
+	}
+
+	// Past MaxLength the list is not spelled at all, yet the text is cut
+	// as before.
+	for _, max := range []int{10, 50, 1024} {
+		opts := Options{LiteralMode: LiteralVerbatim, MaxLength: max}
+		d, err := Compute(sql.String(), opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := want.String()[:max] + "..."; d.Text != want {
+			t.Errorf("MaxLength %d: text = %q, want %q", max, d.Text, want)
+		}
+		r, err := ComputeReader(strings.NewReader(sql.String()), opts)
+		if err != nil || r != d {
+			t.Errorf("MaxLength %d: ComputeReader = %+v, %v; want %+v", max, r, err, d)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	edit := func(op DiffOp, apos, bpos int, a, b []string, reason string) DiffEdit {
+		return DiffEdit{Op: op, APos: apos, BPos: bpos, A: a, B: b, Reason: reason}
+	}
+	tests := []struct {
+		a, b  string
+		edits []DiffEdit
+	}{
+		{"SELECT * FROM t WHERE a IN (1, 2)", "select * from t where a in (3,4,5)", nil},
+		{"SELECT u.id FROM users u", "SELECT x.id FROM users x", []DiffEdit{
+			edit(DiffReplace, 1, 1, []string{"`u`"}, []string{"`x`"}, "different identifier or alias"),
+			edit(DiffReplace, 6, 6, []string{"`u`"}, []string{"`x`"}, "different identifier or alias"),
+		}},
+		{"SELECT a FROM t", "SELECT A FROM t", []DiffEdit{
+			edit(DiffReplace, 1, 1, []string{"`a`"}, []string{"`A`"}, "identifiers are case sensitive"),
+		}},
+		{"SELECT * FROM t WHERE a IN (1)", "SELECT * FROM t WHERE a = 1", []DiffEdit{
+			edit(DiffReplace, 6, 6, []string{"IN (...)"}, []string{"=", "?"}, "IN list against a comparison"),
+		}},
+		{"SELECT * FROM t LIMIT 10, 20", "SELECT * FROM t LIMIT 20 OFFSET 10", []DiffEdit{
+			edit(DiffReplace, 5, 5, []string{"?, ..."}, []string{"?", "OFFSET", "?"}, "LIMIT offset written differently"),
+		}},
+		{"INSERT INTO t VALUES (1)", "INSERT INTO t VALUES (1, 2)", []DiffEdit{
+			edit(DiffReplace, 4, 4, []string{"(?)"}, []string{"(...)"}, "different number of values"),
+		}},
+		{"SELECT /*!80000 SQL_NO_CACHE */ a FROM t", "SELECT a FROM t", []DiffEdit{
+			edit(DiffDelete, 1, 1, []string{"SQL_NO_CACHE"}, nil, "tokens from a versioned comment"),
+		}},
+		{"SELECT '/*!' FROM t", "SELECT '/*!', b FROM t", []DiffEdit{
+			edit(DiffInsert, 2, 2, nil, []string{",", "`b`"}, ""),
+		}},
+		{"SELECT /*!80000 SQL_NO_CACHE */ a FROM t", "SELECT /*!80000 SQL_NO_CACHE */ a, b FROM t", []DiffEdit{
+			edit(DiffInsert, 3, 3, nil, []string{",", "`b`"}, ""),
+		}},
+		{"SELECT a FROM t", "SELECT a /*!80000 , b */ FROM t", []DiffEdit{
+			edit(DiffInsert, 2, 2, nil, []string{",", "`b`"}, "tokens from a versioned comment"),
+		}},
+		{"SELECT a FROM t", "SELECT a, b FROM t ORDER BY a", []DiffEdit{
+			edit(DiffInsert, 2, 2, nil, []string{",", "`b`"}, ""),
+			edit(DiffInsert, 4, 6, nil, []string{"ORDER", "BY", "`a`"}, ""),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a, func(t *testing.T) {
+			d, err := Diff(tt.a, tt.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.Same() != (tt.edits == nil) {
+				t.Errorf("Same() = %v", d.Same())
+			}
+			for i := range d.Edits {
+				d.Edits[i].ANames, d.Edits[i].BNames = nil, nil
+			}
+			if fmt.Sprint(d.Edits) != fmt.Sprint(tt.edits) {
+				t.Errorf("edits:\n got: %+v\nwant: %+v\n%s", d.Edits, tt.edits, d)
+			}
+		})
+	}
+
+	// Names tell apart the tokens that are spelled alike.
+	d, err := Diff("SELECT a FROM t", "SELECT 1 FROM t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := d.Edits[0]; fmt.Sprint(e.ANames, e.BNames) != "[(tok_id)] [?]" {
+		t.Errorf("names = %q, %q", e.ANames, e.BNames)
+	}
+
+	// The options reach both statements: a versioned comment newer than
+	// the server is skipped in either.
+	const withComment, without = "SELECT /*!80000 SQL_NO_CACHE */ a FROM t", "SELECT a FROM t"
+	for _, tt := range []struct {
+		version MySQLVersion
+		same    bool
+	}{{MySQL80, false}, {MySQL57, true}} {
+		d, err := Diff(withComment, without, Options{Version: tt.version})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.Same() != tt.same {
+			t.Errorf("version %v: Same() = %v, want %v\n%s", tt.version, d.Same(), tt.same, d)
+		}
+	}
+
+	if _, err := Diff("SELECT 1", "SELECT 'x"); !errors.Is(err, ErrUnterminatedString) {
+		t.Errorf("error = %v, want %v", err, ErrUnterminatedString)
+	}
+}
+
+func TestDigestDiff_String(t *testing.T) {
+	d, err := Diff("SELECT * FROM t WHERE a IN (1)", "SELECT * FROM t WHERE a = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "A: " + d.A.Hash + " SELECT * FROM `t` WHERE `a` IN (...)\n" +
+		"B: " + d.B.Hash + " SELECT * FROM `t` WHERE `a` = ?\n" +
+		"token 7/7: IN (...) vs = ? (IN list against a comparison)\n"
+	if got := d.String(); got != want {
+		t.Errorf("String() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestTrace(t *testing.T) {
+	d, events, err := Trace("SELECT a FROM t WHERE b IN (-1, 2);")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := Compute("SELECT a FROM t WHERE b IN (-1, 2);"); d != want {
+		t.Errorf("digest = %+v, want %+v", d, want)
+	}
+
+	var rules []string
+	lexed := 0
+	for _, e := range events {
+		switch e.Kind {
+		case TraceLex:
+			lexed++
+		case TraceReduce:
+			rules = append(rules, e.Rule)
+		}
+	}
+	if lexed != 15 {
+		t.Errorf("%d tokens lexed, want 15", lexed)
+	}
+	want := []string{RuleUnarySign, RuleValueList, RuleRow, RuleInList, RuleSemicolon}
+	if !slices.Equal(rules, want) {
+		t.Errorf("rules = %q, want %q", rules, want)
+	}
+
+	// The identifier a: its 2-byte token, a 2-byte length and the name.
+	push := events[3]
+	if push.Kind != TracePush || push.Name != "(tok_id)" || hex.EncodeToString(push.Record) != "5204010061" {
+		t.Errorf("push of a = %+v", push)
+	}
+	num := events[slices.IndexFunc(events, func(e TraceEvent) bool { return e.Text == "1" })]
+	if num.Name != "(num)" || fmt.Sprint(num.States) != "[START NUMBER_IDENT INT_OR_REAL]" {
+		t.Errorf("lex of 1 = %+v", num)
+	}
+	last := events[len(events)-1]
+	if got := strings.Join(last.Stack, " "); got != "SELECT `a` FROM `t` WHERE `b` IN (...)" {
+		t.Errorf("final stack = %q", got)
+	}
+
+	_, events, err = Trace("SELECT 'x")
+	if !errors.Is(err, ErrUnterminatedString) {
+		t.Fatalf("error = %v", err)
+	}
+	if n := len(events); n != 3 || events[n-1].Name != "(abort)" {
+		t.Errorf("events = %+v", events)
+	}
+}
+
+var update = flag.Bool("update", false, "rewrite golden files")
+
+// TestDigest_Pretty compares Pretty(60) of the digest of each
+// testdata/pretty/*.sql with the .golden file next to it.
+func TestDigest_Pretty(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "pretty", "*.sql"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no test statements: %v", err)
+	}
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".sql")
+		t.Run(name, func(t *testing.T) {
+			sql, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d, err := Compute(string(sql))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := d.Pretty(60) + "\n"
+
+			golden := strings.TrimSuffix(file, ".sql") + ".golden"
+			if *update {
+				if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != string(want) {
+				t.Errorf("differs from %s; run go test -update to refresh\n%s", golden, got)
+			}
+
+			// The layout only moves tokens around.
+			again, err := Compute(got, Options{InputIsDigestText: true})
+			if err != nil || again.Hash != d.Hash {
+				t.Errorf("pretty text digests to %s (%v), want %s", again.Hash, err, d.Hash)
+			}
+			for _, line := range strings.Split(got, "\n") {
+				if len(line) > 60 {
+					t.Errorf("line longer than 60 bytes: %q", line)
+				}
+			}
+		})
+	}
+}
+
+func TestDigest_PrettyNoWidth(t *testing.T) {
+	d, err := Compute("SELECT a, b FROM t WHERE c = 1 AND d = 'x'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "SELECT `a`, `b`\nFROM `t`\nWHERE `c` = ?\n  AND `d` = ?"
+	if got := d.Pretty(0); got != want {
+		t.Errorf("Pretty(0) =\n%s\nwant\n%s", got, want)
+	}
+
+	// Only the dot after an identifier joins a qualified name.
+	d, err = Compute("SELECT u.id, @@global.x FROM t ORDER BY. DEC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = "SELECT `u`.`id`, @@GLOBAL . `x`\nFROM `t`\nORDER BY . DECIMAL"
+	if got := d.Pretty(0); got != want {
+		t.Errorf("Pretty(0) =\n%s\nwant\n%s", got, want)
+	}
+
+	// A text cut by MaxLength in the middle of an identifier is kept.
+	d, err = Compute("SELECT abcdef FROM t", Options{MaxLength: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Pretty(0); got != "SELECT `ab..." {
+		t.Errorf("Pretty(0) of %q = %q", d.Text, got)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	sql := "SELECT /*+ BKA(t) */ a, 'x' FROM t -- c\nWHERE b = ? /*!80000 AND d = 1.5 */"
+	type span struct {
+		text  string
+		class TokenClass
+	}
+	var got []span
+	for _, s := range Highlight(sql) {
+		if s.Class != ClassPlain {
+			got = append(got, span{sql[s.Start:s.End], s.Class})
+		}
+	}
+	want := []span{
+		{"SELECT", ClassKeyword}, {"/*+ BKA(t) */", ClassHint},
+		{"a", ClassIdentifier}, {",", ClassOperator}, {"'x'", ClassLiteral},
+		{"FROM", ClassKeyword}, {"t", ClassIdentifier}, {"-- c", ClassComment},
+		{"WHERE", ClassKeyword}, {"b", ClassIdentifier}, {"=", ClassOperator},
+		{"?", ClassPlaceholder}, {"/*!80000 ", ClassComment}, {"AND", ClassKeyword},
+		{"d", ClassIdentifier}, {"=", ClassOperator}, {"1.5", ClassLiteral},
+		{"*/", ClassComment},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("spans:\n got: %v\nwant: %v", got, want)
+	}
+
+	// An unterminated string ends in one plain span.
+	spans := Highlight("SELECT 'abc")
+	if last := spans[len(spans)-1]; last.Start != 7 || last.Class != ClassPlain {
+		t.Errorf("last span = %+v", last)
+	}
+}
+
+func TestHTML(t *testing.T) {
+	got := HTML("SELECT a<b")
+	want := `<span class="sql-keyword">SELECT</span> <span class="sql-identifier">a</span>` +
+		`<span class="sql-operator">&lt;</span><span class="sql-identifier">b</span>`
+	if got != want {
+		t.Errorf("HTML() =\n%s\nwant\n%s", got, want)
+	}
+
+	d, err := Compute("SELECT * FROM t WHERE id IN (1, 2)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = `<span class="sql-keyword">SELECT</span> <span class="sql-operator">*</span> ` +
+		`<span class="sql-keyword">FROM</span> <span class="sql-identifier">` + "`t`" + `</span> ` +
+		`<span class="sql-keyword">WHERE</span> <span class="sql-identifier">` + "`id`" + `</span> ` +
+		`<span class="sql-placeholder">IN (...)</span>`
+	if got := d.HTML(); got != want {
+		t.Errorf("Digest.HTML() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestCompute_Prepared(t *testing.T) {
+	plain, err := Compute("SELECT * FROM t WHERE a IN (?, ?) AND b = ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prepared, err := Compute("SELECT * FROM t WHERE a IN (?, ?) AND b = ?", Options{Prepared: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "SELECT * FROM `t` WHERE `a` IN (...) AND `b` = ?"; prepared.Text != want {
+		t.Errorf("Text = %q, want %q", prepared.Text, want)
+	}
+	if plain.Hash == prepared.Hash {
+		t.Errorf("? digests the same with and without Prepared: %q", plain.Text)
+	}
+	if d, _ := Compute("SELECT * FROM t WHERE a IN (1, 2) AND b = 'x'"); d.Hash != prepared.Hash {
+		t.Errorf("parameter markers digest unlike literals: %q vs %q", prepared.Text, d.Text)
+	}
+}
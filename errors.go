@@ -0,0 +1,53 @@
+package digest
+
+import "github.com/rashiq/mysql-digest/internal"
+
+// SyntaxError is returned for a statement that cannot be lexed. It gives
+// the Kind of problem, where the failing token starts as a byte Offset and
+// as a 1-based Line and Column, and a Snippet of at most 32 bytes from
+// there. It matches the sentinel error of its Kind with errors.Is:
+//
+//	var se *digest.SyntaxError
+//	if errors.As(err, &se) {
+//		fmt.Println(se.Line, se.Column, se.Snippet)
+//	}
+//	if errors.Is(err, digest.ErrUnterminatedString) { ... }
+type SyntaxError = internal.SyntaxError
+
+// ErrorKind says what made a statement impossible to lex.
+type ErrorKind = internal.ErrorKind
+
+const (
+	UnterminatedString      = internal.UnterminatedString
+	UnterminatedComment     = internal.UnterminatedComment
+	UnterminatedIdentifier  = internal.UnterminatedIdentifier
+	UnterminatedHint        = internal.UnterminatedHint
+	UnterminatedDollarQuote = internal.UnterminatedDollarQuote
+	InvalidHexLiteral       = internal.InvalidHexLiteral
+	InvalidBinaryLiteral    = internal.InvalidBinaryLiteral
+)
+
+// Sentinel errors matching a SyntaxError of each kind.
+var (
+	ErrUnterminatedString      = internal.ErrUnterminatedString
+	ErrUnterminatedComment     = internal.ErrUnterminatedComment
+	ErrUnterminatedIdentifier  = internal.ErrUnterminatedIdentifier
+	ErrUnterminatedHint        = internal.ErrUnterminatedHint
+	ErrUnterminatedDollarQuote = internal.ErrUnterminatedDollarQuote
+	ErrInvalidHexLiteral       = internal.ErrInvalidHexLiteral
+	ErrInvalidBinaryLiteral    = internal.ErrInvalidBinaryLiteral
+)
+
+// LimitError reports a statement over Options.MaxInputBytes or
+// Options.MaxTokens. It matches ErrInputTooLarge or ErrTooManyTokens with
+// errors.Is.
+type LimitError = internal.LimitError
+
+var (
+	ErrInputTooLarge = internal.ErrInputTooLarge
+	ErrTooManyTokens = internal.ErrTooManyTokens
+)
+
+// ErrNoLiteralKey is returned for Options with LiteralHMAC and no
+// LiteralKey.
+var ErrNoLiteralKey = internal.ErrNoLiteralKey
@@ -0,0 +1,65 @@
+package digest
+
+import (
+	"html"
+	"strings"
+
+	"github.com/rashiq/mysql-digest/internal"
+)
+
+// TokenClass is what a piece of a statement is highlighted as.
+type TokenClass = internal.TokenClass
+
+const (
+	ClassPlain       = internal.ClassPlain
+	ClassKeyword     = internal.ClassKeyword
+	ClassIdentifier  = internal.ClassIdentifier
+	ClassPlaceholder = internal.ClassPlaceholder
+	ClassLiteral     = internal.ClassLiteral
+	ClassOperator    = internal.ClassOperator
+	ClassHint        = internal.ClassHint
+	ClassComment     = internal.ClassComment
+)
+
+// Span is the piece sql[Start:End] of a statement passed to Highlight.
+type Span = internal.Span
+
+// Highlight splits sql into spans of one TokenClass each, for syntax
+// highlighting. The spans cover all of sql, in order. SQLMode and
+// InputIsDigestText are taken from opts; with InputIsDigestText, the value
+// tokens of a digest text are placeholders. Text the lexer cannot read
+// on, like an unterminated string, is one ClassPlain span.
+func Highlight(sql string, opts ...Options) []Span {
+	var opt Options
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	return internal.Highlight(newLexer(sql, opt))
+}
+
+// HTML returns sql as HTML escaped text, with every token and comment in
+// a <span> whose class is "sql-" and its TokenClass, as in
+// <span class="sql-keyword">SELECT</span>. Blanks are left out of spans.
+// opts are used as in Highlight.
+func HTML(sql string, opts ...Options) string {
+	var b strings.Builder
+	b.Grow(len(sql) * 3)
+	for _, s := range Highlight(sql, opts...) {
+		text := html.EscapeString(sql[s.Start:s.End])
+		if s.Class == ClassPlain {
+			b.WriteString(text)
+			continue
+		}
+		b.WriteString(`<span class="sql-`)
+		b.WriteString(s.Class.String())
+		b.WriteString(`">`)
+		b.WriteString(text)
+		b.WriteString("</span>")
+	}
+	return b.String()
+}
+
+// HTML returns d.Text as HTML, as HTML does for a digest text.
+func (d Digest) HTML() string {
+	return HTML(d.Text, Options{InputIsDigestText: true})
+}
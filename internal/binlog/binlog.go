@@ -0,0 +1,377 @@
+// Package binlog reads the statements in MySQL binary log files: the SQL
+// of statement-format QUERY_EVENTs, and the original statements that
+// ROWS_QUERY_LOG_EVENTs carry for row-format changes when
+// binlog_rows_query_log_events is on. It reads the v4 format of MySQL 5.0
+// and later, unencrypted.
+package binlog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	digest "github.com/rashiq/mysql-digest"
+)
+
+// Event types.
+const (
+	QueryEvent              = 2
+	FormatDescriptionEvent  = 15
+	RowsQueryEvent          = 29
+	TransactionPayloadEvent = 40
+)
+
+const (
+	headerLength = 19
+	maxEvent     = 1 << 30 // max_allowed_packet at its largest
+	magic        = "\xfebin"
+	magicCrypt   = "\xfdbin"
+)
+
+// ErrEncrypted is returned for files written with binlog_encryption on.
+var ErrEncrypted = errors.New("binlog: encrypted binary logs are not supported")
+
+// The sql_mode bits that change how statements lex, as QUERY_EVENTs
+// store them.
+const (
+	modeANSIQuotes         = 1 << 2
+	modeANSI               = 1 << 18
+	modeNoBackslashEscapes = 1 << 20
+)
+
+// Statement is a statement from a QUERY_EVENT or ROWS_QUERY_LOG_EVENT.
+type Statement struct {
+	Pos      int64     // offset of the event in the file
+	Time     time.Time // when the statement started running on the source
+	ServerID uint32
+	Event    byte // QueryEvent or RowsQueryEvent
+
+	// Schema is the default database the statement ran in, and SQLMode the
+	// @@sql_mode bits it ran with. A ROWS_QUERY_LOG_EVENT has neither, and
+	// takes them from the QUERY_EVENT that began its transaction.
+	Schema  string
+	SQLMode uint64
+
+	// ErrorCode is the error the statement got on the source, for
+	// statements logged although they failed part way.
+	ErrorCode uint16
+	SQL       string
+}
+
+// DigestSQLMode returns the modes of s.SQLMode that digests depend on.
+func (s Statement) DigestSQLMode() digest.SQLMode {
+	var mode digest.SQLMode
+	if s.SQLMode&(modeANSIQuotes|modeANSI) != 0 {
+		mode |= digest.MODE_ANSI_QUOTES
+	}
+	if s.SQLMode&modeNoBackslashEscapes != 0 {
+		mode |= digest.MODE_NO_BACKSLASH_ESCAPES
+	}
+	return mode
+}
+
+// Reader reads the statements of a binary log file.
+type Reader struct {
+	ServerVersion string // of the server that wrote the file
+
+	// Compressed counts the TRANSACTION_PAYLOAD_EVENTs passed over: the
+	// events of transactions written with binlog_transaction_compression
+	// are compressed with zstd, which Reader does not read.
+	Compressed int
+
+	r         *bufio.Reader
+	pos       int64
+	checksum  bool
+	postQuery int // length of the QUERY_EVENT post-header
+
+	schema  string // of the last QUERY_EVENT
+	sqlMode uint64
+}
+
+// NewReader checks the magic number of the file in r and reads its
+// FORMAT_DESCRIPTION_EVENT.
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReaderSize(r, 1<<16)
+	var m [4]byte
+	if _, err := io.ReadFull(br, m[:]); err != nil {
+		return nil, fmt.Errorf("binlog: reading magic number: %w", err)
+	}
+	switch string(m[:]) {
+	case magic:
+	case magicCrypt:
+		return nil, ErrEncrypted
+	default:
+		return nil, fmt.Errorf("binlog: not a binary log file (magic %q)", m[:])
+	}
+	lr := &Reader{r: br, pos: int64(len(m)), postQuery: 13}
+
+	pos := lr.pos
+	e, err := lr.event()
+	if err != nil {
+		return nil, err
+	}
+	if e.typ != FormatDescriptionEvent {
+		return nil, fmt.Errorf("binlog: first event is of type %d, not a format description: not a v4 binary log", e.typ)
+	}
+	if err := lr.formatDescription(e.data, pos); err != nil {
+		return nil, err
+	}
+	return lr, nil
+}
+
+// formatDescription reads a FORMAT_DESCRIPTION_EVENT. It ends with the
+// checksum algorithm of the file, and its own checksum if that is CRC32.
+func (r *Reader) formatDescription(data []byte, pos int64) error {
+	const fixed = 2 + 50 + 4 + 1 // binlog version, server version, created, header length
+	body := data[headerLength:]
+	if len(body) < fixed {
+		return errors.New("binlog: format description event too short")
+	}
+	if v := binary.LittleEndian.Uint16(body); v != 4 {
+		return fmt.Errorf("binlog: binlog version %d, not 4", v)
+	}
+	version, _, _ := bytes.Cut(body[2:52], []byte{0})
+	r.ServerVersion = string(version)
+	if n := body[56]; n != headerLength {
+		return fmt.Errorf("binlog: event header length %d, not %d", n, headerLength)
+	}
+	post := body[fixed:]
+	if hasChecksumAlg(r.ServerVersion) {
+		if len(post) < 5 {
+			return errors.New("binlog: format description event too short")
+		}
+		r.checksum = post[len(post)-5] == 1 // CRC32
+		if r.checksum && !checksumOK(data) {
+			return fmt.Errorf("binlog: event at %d: checksum mismatch", pos)
+		}
+		post = post[:len(post)-5]
+	}
+	if len(post) >= QueryEvent {
+		r.postQuery = int(post[QueryEvent-1])
+	}
+	return nil
+}
+
+// hasChecksumAlg reports whether a server of the given version writes the
+// checksum algorithm in its format description events, as 5.6.1 and
+// later do.
+func hasChecksumAlg(version string) bool {
+	var v [3]int
+	for i, part := range strings.SplitN(version, ".", 3) {
+		digits := strings.IndexFunc(part+"x", func(r rune) bool { return r < '0' || r > '9' })
+		v[i], _ = strconv.Atoi(part[:digits])
+	}
+	return v[0]*10000+v[1]*100+v[2] >= 50601
+}
+
+// checksumOK checks the CRC32 that ends data, an event.
+func checksumOK(data []byte) bool {
+	n := len(data) - 4
+	return n >= headerLength && crc32.ChecksumIEEE(data[:n]) == binary.LittleEndian.Uint32(data[n:])
+}
+
+// event is an event read whole.
+type event struct {
+	typ      byte
+	time     time.Time
+	serverID uint32
+	data     []byte // the header, the event and its checksum
+}
+
+// event reads the next event, or returns io.EOF at the end of the file.
+func (r *Reader) event() (event, error) {
+	var hdr [headerLength]byte
+	if _, err := io.ReadFull(r.r, hdr[:]); err != nil {
+		if err == io.EOF {
+			return event{}, io.EOF
+		}
+		return event{}, fmt.Errorf("binlog: event at %d: %w", r.pos, err)
+	}
+	n := int64(binary.LittleEndian.Uint32(hdr[9:]))
+	if n < headerLength || n > maxEvent {
+		return event{}, fmt.Errorf("binlog: event at %d: length %d", r.pos, n)
+	}
+	data := make([]byte, n)
+	copy(data, hdr[:])
+	if _, err := io.ReadFull(r.r, data[headerLength:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return event{}, fmt.Errorf("binlog: event at %d: %w", r.pos, err)
+	}
+	r.pos += n
+	return event{
+		typ:      hdr[4],
+		time:     time.Unix(int64(binary.LittleEndian.Uint32(hdr[0:])), 0),
+		serverID: binary.LittleEndian.Uint32(hdr[5:]),
+		data:     data,
+	}, nil
+}
+
+// Next returns the next statement, or io.EOF at the end of the file.
+// Events of other types are passed over.
+func (r *Reader) Next() (Statement, error) {
+	for {
+		pos := r.pos
+		e, err := r.event()
+		if err != nil {
+			return Statement{}, err
+		}
+		if e.typ == FormatDescriptionEvent {
+			// A relay log repeats it for each log of the source, whose
+			// checksums may differ.
+			if err := r.formatDescription(e.data, pos); err != nil {
+				return Statement{}, err
+			}
+			continue
+		}
+		body := e.data[headerLength:]
+		if r.checksum {
+			if !checksumOK(e.data) {
+				return Statement{}, fmt.Errorf("binlog: event at %d: checksum mismatch", pos)
+			}
+			body = body[:len(body)-4]
+		}
+		s := Statement{Pos: pos, Time: e.time, ServerID: e.serverID, Event: e.typ}
+		switch e.typ {
+		case QueryEvent:
+			if err := r.query(&s, body); err != nil {
+				return Statement{}, fmt.Errorf("binlog: event at %d: %w", pos, err)
+			}
+			r.schema, r.sqlMode = s.Schema, s.SQLMode
+			return s, nil
+		case RowsQueryEvent:
+			if len(body) < 1 {
+				return Statement{}, fmt.Errorf("binlog: event at %d: rows query event too short", pos)
+			}
+			// The length byte before the statement is its length cut to
+			// 255; the statement runs to the end of the event.
+			s.Schema, s.SQLMode, s.SQL = r.schema, r.sqlMode, string(body[1:])
+			return s, nil
+		case TransactionPayloadEvent:
+			r.Compressed++
+		}
+	}
+}
+
+// query reads the body of a QUERY_EVENT into s: a post-header, status
+// variables, the default database and the statement.
+func (r *Reader) query(s *Statement, body []byte) error {
+	if r.postQuery < 13 || len(body) < r.postQuery {
+		return errors.New("query event too short")
+	}
+	schemaLen := int(body[8])
+	s.ErrorCode = binary.LittleEndian.Uint16(body[9:])
+	varsLen := int(binary.LittleEndian.Uint16(body[11:]))
+	rest := body[r.postQuery:]
+	if len(rest) < varsLen+schemaLen+1 {
+		return errors.New("query event too short")
+	}
+	if mode, ok := statusSQLMode(rest[:varsLen]); ok {
+		s.SQLMode = mode
+	}
+	rest = rest[varsLen:]
+	s.Schema = string(rest[:schemaLen])
+	s.SQL = string(rest[schemaLen+1:]) // after the NUL ending the database
+	return nil
+}
+
+// Status variable codes of QUERY_EVENTs.
+const (
+	qFlags2                  = 0
+	qSQLMode                 = 1
+	qCatalog                 = 2
+	qAutoIncrement           = 3
+	qCharset                 = 4
+	qTimeZone                = 5
+	qCatalogNZ               = 6
+	qLCTimeNames             = 7
+	qCharsetDatabase         = 8
+	qTableMapForUpdate       = 9
+	qMasterDataWritten       = 10
+	qInvoker                 = 11
+	qUpdatedDBNames          = 12
+	qMicroseconds            = 13
+	qExplicitDefaultsForTS   = 16
+	qDDLLoggedWithXID        = 17
+	qDefaultCollationUTF8MB4 = 18
+	qSQLRequirePrimaryKey    = 19
+	qDefaultTableEncryption  = 20
+)
+
+// statusSizes are the lengths of the status variables of a fixed size.
+var statusSizes = map[byte]int{
+	qFlags2:                  4,
+	qSQLMode:                 8,
+	qAutoIncrement:           4,
+	qCharset:                 6,
+	qLCTimeNames:             2,
+	qCharsetDatabase:         2,
+	qTableMapForUpdate:       8,
+	qMasterDataWritten:       4,
+	qMicroseconds:            3,
+	qExplicitDefaultsForTS:   1,
+	qDDLLoggedWithXID:        8,
+	qDefaultCollationUTF8MB4: 2,
+	qSQLRequirePrimaryKey:    1,
+	qDefaultTableEncryption:  1,
+}
+
+// statusSQLMode finds the sql_mode among the status variables of a
+// QUERY_EVENT. As the server does, it stops at a variable it does not
+// know, whose length it cannot tell.
+func statusSQLMode(vars []byte) (uint64, bool) {
+	for len(vars) > 0 {
+		code, v := vars[0], vars[1:]
+		n, fixed := statusSizes[code]
+		switch {
+		case fixed:
+		case code == qCatalog:
+			n = 1 + 1 // the length, and a NUL after the name
+			if len(v) > 0 {
+				n += int(v[0])
+			}
+		case code == qTimeZone, code == qCatalogNZ:
+			n = 1
+			if len(v) > 0 {
+				n += int(v[0])
+			}
+		case code == qInvoker: // user, then host
+			n = 1
+			if len(v) > 0 {
+				n += int(v[0])
+			}
+			if len(v) > n {
+				n += 1 + int(v[n])
+			}
+		case code == qUpdatedDBNames:
+			n = 1
+			if len(v) > 0 && v[0] != 254 { // 254: too many to list
+				for range v[0] {
+					i := bytes.IndexByte(v[n:], 0)
+					if i < 0 {
+						return 0, false
+					}
+					n += i + 1
+				}
+			}
+		default:
+			return 0, false
+		}
+		if len(v) < n {
+			return 0, false
+		}
+		if code == qSQLMode {
+			return binary.LittleEndian.Uint64(v), true
+		}
+		vars = v[n:]
+	}
+	return 0, false
+}
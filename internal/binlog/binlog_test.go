@@ -0,0 +1,220 @@
+package binlog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strings"
+	"testing"
+
+	digest "github.com/rashiq/mysql-digest"
+)
+
+// file builds a binary log.
+type file struct {
+	buf      bytes.Buffer
+	checksum bool
+}
+
+// newFile starts a file with the format description of a server of the
+// given version, which writes CRC32 checksums if checksum is set and its
+// version knows them.
+func newFile(version string, checksum bool) *file {
+	f := &file{}
+	f.buf.WriteString(magic)
+	body := binary.LittleEndian.AppendUint16(nil, 4)
+	v := make([]byte, 50)
+	copy(v, version)
+	body = append(body, v...)
+	body = binary.LittleEndian.AppendUint32(body, 1714557600)
+	body = append(body, headerLength)
+	post := make([]byte, 41)
+	post[QueryEvent-1] = 13
+	body = append(body, post...)
+	if hasChecksumAlg(version) {
+		alg := byte(0)
+		if checksum {
+			alg = 1
+		}
+		// The checksum follows the algorithm even when it is off.
+		body = append(body, alg)
+		f.checksum = true
+		f.event(FormatDescriptionEvent, body)
+		f.checksum = checksum
+		return f
+	}
+	f.event(FormatDescriptionEvent, body)
+	return f
+}
+
+func (f *file) event(typ byte, body []byte) {
+	var hdr [headerLength]byte
+	binary.LittleEndian.PutUint32(hdr[0:], 1714557601)
+	hdr[4] = typ
+	binary.LittleEndian.PutUint32(hdr[5:], 7) // server id
+	n := headerLength + len(body)
+	if f.checksum {
+		n += 4
+	}
+	binary.LittleEndian.PutUint32(hdr[9:], uint32(n))
+	binary.LittleEndian.PutUint32(hdr[13:], uint32(f.buf.Len()+n))
+	data := append(hdr[:], body...)
+	if f.checksum {
+		data = binary.LittleEndian.AppendUint32(data, crc32.ChecksumIEEE(data))
+	}
+	f.buf.Write(data)
+}
+
+// query adds a QUERY_EVENT.
+func (f *file) query(schema string, vars []byte, sql string) {
+	body := binary.LittleEndian.AppendUint32(nil, 42) // thread id
+	body = binary.LittleEndian.AppendUint32(body, 0)  // execution time
+	body = append(body, byte(len(schema)))
+	body = binary.LittleEndian.AppendUint16(body, 0) // error code
+	body = binary.LittleEndian.AppendUint16(body, uint16(len(vars)))
+	body = append(body, vars...)
+	body = append(body, schema...)
+	body = append(body, 0)
+	f.event(QueryEvent, append(body, sql...))
+}
+
+func sqlModeVar(mode uint64) []byte {
+	return binary.LittleEndian.AppendUint64([]byte{qSQLMode}, mode)
+}
+
+const (
+	ansiQuotes = modeANSIQuotes | 1<<21 // with STRICT_TRANS_TABLES
+	flags2     = "\x00\x00\x00\x00\x00"
+	catalog    = "\x06\x03std"
+	charset    = "\x04\x21\x00\x21\x00\x2d\x00"
+	updatedDBs = "\x0c\x01shop\x00"
+	invoker    = "\x0b\x04root\x09localhost"
+)
+
+func statements(t *testing.T, r *Reader) []string {
+	t.Helper()
+	var got []string
+	for {
+		s, err := r.Next()
+		if err == io.EOF {
+			return got
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, fmt.Sprintf("%d %d schema=%s mode=%#x err=%d %q",
+			s.Event, s.ServerID, s.Schema, s.SQLMode, s.ErrorCode, s.SQL))
+	}
+}
+
+func TestReader(t *testing.T) {
+	for _, checksum := range []bool{true, false} {
+		t.Run(fmt.Sprintf("checksum=%v", checksum), func(t *testing.T) {
+			f := newFile("8.0.36-log", checksum)
+			vars := append([]byte(flags2), sqlModeVar(ansiQuotes)...)
+			vars = append(vars, catalog+charset+updatedDBs...)
+			f.query("shop", vars, "BEGIN")
+			f.event(19, []byte("table map"))
+			f.event(RowsQueryEvent, []byte("\x18INSERT INTO t VALUES (1)"))
+			f.event(30, []byte("write rows"))
+			f.event(16, []byte("xid 12345"))
+			f.event(TransactionPayloadEvent, []byte("zstd"))
+			// The sql_mode is after variables of every kind of length.
+			vars = []byte(flags2 + catalog + charset + updatedDBs + invoker + "\x05\x03UTC")
+			f.query("", append(vars, sqlModeVar(modeNoBackslashEscapes)...), `CREATE TABLE "t" (a INT)`)
+			// Past a variable of unknown length, it is out of reach.
+			f.query("shop", append([]byte(flags2+"\x7f"), sqlModeVar(ansiQuotes)...), "DROP TABLE t")
+
+			r, err := NewReader(&f.buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.ServerVersion != "8.0.36-log" {
+				t.Errorf("ServerVersion = %q", r.ServerVersion)
+			}
+			want := []string{
+				`2 7 schema=shop mode=0x200004 err=0 "BEGIN"`,
+				`29 7 schema=shop mode=0x200004 err=0 "INSERT INTO t VALUES (1)"`,
+				`2 7 schema= mode=0x100000 err=0 "CREATE TABLE \"t\" (a INT)"`,
+				`2 7 schema=shop mode=0x0 err=0 "DROP TABLE t"`,
+			}
+			if got := statements(t, r); strings.Join(got, "\n") != strings.Join(want, "\n") {
+				t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+			}
+			if r.Compressed != 1 {
+				t.Errorf("Compressed = %d", r.Compressed)
+			}
+		})
+	}
+}
+
+func TestReader_NoChecksumAlgorithm(t *testing.T) {
+	f := newFile("5.5.62-log", false)
+	f.query("shop", sqlModeVar(0), "UPDATE t SET a = 1")
+	r, err := NewReader(&f.buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `2 7 schema=shop mode=0x0 err=0 "UPDATE t SET a = 1"`
+	if got := statements(t, r); len(got) != 1 || got[0] != want {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestReader_Errors(t *testing.T) {
+	good := func() *file {
+		f := newFile("8.0.36", true)
+		f.query("shop", nil, "UPDATE t SET a = 1")
+		return f
+	}
+	next := func(b []byte) error {
+		r, err := NewReader(bytes.NewReader(b))
+		if err != nil {
+			return err
+		}
+		_, err = r.Next()
+		return err
+	}
+
+	if err := next([]byte("\xfdbin")); !errors.Is(err, ErrEncrypted) {
+		t.Errorf("encrypted: %v", err)
+	}
+	if err := next([]byte("SELECT 1")); err == nil || !strings.Contains(err.Error(), "not a binary log") {
+		t.Errorf("not a binlog: %v", err)
+	}
+	b := good().buf.Bytes()
+	b[len(b)-10] ^= 1
+	if err := next(b); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Errorf("corrupt event: %v", err)
+	}
+	b = good().buf.Bytes()
+	if err := next(b[:len(b)-3]); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("cut short: %v", err)
+	}
+	f := &file{}
+	f.buf.WriteString(magic)
+	f.query("", nil, "SELECT 1")
+	if err := next(f.buf.Bytes()); err == nil || !strings.Contains(err.Error(), "not a format description") {
+		t.Errorf("no format description: %v", err)
+	}
+}
+
+func TestStatement_DigestSQLMode(t *testing.T) {
+	for _, tt := range []struct {
+		mode uint64
+		want digest.SQLMode
+	}{
+		{0, 0},
+		{1 << 21, 0}, // STRICT_TRANS_TABLES
+		{modeANSIQuotes, digest.MODE_ANSI_QUOTES},
+		{modeANSI, digest.MODE_ANSI_QUOTES},
+		{modeNoBackslashEscapes | modeANSIQuotes, digest.MODE_NO_BACKSLASH_ESCAPES | digest.MODE_ANSI_QUOTES},
+	} {
+		if got := (Statement{SQLMode: tt.mode}).DigestSQLMode(); got != tt.want {
+			t.Errorf("%#x: got %v, want %v", tt.mode, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Package conformance checks computed digests against DIGEST and
+// DIGEST_TEXT values captured from real servers' performance_schema.
+//
+// A corpus is either JSON lines:
+//
+//	{"version":"8.0.36","sql_mode":"ANSI_QUOTES","sql":"...","digest":"...","digest_text":"..."}
+//
+// or the tab separated output of mysql --batch with a header row, e.g.
+//
+//	mysql -B -e "SELECT @@version AS version, @@sql_mode AS sql_mode,
+//	  SQL_TEXT, DIGEST, DIGEST_TEXT
+//	  FROM performance_schema.events_statements_history_long"
+package conformance
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Case is one captured statement and the digest the server recorded for it.
+type Case struct {
+	Version    string `json:"version"`
+	SQLMode    string `json:"sql_mode"`
+	SQL        string `json:"sql"`
+	Digest     string `json:"digest"`
+	DigestText string `json:"digest_text"`
+
+	// Source is "file:line" of the case within its corpus.
+	Source string `json:"-"`
+}
+
+// Load reads a corpus in either JSON lines or mysql --batch format. name is
+// used to label cases and errors.
+func Load(r io.Reader, name string) ([]Case, error) {
+	br := bufio.NewReader(r)
+	first, err := firstByte(br)
+	if err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if first == '{' {
+		return loadJSON(br, name)
+	}
+	return loadBatch(br, name)
+}
+
+func firstByte(br *bufio.Reader) (byte, error) {
+	for {
+		b, err := br.Peek(1)
+		if err != nil {
+			return 0, err
+		}
+		if b[0] != ' ' && b[0] != '\t' && b[0] != '\n' && b[0] != '\r' {
+			return b[0], nil
+		}
+		if _, err := br.ReadByte(); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func loadJSON(r io.Reader, name string) ([]Case, error) {
+	var cases []Case
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for n := 1; sc.Scan(); n++ {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var c Case
+		if err := json.Unmarshal(line, &c); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", name, n, err)
+		}
+		c.Source = fmt.Sprintf("%s:%d", name, n)
+		cases = append(cases, c)
+	}
+	return cases, sc.Err()
+}
+
+// batchColumns maps the accepted header names to Case fields.
+var batchColumns = map[string]string{
+	"version":     "version",
+	"@@version":   "version",
+	"sql_mode":    "sql_mode",
+	"@@sql_mode":  "sql_mode",
+	"sql":         "sql",
+	"sql_text":    "sql",
+	"digest":      "digest",
+	"digest_text": "digest_text",
+}
+
+func loadBatch(r io.Reader, name string) ([]Case, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+
+	if !sc.Scan() {
+		return nil, sc.Err()
+	}
+	var cols []string
+	for _, h := range strings.Split(sc.Text(), "\t") {
+		cols = append(cols, batchColumns[strings.ToLower(strings.TrimSpace(h))])
+	}
+
+	var cases []Case
+	for n := 2; sc.Scan(); n++ {
+		if strings.TrimSpace(sc.Text()) == "" {
+			continue
+		}
+		fields := strings.Split(sc.Text(), "\t")
+		if len(fields) != len(cols) {
+			return nil, fmt.Errorf("%s:%d: got %d columns, header has %d", name, n, len(fields), len(cols))
+		}
+		c := Case{Source: fmt.Sprintf("%s:%d", name, n)}
+		for i, f := range fields {
+			v := unescapeBatch(f)
+			switch cols[i] {
+			case "version":
+				c.Version = v
+			case "sql_mode":
+				c.SQLMode = v
+			case "sql":
+				c.SQL = v
+			case "digest":
+				c.Digest = v
+			case "digest_text":
+				c.DigestText = v
+			}
+		}
+		cases = append(cases, c)
+	}
+	return cases, sc.Err()
+}
+
+// unescapeBatch reverses the escaping mysql --batch applies to field values.
+func unescapeBatch(s string) string {
+	if s == "NULL" {
+		return ""
+	}
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case '0':
+			b.WriteByte(0)
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,103 @@
+package conformance
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCorpus(t *testing.T) {
+	paths, err := filepath.Glob("testdata/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			f, err := os.Open(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			cases, err := Load(f, path)
+			if err != nil {
+				t.Fatalf("Load(%s) error: %v", path, err)
+			}
+			if len(cases) == 0 {
+				t.Fatalf("Load(%s) returned no cases", path)
+			}
+
+			r := Run(cases)
+			if len(r.Mismatches) > 0 {
+				var b strings.Builder
+				r.Write(&b, true) //nolint:errcheck
+				t.Errorf("conformance mismatches:\n%s", b.String())
+			}
+		})
+	}
+}
+
+func TestLoad_Batch(t *testing.T) {
+	input := "@@version\tsql_mode\tSQL_TEXT\tDIGEST\tDIGEST_TEXT\n" +
+		"8.0.36\tANSI_QUOTES\tSELECT 'a\\tb'\\n FROM t\tabc\tNULL\n"
+
+	cases, err := Load(strings.NewReader(input), "batch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cases) != 1 {
+		t.Fatalf("got %d cases, want 1", len(cases))
+	}
+
+	c := cases[0]
+	if c.Version != "8.0.36" || c.SQLMode != "ANSI_QUOTES" || c.Digest != "abc" {
+		t.Errorf("unexpected case %+v", c)
+	}
+	if want := "SELECT 'a\tb'\n FROM t"; c.SQL != want {
+		t.Errorf("SQL = %q, want %q", c.SQL, want)
+	}
+	if c.DigestText != "" {
+		t.Errorf("DigestText = %q, want empty for NULL", c.DigestText)
+	}
+	if c.Source != "batch:2" {
+		t.Errorf("Source = %q, want batch:2", c.Source)
+	}
+}
+
+func TestRun_GroupsByFirstDifferingToken(t *testing.T) {
+	cases := []Case{
+		{Version: "8.0", SQL: "SELECT 1", DigestText: "SELECT ?"},
+		{Version: "8.0", SQL: "SELECT a FROM t WHERE x IN (1, 2)", DigestText: "SELECT `a` FROM `t` WHERE `x` IN (?, ...)"},
+		{Version: "8.0", SQL: "SELECT b FROM t WHERE y IN (1, 2, 3)", DigestText: "SELECT `b` FROM `t` WHERE `y` IN (?, ...)"},
+		{Version: "8.0", SQL: "SELECT 1", Digest: "0000"},
+		{Version: "4.1", SQL: "SELECT 1"},
+		{Version: "8.0", SQL: "SELECT 'unterminated"},
+	}
+
+	r := Run(cases)
+	if r.Total != 6 || r.Passed != 1 {
+		t.Fatalf("Total = %d, Passed = %d, want 6 and 1", r.Total, r.Passed)
+	}
+
+	got := make(map[string]int)
+	for _, g := range r.Groups() {
+		got[g.Key] = len(g.Mismatches)
+	}
+	want := map[string]int{
+		`want "(?,", got "(...)"`:            2,
+		"hash only":                          1,
+		"unsupported version":                1,
+		"error: unterminated string literal": 1,
+	}
+	for key, n := range want {
+		if got[key] != n {
+			t.Errorf("group %q has %d mismatches, want %d (groups: %v)", key, got[key], n, got)
+		}
+	}
+
+	if first := r.Groups()[0]; first.Key != `want "(?,", got "(...)"` {
+		t.Errorf("largest group = %q, want the IN list group first", first.Key)
+	}
+}
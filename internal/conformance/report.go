@@ -0,0 +1,183 @@
+package conformance
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	digest "github.com/rashiq/mysql-digest"
+	"github.com/rashiq/mysql-digest/internal"
+)
+
+// Mismatch is a case whose computed digest differs from the captured one.
+type Mismatch struct {
+	Case
+	GotDigest string
+	GotText   string
+	Err       error
+
+	// Key describes the first differing token and is used for grouping.
+	Key string
+}
+
+// Report summarizes a conformance run.
+type Report struct {
+	Total      int
+	Passed     int
+	Mismatches []Mismatch
+}
+
+// Group is a set of mismatches that share the same first differing token.
+type Group struct {
+	Key        string
+	Mismatches []Mismatch
+}
+
+// Run digests every case with the version and sql_mode it was captured
+// under and compares the result with the server's DIGEST and DIGEST_TEXT.
+func Run(cases []Case) *Report {
+	r := &Report{Total: len(cases)}
+	for _, c := range cases {
+		if m, ok := check(c); !ok {
+			r.Mismatches = append(r.Mismatches, m)
+		} else {
+			r.Passed++
+		}
+	}
+	return r
+}
+
+func check(c Case) (Mismatch, bool) {
+	m := Mismatch{Case: c}
+
+	version, err := digest.ParseVersion(c.Version)
+	if err != nil {
+		m.Err = err
+		m.Key = "unsupported version"
+		return m, false
+	}
+
+	d, err := digest.Compute(c.SQL, digest.Options{
+		Version: version,
+		SQLMode: digest.ParseSQLMode(c.SQLMode),
+	})
+	m.GotDigest, m.GotText = d.Hash, d.Text
+	if err != nil {
+		m.Err = err
+		m.Key = "error: " + errorKind(err)
+		return m, false
+	}
+
+	hashOK := c.Digest == "" || strings.EqualFold(c.Digest, d.Hash)
+	textOK := c.DigestText == "" || c.DigestText == d.Text
+	if hashOK && textOK {
+		return m, true
+	}
+
+	if textOK {
+		m.Key = "hash only"
+	} else {
+		m.Key = firstDifference(c.DigestText, d.Text)
+	}
+	return m, false
+}
+
+func errorKind(err error) string {
+	var syntaxErr *internal.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return syntaxErr.Kind.String()
+	}
+	return err.Error()
+}
+
+// firstDifference compares two digest texts word by word and describes the
+// first pair that differs, independent of where it occurs.
+func firstDifference(want, got string) string {
+	w, g := strings.Fields(want), strings.Fields(got)
+	for i := 0; i < len(w) || i < len(g); i++ {
+		wt, gt := wordAt(w, i), wordAt(g, i)
+		if wt != gt {
+			return fmt.Sprintf("want %q, got %q", wt, gt)
+		}
+	}
+	return "whitespace"
+}
+
+func wordAt(words []string, i int) string {
+	if i >= len(words) {
+		return "<end>"
+	}
+	return words[i]
+}
+
+// Groups returns the mismatches grouped by Key, largest group first.
+func (r *Report) Groups() []Group {
+	byKey := make(map[string]*Group)
+	var groups []*Group
+	for _, m := range r.Mismatches {
+		g, ok := byKey[m.Key]
+		if !ok {
+			g = &Group{Key: m.Key}
+			byKey[m.Key] = g
+			groups = append(groups, g)
+		}
+		g.Mismatches = append(g.Mismatches, m)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if len(groups[i].Mismatches) != len(groups[j].Mismatches) {
+			return len(groups[i].Mismatches) > len(groups[j].Mismatches)
+		}
+		return groups[i].Key < groups[j].Key
+	})
+
+	out := make([]Group, len(groups))
+	for i, g := range groups {
+		out[i] = *g
+	}
+	return out
+}
+
+// Write prints a human readable summary. With verbose set, every
+// mismatch is listed under its group; otherwise only the first one is.
+func (r *Report) Write(w io.Writer, verbose bool) error {
+	ew := &errWriter{w: w}
+	ew.printf("%d cases, %d passed, %d failed\n", r.Total, r.Passed, len(r.Mismatches))
+
+	for _, g := range r.Groups() {
+		ew.printf("\n[%d] %s\n", len(g.Mismatches), g.Key)
+		shown := g.Mismatches
+		if !verbose && len(shown) > 1 {
+			shown = shown[:1]
+		}
+		for _, m := range shown {
+			ew.printf("  %s (%s)\n", m.Source, m.Version)
+			ew.printf("    sql:       %s\n", m.SQL)
+			if m.Err != nil {
+				ew.printf("    error:     %v\n", m.Err)
+				continue
+			}
+			ew.printf("    want text: %s\n", m.DigestText)
+			ew.printf("    got text:  %s\n", m.GotText)
+			ew.printf("    want hash: %s\n", m.Digest)
+			ew.printf("    got hash:  %s\n", m.GotDigest)
+		}
+		if hidden := len(g.Mismatches) - len(shown); hidden > 0 {
+			ew.printf("  ... and %d more\n", hidden)
+		}
+	}
+	return ew.err
+}
+
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (e *errWriter) printf(format string, args ...any) {
+	if e.err == nil {
+		_, e.err = fmt.Fprintf(e.w, format, args...)
+	}
+}
@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrorKind says what made the lexer give up on a statement.
+type ErrorKind int
+
+const (
+	UnterminatedString ErrorKind = iota + 1
+	UnterminatedComment
+	UnterminatedIdentifier
+	UnterminatedHint
+	UnterminatedDollarQuote
+	InvalidHexLiteral
+	InvalidBinaryLiteral
+)
+
+// Sentinel errors a SyntaxError of each kind matches with errors.Is.
+var (
+	ErrUnterminatedString      = errors.New("unterminated string literal")
+	ErrUnterminatedComment     = errors.New("unterminated block comment")
+	ErrUnterminatedIdentifier  = errors.New("unterminated quoted identifier")
+	ErrUnterminatedHint        = errors.New("unterminated optimizer hint")
+	ErrUnterminatedDollarQuote = errors.New("unterminated dollar-quoted string")
+	ErrInvalidHexLiteral       = errors.New("invalid hex literal")
+	ErrInvalidBinaryLiteral    = errors.New("invalid binary literal")
+
+	ErrInvalidTokenBounds = errors.New("invalid token bounds")
+)
+
+// ErrNoLiteralKey is returned for LiteralHMAC without a key.
+var ErrNoLiteralKey = errors.New("LiteralHMAC needs a LiteralKey")
+
+// Sentinel errors a LimitError matches with errors.Is.
+var (
+	ErrInputTooLarge = errors.New("statement too large")
+	ErrTooManyTokens = errors.New("statement has too many tokens")
+)
+
+var kindErrors = [...]error{
+	UnterminatedString:      ErrUnterminatedString,
+	UnterminatedComment:     ErrUnterminatedComment,
+	UnterminatedIdentifier:  ErrUnterminatedIdentifier,
+	UnterminatedHint:        ErrUnterminatedHint,
+	UnterminatedDollarQuote: ErrUnterminatedDollarQuote,
+	InvalidHexLiteral:       ErrInvalidHexLiteral,
+	InvalidBinaryLiteral:    ErrInvalidBinaryLiteral,
+}
+
+// Err returns the sentinel error for k.
+func (k ErrorKind) Err() error {
+	if k <= 0 || int(k) >= len(kindErrors) {
+		return nil
+	}
+	return kindErrors[k]
+}
+
+func (k ErrorKind) String() string {
+	if err := k.Err(); err != nil {
+		return err.Error()
+	}
+	return fmt.Sprintf("ErrorKind(%d)", int(k))
+}
+
+// LimitError reports a statement that was not digested because it is
+// longer than a configured limit.
+type LimitError struct {
+	Err   error // ErrInputTooLarge or ErrTooManyTokens
+	Limit int
+}
+
+func (e *LimitError) Error() string {
+	unit := "bytes"
+	if e.Err == ErrTooManyTokens {
+		unit = "tokens"
+	}
+	return fmt.Sprintf("%v: limit is %d %s", e.Err, e.Limit, unit)
+}
+
+// Unwrap returns e.Err.
+func (e *LimitError) Unwrap() error {
+	return e.Err
+}
+
+// maxSnippet bounds SyntaxError.Snippet.
+const maxSnippet = 32
+
+// SyntaxError reports a statement the lexer could not read, at the token
+// that failed: the opening quote of an unterminated string, the start of
+// an unclosed comment, and so on.
+type SyntaxError struct {
+	Kind    ErrorKind
+	Offset  int    // byte offset in the statement
+	Line    int    // 1-based
+	Column  int    // 1-based, in bytes
+	Snippet string // up to 32 bytes of the statement from Offset
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at line %d, column %d: %s near %q", e.Line, e.Column, e.Kind, e.Snippet)
+}
+
+// Unwrap returns the sentinel error for e.Kind.
+func (e *SyntaxError) Unwrap() error {
+	return e.Kind.Err()
+}
+
+// syntaxError returns an error of kind at the start of the current token.
+func (l *Lexer) syntaxError(kind ErrorKind) *SyntaxError {
+	return l.syntaxErrorAt(l.tokStart, kind)
+}
+
+// syntaxErrorAt returns an error of kind at pos in the input. The snippet
+// is copied, so the error stays valid when the input does not.
+func (l *Lexer) syntaxErrorAt(pos int, kind ErrorKind) *SyntaxError {
+	if n := pos + maxSnippet - l.pos; n > 0 {
+		l.more(n) // a streaming window may not hold the whole snippet yet
+	}
+	before := l.input[:pos]
+
+	base, line, lineStart := 0, 1, 0
+	if l.src != nil {
+		base, line, lineStart = l.src.base, 1+l.src.lines, l.src.lineStart
+	}
+	line += strings.Count(before, "\n")
+	if i := strings.LastIndexByte(before, '\n'); i >= 0 {
+		lineStart = base + i + 1
+	}
+
+	end := min(len(l.input), pos+maxSnippet)
+	for end > pos && end < len(l.input) && !utf8.RuneStart(l.input[end]) {
+		end--
+	}
+	return &SyntaxError{
+		Kind:    kind,
+		Offset:  base + pos,
+		Line:    line,
+		Column:  base + pos - lineStart + 1,
+		Snippet: strings.Clone(l.input[pos:end]),
+	}
+}
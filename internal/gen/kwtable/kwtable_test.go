@@ -0,0 +1,21 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGenerate_UpToDate(t *testing.T) {
+	got, err := generate("../../tokens.go")
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	want, err := os.ReadFile("../../keyword_tables.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("keyword_tables.go is stale; run go generate ./internal")
+	}
+}
@@ -0,0 +1,143 @@
+// Command kwtable generates the perfect hash keyword tables the lexer uses
+// from the keyword maps in the internal package.
+//
+// Usage:
+//
+//	go run ./gen/kwtable -tokens=tokens.go -out=keyword_tables.go
+//
+// Run it after tokengen, whenever the keyword maps or the per-version
+// keyword sets in token_config.go change. The entries name their tokens
+// by the constants of the -tokens file.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+
+	"github.com/rashiq/mysql-digest/internal"
+)
+
+const generatedHeader = "// Code generated by kwtable from the internal keyword maps. DO NOT EDIT.\n\n"
+
+func main() {
+	out := flag.String("out", "keyword_tables.go", "output file")
+	tokens := flag.String("tokens", "tokens.go", "file with the token constants")
+	flag.Parse()
+
+	src, err := generate(*tokens)
+	if err == nil {
+		err = os.WriteFile(*out, src, 0o644)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "kwtable: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+type table struct {
+	name  string
+	doc   string
+	words map[string]int
+}
+
+func tables() []table {
+	return []table{
+		{"keywordTable80", "MySQL 8.0 keywords", internal.KeywordsFor(internal.MySQL80)},
+		{"keywordTable84", "MySQL 8.4 keywords", internal.KeywordsFor(internal.MySQL84)},
+		{"keywordTable90", "MySQL 9.0 keywords", internal.KeywordsFor(internal.MySQL90)},
+		{"keywordTable57", "MySQL 5.7 keywords", internal.KeywordsFor(internal.MySQL57)},
+		{"versionedKeywordTable", "words that do not lex to the same token in every version", internal.VersionedKeywords()},
+		{"hintKeywordTable", "optimizer hint names", internal.HintKeywords()},
+	}
+}
+
+// tokenNames reads the token constants of the Go file at path, and maps
+// each token to its first name.
+func tokenNames(path string) (map[int]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int]string)
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs := spec.(*ast.ValueSpec)
+			for i, name := range vs.Names {
+				if i >= len(vs.Values) {
+					continue
+				}
+				lit, ok := vs.Values[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.INT {
+					continue
+				}
+				v, err := strconv.Atoi(lit.Value)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %s: %w", path, name.Name, err)
+				}
+				if _, ok := names[v]; !ok {
+					names[v] = name.Name
+				}
+			}
+		}
+	}
+	return names, nil
+}
+
+func generate(tokensPath string) ([]byte, error) {
+	names, err := tokenNames(tokensPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	b.WriteString(generatedHeader)
+	b.WriteString("package internal\n")
+
+	for _, t := range tables() {
+		kt, err := internal.NewKeywordTable(t.words)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", t.name, err)
+		}
+		if err := emit(&b, t, kt, names); err != nil {
+			return nil, err
+		}
+	}
+
+	return format.Source(b.Bytes())
+}
+
+func emit(b *bytes.Buffer, t table, kt *internal.KeywordTable, names map[int]string) error {
+	fmt.Fprintf(b, "\n// %s holds the %s.\n", t.name, t.doc)
+	fmt.Fprintf(b, "var %s = &KeywordTable{\n", t.name)
+	fmt.Fprintf(b, "Seed: %d,\n", kt.Seed)
+	fmt.Fprintf(b, "MaxLen: %d,\n", kt.MaxLen)
+	b.WriteString("Displace: []uint32{")
+	for i, d := range kt.Displace {
+		if i%16 == 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(b, "%d, ", d)
+	}
+	b.WriteString("\n},\n")
+	b.WriteString("Entries: []KeywordEntry{\n")
+	for _, e := range kt.Entries {
+		name, ok := names[e.Token]
+		if !ok {
+			return fmt.Errorf("%s: %q has token %d, which has no constant", t.name, e.Word, e.Token)
+		}
+		fmt.Fprintf(b, "{%q, %s},\n", e.Word, name)
+	}
+	b.WriteString("},\n}\n")
+	return nil
+}
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const generatedHeader = "// Code generated by tokengen from MySQL sources. DO NOT EDIT.\n\n"
+
+// generator turns the parsed current (8.0+) and 5.7 source trees into the
+// internal package's token tables.
+type generator struct {
+	cur      *source
+	old      *source
+	obsolete map[string]string // OBSOLETE_TOKEN_<n> -> 5.7 token name
+
+	curByName map[string]token
+	oldByName map[string]token
+	oldByVal  map[int]token
+}
+
+func newGenerator(cur, old *source, obsolete map[string]string) *generator {
+	g := &generator{
+		cur:       cur,
+		old:       old,
+		obsolete:  obsolete,
+		curByName: make(map[string]token),
+		oldByName: make(map[string]token),
+		oldByVal:  make(map[int]token),
+	}
+	for _, t := range cur.allTokens() {
+		g.curByName[t.Name] = t
+	}
+	for _, t := range old.Tokens {
+		g.oldByName[t.Name] = t
+		if _, ok := g.oldByVal[t.Value]; !ok {
+			g.oldByVal[t.Value] = t
+		}
+	}
+	return g
+}
+
+// allTokens returns grammar and digest tokens ordered by value.
+func (s *source) allTokens() []token {
+	toks := append(append([]token(nil), s.Tokens...), s.Digest...)
+	sort.SliceStable(toks, func(i, j int) bool { return toks[i].Value < toks[j].Value })
+	return toks
+}
+
+// files returns the generated sources keyed by output file name.
+func (g *generator) files() (map[string][]byte, error) {
+	emitters := map[string]func(*bytes.Buffer) error{
+		"tokens.go":      g.emitTokens,
+		"keywords.go":    g.emitKeywords,
+		"token_names.go": g.emitTokenNames,
+		"tokens57.go":    g.emitTokens57,
+	}
+
+	out := make(map[string][]byte, len(emitters))
+	for name, emit := range emitters {
+		var b bytes.Buffer
+		b.WriteString(generatedHeader)
+		b.WriteString("package internal\n\n")
+		if err := emit(&b); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		src, err := format.Source(b.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%s: formatting: %w", name, err)
+		}
+		out[name] = src
+	}
+	return out, nil
+}
+
+func (g *generator) emitTokens(b *bytes.Buffer) error {
+	b.WriteString("// Token constants derived from MySQL 8.0 source\n")
+	b.WriteString("//\n")
+	b.WriteString("// Single character tokens (0-255) use their ASCII value directly.\n")
+	b.WriteString("// The lexer returns int(char) for punctuation like '(', ')', ',', etc.\n")
+	b.WriteString("// Named tokens start at 258 (standard bison offset).\n")
+	b.WriteString("const (\n")
+	for _, t := range g.cur.allTokens() {
+		fmt.Fprintf(b, "\t%s = %d\n", t.Name, t.Value)
+	}
+	b.WriteString(")\n")
+	return nil
+}
+
+func (g *generator) emitKeywords(b *bytes.Buffer) error {
+	current := make(map[string]bool)
+
+	b.WriteString("// TokenKeywords returns the keyword map derived from sql/lex.h. The lexer\n")
+	b.WriteString("// looks words up in keyword_tables.go; the map is built on each call, for\n")
+	b.WriteString("// gen/kwtable and the tests.\n")
+	b.WriteString("func TokenKeywords() map[string]int {\n")
+	b.WriteString("\treturn map[string]int{\n")
+	keywords := make(map[string]bool)
+	for _, s := range g.cur.Symbols {
+		if s.Group == groupKeyword {
+			keywords[s.Text] = true
+		}
+	}
+	// The hint names are keywords to the main lexer too, as they have
+	// always been in these tables, unless they spell a keyword; digests
+	// depend on it.
+	for _, s := range g.cur.Symbols {
+		if s.Group == groupHint && keywords[s.Text] {
+			continue
+		}
+		if _, ok := g.curByName[s.Token]; !ok {
+			return fmt.Errorf("keyword %q uses undefined token %s", s.Text, s.Token)
+		}
+		current[s.Text] = true
+		fmt.Fprintf(b, "\t%s: %s,\n", strconv.Quote(s.Text), s.Token)
+	}
+	b.WriteString("}\n}\n\n")
+
+	b.WriteString("// HintKeywords returns the optimizer hint names and their token IDs.\n")
+	b.WriteString("// These are only valid inside /*+ ... */ optimizer hint comments.\n")
+	b.WriteString("func HintKeywords() map[string]int {\n")
+	b.WriteString("\treturn map[string]int{\n")
+	for _, s := range g.cur.Symbols {
+		if s.Group != groupHint {
+			continue
+		}
+		if _, ok := g.curByName[s.Token]; !ok {
+			return fmt.Errorf("hint %q uses undefined token %s", s.Text, s.Token)
+		}
+		fmt.Fprintf(b, "\t%s: %s,\n", strconv.Quote(s.Text), s.Token)
+	}
+	b.WriteString("}\n}\n\n")
+
+	b.WriteString("// mysql57Keywords returns the keywords that only exist in MySQL 5.7.\n")
+	b.WriteString("// These are obsolete in MySQL 8.0+; KeywordsFor adds them for MySQL57.\n")
+	b.WriteString("func mysql57Keywords() map[string]int {\n")
+	b.WriteString("\treturn map[string]int{\n")
+	for _, s := range g.old.Symbols {
+		if s.Group != groupKeyword || current[s.Text] {
+			continue
+		}
+		if _, ok := g.oldByName[s.Token]; !ok {
+			return fmt.Errorf("5.7 keyword %q uses undefined token %s", s.Text, s.Token)
+		}
+		if obsolete := g.obsoleteFor(s.Token); obsolete != "" {
+			fmt.Fprintf(b, "\t%s: %s,\n", strconv.Quote(s.Text), obsolete)
+		}
+	}
+	b.WriteString("}\n}\n")
+	return nil
+}
+
+// spellings are the names digest text shows for tokens lex.h spells in
+// more than one way, where that is not the last spelling.
+var spellings = map[string]string{
+	"SYSTEM_USER": "USER",
+}
+
+func (g *generator) emitTokenNames(b *bytes.Buffer) error {
+	b.WriteString("// Token name initializations derived from sql/lex.h\n")
+	b.WriteString("func init() {\n")
+	for _, s := range g.cur.Symbols {
+		// Retired tokens are named in token_config.go.
+		if strings.HasPrefix(s.Token, "OBSOLETE_TOKEN_") {
+			continue
+		}
+		text := s.Text
+		if name, ok := spellings[text]; ok {
+			text = name
+		}
+		fmt.Fprintf(b, "\tTokenInfos[%s].String = %s\n", s.Token, strconv.Quote(text))
+	}
+	b.WriteString("}\n")
+	return nil
+}
+
+func (g *generator) emitTokens57(b *bytes.Buffer) error {
+	b.WriteString("// This file maps MySQL 8.0 token IDs to MySQL 5.7 token IDs.\n\n")
+	b.WriteString("const (\n")
+	for _, t := range g.old.Tokens {
+		fmt.Fprintf(b, "\tm57_%s = %d\n", t.Name, t.Value)
+	}
+	b.WriteString("\n\t// MySQL 5.7 digest tokens\n")
+	oldDigest := make(map[string]bool, len(g.old.Digest))
+	for _, t := range g.old.Digest {
+		oldDigest[t.Name] = true
+		fmt.Fprintf(b, "\tm57%s = %d\n", t.Name, t.Value)
+	}
+	b.WriteString(")\n\n")
+
+	// Keywords that were renamed between releases are joined by their
+	// lex.h spelling, e.g. ZEROFILL -> ZEROFILL_SYM. A hint is only joined
+	// to a hint: the MERGE hint is not the MERGE keyword.
+	type spelling struct {
+		group symbolGroup
+		text  string
+	}
+	oldByText := make(map[spelling]string)
+	for _, s := range g.old.Symbols {
+		oldByText[spelling{s.Group, s.Text}] = s.Token
+	}
+	renamed := make(map[string]string)
+	for _, s := range g.cur.Symbols {
+		if name, ok := oldByText[spelling{s.Group, s.Text}]; ok && name != s.Token {
+			renamed[s.Token] = name
+		}
+	}
+
+	b.WriteString("// mysql80To57TokenMap maps MySQL 8.0 token IDs to MySQL 5.7 token IDs.\n")
+	b.WriteString("var mysql80To57TokenMap = map[int]int{\n")
+	seen := make(map[int]bool)
+	for _, t := range g.cur.allTokens() {
+		if t.Value < 256 || seen[t.Value] {
+			continue
+		}
+		seen[t.Value] = true
+
+		old, listed := g.obsolete[t.Name]
+		switch {
+		case listed && !g.hasOld(old):
+			fmt.Fprintf(b, "\t%s: m57TOK_UNUSED, // Not in MySQL 5.7\n", t.Name)
+		case oldDigest[t.Name]:
+			fmt.Fprintf(b, "\t%s: m57%s,\n", t.Name, t.Name)
+		case g.hasOld(t.Name):
+			fmt.Fprintf(b, "\t%s: m57_%s,\n", t.Name, t.Name)
+		case g.hasOld(renamed[t.Name]):
+			fmt.Fprintf(b, "\t%s: m57_%s,\n", t.Name, renamed[t.Name])
+		case g.replaced(t.Name) != "":
+			old := g.replaced(t.Name)
+			fmt.Fprintf(b, "\t%s: m57_%s, // %s removed in 8.0\n", t.Name, old, old)
+		default:
+			fmt.Fprintf(b, "\t%s: m57TOK_UNUSED, // Not in MySQL 5.7\n", t.Name)
+		}
+	}
+	b.WriteString("}\n")
+	return nil
+}
+
+func (g *generator) hasOld(name string) bool {
+	if name == "" {
+		return false
+	}
+	_, ok := g.oldByName[name]
+	return ok
+}
+
+// replaced returns the 5.7 token an OBSOLETE_TOKEN_<n> stands in for. The
+// obsolete list wins; otherwise the 5.7 token with the same number is used.
+func (g *generator) replaced(name string) string {
+	if old, ok := g.obsolete[name]; ok && g.hasOld(old) {
+		return old
+	}
+	var v int
+	if _, err := fmt.Sscanf(name, "OBSOLETE_TOKEN_%d", &v); err != nil {
+		return ""
+	}
+	if _, listed := g.obsolete[name]; listed {
+		return ""
+	}
+	if old, ok := g.oldByVal[v]; ok {
+		return old.Name
+	}
+	return ""
+}
+
+// obsoleteFor returns the current OBSOLETE_TOKEN_<n> that replaced the 5.7
+// token old, or "" when it has no counterpart.
+func (g *generator) obsoleteFor(old string) string {
+	for _, t := range g.cur.Tokens {
+		if g.replaced(t.Name) == old {
+			return t.Name
+		}
+	}
+	return ""
+}
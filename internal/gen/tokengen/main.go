@@ -0,0 +1,88 @@
+// Command tokengen regenerates the internal package's keyword and token
+// tables from MySQL source trees.
+//
+// It reads sql/lex.h and sql/gen_lex_token.cc from each source tree and the
+// bison generated sql/sql_yacc.h (plus sql/sql_hints.yy.h when present) from
+// the matching build tree, then writes tokens.go, keywords.go,
+// token_names.go and tokens57.go. Output is deterministic for a given input.
+//
+// Usage:
+//
+//	go run ./gen/tokengen -src=$MYSQL_SRC -build=$MYSQL_BUILD \
+//		-src57=$MYSQL57_SRC -build57=$MYSQL57_BUILD -out=.
+//
+// Retired 8.0 tokens are matched to their 5.7 counterparts through
+// obsolete57.txt, which also names the tokens that have none. Version
+// specific keyword sets (mysql80OnlyKeywords and friends in
+// token_config.go) are still maintained by hand.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+func main() {
+	src := flag.String("src", "", "current MySQL source tree (8.0 or later)")
+	build := flag.String("build", "", "build tree containing sql/sql_yacc.h for -src (defaults to -src)")
+	src57 := flag.String("src57", "", "MySQL 5.7 source tree")
+	build57 := flag.String("build57", "", "build tree containing sql/sql_yacc.h for -src57 (defaults to -src57)")
+	obsolete := flag.String("obsolete", "gen/tokengen/obsolete57.txt", "OBSOLETE_TOKEN_<n> to 5.7 token name list")
+	out := flag.String("out", ".", "output directory")
+	optional := flag.Bool("optional", false, "do nothing if -src and -src57 are empty, as under go generate without the MYSQL_* variables")
+	flag.Parse()
+
+	if *optional && *src == "" && *src57 == "" {
+		fmt.Fprintln(os.Stderr, "tokengen: no source trees given, keeping the committed tables")
+		return
+	}
+
+	if err := run(*src, *build, *src57, *build57, *obsolete, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "tokengen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(src, build, src57, build57, obsolete, out string) error {
+	if src == "" || src57 == "" {
+		return errors.New("-src and -src57 are required")
+	}
+
+	files, err := generate(src, build, src57, build57, obsolete)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(out, name), files[name], 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func generate(src, build, src57, build57, obsoletePath string) (map[string][]byte, error) {
+	cur, err := loadSource(src, build)
+	if err != nil {
+		return nil, err
+	}
+	old, err := loadSource(src57, build57)
+	if err != nil {
+		return nil, err
+	}
+	obsolete, err := parseObsolete(obsoletePath)
+	if err != nil {
+		return nil, err
+	}
+	return newGenerator(cur, old, obsolete).files()
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// symbolGroup matches the SYM_* macro used for an entry in sql/lex.h.
+type symbolGroup int
+
+const (
+	groupKeyword symbolGroup = iota // SYM, SYM_FN, SYM_HK
+	groupHint                       // SYM_H
+)
+
+type symbol struct {
+	Text  string
+	Token string
+	Group symbolGroup
+}
+
+type token struct {
+	Name  string
+	Value int
+}
+
+// source is everything the generator needs from one MySQL source tree.
+type source struct {
+	Symbols []symbol
+	Tokens  []token // sorted by value, source order for equal values
+	Digest  []token // fake digest tokens from gen_lex_token.cc
+}
+
+var (
+	symRe         = regexp.MustCompile(`\{\s*(SYM|SYM_FN|SYM_HK|SYM_H)\s*\(\s*("(?:[^"\\]|\\.)*")\s*,\s*([A-Za-z_][A-Za-z0-9_]*)\s*\)\s*\}`)
+	enumStartRe   = regexp.MustCompile(`^\s*enum\s+[A-Za-z_]*tokentype\b`)
+	enumEntryRe   = regexp.MustCompile(`^\s*([A-Za-z_][A-Za-z0-9_]*)\s*=\s*(-?\d+)\s*,?`)
+	defineRe      = regexp.MustCompile(`^\s*#\s*define\s+([A-Z_][A-Z0-9_]*)\s+(\d+)\s*$`)
+	maxTokenRe    = regexp.MustCompile(`\bmax_token_seen\s*=\s*(\d+)\s*;`)
+	digestTokenRe = regexp.MustCompile(`\b(tok_[a-z_]+)\s*=\s*max_token_seen\s*\+\+\s*;`)
+)
+
+// parseLexH extracts the keyword and hint symbols from sql/lex.h, in file order.
+func parseLexH(path string) ([]symbol, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var syms []symbol
+	for _, m := range symRe.FindAllStringSubmatch(string(data), -1) {
+		text, err := strconv.Unquote(m[2])
+		if err != nil {
+			return nil, fmt.Errorf("%s: bad symbol string %s: %w", path, m[2], err)
+		}
+		group := groupKeyword
+		if m[1] == "SYM_H" {
+			group = groupHint
+		}
+		syms = append(syms, symbol{Text: text, Token: m[3], Group: group})
+	}
+	if len(syms) == 0 {
+		return nil, fmt.Errorf("%s: no symbols found", path)
+	}
+	return syms, nil
+}
+
+// parseYaccHeader extracts token numbers from a bison generated header.
+// The yytokentype enum is preferred; older bison releases that only emit
+// #define lines are handled as a fallback.
+func parseYaccHeader(path string) ([]token, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var enumToks, defineToks []token
+	inEnum := false
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := sc.Text()
+		switch {
+		case enumStartRe.MatchString(line):
+			inEnum = true
+		case inEnum && strings.Contains(line, "}"):
+			inEnum = false
+		case inEnum:
+			if m := enumEntryRe.FindStringSubmatch(line); m != nil {
+				if tok, ok := makeToken(m[1], m[2]); ok {
+					enumToks = append(enumToks, tok)
+				}
+			}
+		default:
+			if m := defineRe.FindStringSubmatch(line); m != nil {
+				if tok, ok := makeToken(m[1], m[2]); ok && tok.Value >= 256 {
+					defineToks = append(defineToks, tok)
+				}
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	toks := enumToks
+	if len(toks) == 0 {
+		toks = defineToks
+	}
+	if len(toks) == 0 {
+		return nil, fmt.Errorf("%s: no tokens found", path)
+	}
+	return toks, nil
+}
+
+// makeToken skips bison internals such as YYEMPTY (negative) and
+// YYerror (mixed case), which the lexer never returns.
+func makeToken(name, value string) (token, bool) {
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 0 || strings.ToUpper(name) != name {
+		return token{}, false
+	}
+	return token{Name: name, Value: v}, true
+}
+
+// parseGenLexToken extracts the fake digest tokens (tok_generic_value, ...)
+// that gen_lex_token.cc allocates after the grammar tokens.
+func parseGenLexToken(path string, next int) ([]token, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	text := string(data)
+
+	if m := maxTokenRe.FindStringSubmatch(text); m != nil {
+		next, _ = strconv.Atoi(m[1])
+	}
+
+	var toks []token
+	for _, m := range digestTokenRe.FindAllStringSubmatch(text, -1) {
+		toks = append(toks, token{Name: strings.ToUpper(m[1]), Value: next})
+		next++
+	}
+	if len(toks) == 0 {
+		return nil, fmt.Errorf("%s: no digest tokens found", path)
+	}
+	return toks, nil
+}
+
+// loadSource reads lex.h and gen_lex_token.cc from src and the bison
+// headers (sql_yacc.h and, when present, sql_hints.yy.h) from build.
+func loadSource(src, build string) (*source, error) {
+	if build == "" {
+		build = src
+	}
+
+	syms, err := parseLexH(src + "/sql/lex.h")
+	if err != nil {
+		return nil, err
+	}
+
+	toks, err := parseYaccHeader(build + "/sql/sql_yacc.h")
+	if err != nil {
+		return nil, err
+	}
+	hintsPath := build + "/sql/sql_hints.yy.h"
+	if _, err := os.Stat(hintsPath); err == nil {
+		hints, err := parseYaccHeader(hintsPath)
+		if err != nil {
+			return nil, err
+		}
+		toks = mergeTokens(toks, hints)
+	}
+	sort.SliceStable(toks, func(i, j int) bool { return toks[i].Value < toks[j].Value })
+
+	maxTok := 0
+	for _, t := range toks {
+		maxTok = max(maxTok, t.Value)
+	}
+	digest, err := parseGenLexToken(src+"/sql/gen_lex_token.cc", maxTok+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &source{Symbols: syms, Tokens: toks, Digest: digest}, nil
+}
+
+// mergeTokens appends the tokens of b that are not already named in a.
+func mergeTokens(a, b []token) []token {
+	seen := make(map[string]bool, len(a))
+	for _, t := range a {
+		seen[t.Name] = true
+	}
+	for _, t := range b {
+		if !seen[t.Name] {
+			a = append(a, t)
+			seen[t.Name] = true
+		}
+	}
+	return a
+}
+
+// parseObsolete reads the hand-maintained OBSOLETE_TOKEN_<n> -> 5.7 token
+// name pairs, where a name of "-" means no 5.7 token. Blank lines and lines
+// starting with '#' are ignored.
+func parseObsolete(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	m := make(map[string]string)
+	sc := bufio.NewScanner(f)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: want \"OBSOLETE_TOKEN_<n> NAME\"", path, n)
+		}
+		m[fields[0]] = fields[1]
+	}
+	return m, sc.Err()
+}
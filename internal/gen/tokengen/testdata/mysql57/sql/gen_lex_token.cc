@@ -0,0 +1,52 @@
+/* Trimmed excerpt of MySQL 5.7 sql/gen_lex_token.cc used as a tokengen fixture. */
+
+int tok_generic_value= 0;
+int tok_generic_value_list= 0;
+int tok_row_single_value= 0;
+int tok_row_single_value_list= 0;
+int tok_row_multiple_value= 0;
+int tok_row_multiple_value_list= 0;
+int tok_ident= 0;
+int tok_ident_at= 0;
+int tok_hint_comment_open= 0;
+int tok_hint_comment_close= 0;
+int tok_unused= 0;
+
+void compute_tokens()
+{
+  /* Fake tokens used in digests, numbered after the main grammar. */
+  max_token_seen= 928;
+
+  tok_generic_value= max_token_seen++;
+  set_token(tok_generic_value, "?");
+
+  tok_generic_value_list= max_token_seen++;
+  set_token(tok_generic_value_list, "?, ...");
+
+  tok_row_single_value= max_token_seen++;
+  set_token(tok_row_single_value, "(?)");
+
+  tok_row_single_value_list= max_token_seen++;
+  set_token(tok_row_single_value_list, "(?) /* , ... */");
+
+  tok_row_multiple_value= max_token_seen++;
+  set_token(tok_row_multiple_value, "(...)");
+
+  tok_row_multiple_value_list= max_token_seen++;
+  set_token(tok_row_multiple_value_list, "(...) /* , ... */");
+
+  tok_ident= max_token_seen++;
+  set_token(tok_ident, "(tok_id)");
+
+  tok_ident_at= max_token_seen++;
+  set_token(tok_ident_at, "(tok_id_at)");
+
+  tok_hint_comment_open= max_token_seen++;
+  set_token(tok_hint_comment_open, HINT_COMMENT_STARTER);
+
+  tok_hint_comment_close= max_token_seen++;
+  set_token(tok_hint_comment_close, HINT_COMMENT_TERMINATOR);
+
+  tok_unused= max_token_seen++;
+  set_token(tok_unused, "UNUSED");
+}
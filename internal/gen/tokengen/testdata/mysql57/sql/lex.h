@@ -0,0 +1,40 @@
+/* Trimmed excerpt of MySQL 5.7 sql/lex.h used as a tokengen fixture. */
+
+#include "lex_symbol.h"
+
+SYM_GROUP sym_group_common= {"", ""};
+
+#define SYM_FN(A,B) STRING_WITH_LEN(A), SG_FUNCTIONS, B
+#define SYM(A,B) STRING_WITH_LEN(A), SG_KEYWORDS, B
+#define SYM_HK(A,B) STRING_WITH_LEN(A), SG_HINTABLE_KEYWORDS, B
+#define SYM_H(A,B) STRING_WITH_LEN(A), SG_HINTS, B
+
+static const SYMBOL symbols[] = {
+  { SYM("&&",			AND_AND_SYM)},
+  { SYM("<",			LT)},
+  { SYM("<=",			LE)},
+  { SYM("<>",			NE)},
+  { SYM("!=",			NE)},
+  { SYM("=",			EQ)},
+  { SYM("ACCESSIBLE",		ACCESSIBLE_SYM)},
+  { SYM_FN("ADDDATE",		ADDDATE_SYM)},
+  { SYM("ADD",			ADD)},
+  { SYM("ALL",			ALL)},
+  { SYM("ANALYSE",		ANALYSE_SYM)},
+  { SYM("AND",			AND_SYM)},
+  { SYM("AS",			AS)},
+  { SYM("DES_KEY_FILE",		DES_KEY_FILE)},
+  { SYM("FROM",			FROM)},
+  { SYM("IN",			IN_SYM)},
+  { SYM_HK("INSERT",		INSERT)},
+  { SYM("MASTER_HOST",		MASTER_HOST_SYM)},
+  { SYM("NULL",			NULL_SYM)},
+  { SYM_HK("SELECT",		SELECT_SYM)},
+  { SYM("WHERE",		WHERE)},
+  { SYM("ZEROFILL",		ZEROFILL)},
+  /*
+    Insert new optimizer hint keywords after that commentary:
+  */
+  { SYM_H("MAX_EXECUTION_TIME", MAX_EXECUTION_TIME_HINT)},
+  { SYM_H("BKA",                BKA_HINT)},
+};
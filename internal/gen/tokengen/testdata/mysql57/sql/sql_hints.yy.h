@@ -0,0 +1,12 @@
+/* A Bison parser, made by GNU Bison 2.3.  */
+/* Trimmed excerpt of a MySQL 5.7 build's sql/sql_hints.yy.h used as a tokengen fixture. */
+
+#ifndef YYTOKENTYPE
+# define YYTOKENTYPE
+   enum yytokentype {
+     MAX_EXECUTION_TIME_HINT = 910,
+     BKA_HINT = 911,
+     HINT_CLOSE = 931,
+     HINT_ERROR = 932
+   };
+#endif
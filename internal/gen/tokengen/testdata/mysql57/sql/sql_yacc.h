@@ -0,0 +1,41 @@
+/* A Bison parser, made by GNU Bison 2.3.  */
+/* Trimmed excerpt of a MySQL 5.7 build's sql/sql_yacc.h used as a tokengen fixture.
+   Only the #define form is kept to exercise the pre-enum fallback. */
+
+#ifndef YY_MYSQL_SQL_YACC_H_INCLUDED
+# define YY_MYSQL_SQL_YACC_H_INCLUDED
+/* Enabling traces.  */
+#ifndef YYDEBUG
+# define YYDEBUG 0
+#endif
+
+/* Tokens.  */
+#define ABORT_SYM 258
+#define ACCESSIBLE_SYM 259
+#define ADD 262
+#define ADDDATE_SYM 263
+#define ALL 268
+#define ANALYSE_SYM 271
+#define AND_AND_SYM 273
+#define AND_SYM 274
+#define AS 276
+#define DES_KEY_FILE 388
+#define END_OF_INPUT 411
+#define EQ 415
+#define FROM 452
+#define INSERT 496
+#define IN_SYM 504
+#define LE 523
+#define LT 550
+#define MASTER_HOST_SYM 555
+#define NE 615
+#define NULL_SYM 628
+#define SELECT_SYM 749
+#define WHERE 891
+#define ZEROFILL 907
+
+#if ! defined YYSTYPE && ! defined YYSTYPE_IS_DECLARED
+# define YYSTYPE_IS_DECLARED 1
+#endif
+
+#endif
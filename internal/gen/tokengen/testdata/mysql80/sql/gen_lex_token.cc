@@ -0,0 +1,69 @@
+/* Trimmed excerpt of MySQL 8.4 sql/gen_lex_token.cc used as a tokengen fixture. */
+
+int tok_generic_value = 0;
+int tok_generic_value_list = 0;
+int tok_row_single_value = 0;
+int tok_row_single_value_list = 0;
+int tok_row_multiple_value = 0;
+int tok_row_multiple_value_list = 0;
+int tok_in_generic_value_expression = 0;
+int tok_ident = 0;
+int tok_ident_at = 0;
+int tok_hint_comment_open = 0;
+int tok_hint_comment_close = 0;
+int tok_by_numeric_column = 0;
+int tok_unused = 0;
+
+static void compute_tokens() {
+  /*
+    Tokens made of just one terminal character
+  */
+  for (char c = 0; c < 256; c++) {
+    set_token(c, std::string(1, c).c_str());
+  }
+
+  /*
+    Fake tokens to represent normalized values, reserved after the
+    grammar and hint tokens.
+  */
+  max_token_seen = 1100;
+
+  tok_generic_value = max_token_seen++;
+  set_token(tok_generic_value, "?");
+
+  tok_generic_value_list = max_token_seen++;
+  set_token(tok_generic_value_list, "?, ...");
+
+  tok_row_single_value = max_token_seen++;
+  set_token(tok_row_single_value, "(?)");
+
+  tok_row_single_value_list = max_token_seen++;
+  set_token(tok_row_single_value_list, "(?) /* , ... */");
+
+  tok_row_multiple_value = max_token_seen++;
+  set_token(tok_row_multiple_value, "(...)");
+
+  tok_row_multiple_value_list = max_token_seen++;
+  set_token(tok_row_multiple_value_list, "(...) /* , ... */");
+
+  tok_ident = max_token_seen++;
+  set_token(tok_ident, "(tok_id)");
+
+  tok_ident_at = max_token_seen++;
+  set_token(tok_ident_at, "(tok_id_at)");
+
+  tok_hint_comment_open = max_token_seen++;
+  set_token(tok_hint_comment_open, HINT_COMMENT_STARTER);
+
+  tok_hint_comment_close = max_token_seen++;
+  set_token(tok_hint_comment_close, HINT_COMMENT_TERMINATOR);
+
+  tok_in_generic_value_expression = max_token_seen++;
+  set_token(tok_in_generic_value_expression, "IN (...)");
+
+  tok_by_numeric_column = max_token_seen++;
+  set_token(tok_by_numeric_column, "(by_num_col)");
+
+  tok_unused = max_token_seen++;
+  set_token(tok_unused, "UNUSED");
+}
@@ -0,0 +1,47 @@
+/* Trimmed excerpt of MySQL 8.4 sql/lex.h used as a tokengen fixture. */
+
+#ifndef LEX_INCLUDED
+#define LEX_INCLUDED
+
+#include "sql/lex_symbol.h"
+#include "sql/sql_hints.yy.h"
+#include "sql/sql_yacc.h"
+
+#define SYM_FN(T, A) STRING_WITH_LEN(T), SG_FUNCTIONS, A
+#define SYM(T, A) STRING_WITH_LEN(T), SG_KEYWORDS, A
+#define SYM_HK(T, A) STRING_WITH_LEN(T), SG_HINTABLE_KEYWORDS, A
+#define SYM_H(T, A) STRING_WITH_LEN(T), SG_HINTS, A
+
+static const SYMBOL symbols[] = {
+    /*
+     Insert new SQL keywords after that commentary (by alphabetical order):
+    */
+    {SYM("&&", AND_AND_SYM)},
+    {SYM("<", LT)},
+    {SYM("<=", LE)},
+    {SYM("<>", NE)},
+    {SYM("!=", NE)},
+    {SYM("=", EQ)},
+    {SYM("ACCESSIBLE", ACCESSIBLE_SYM)},
+    {SYM_FN("ADDDATE", ADDDATE_SYM)},
+    {SYM("ADD", ADD)},
+    {SYM("ALL", ALL)},
+    {SYM("AND", AND_SYM)},
+    {SYM("AS", AS)},
+    {SYM("FROM", FROM)},
+    {SYM("IN", IN_SYM)},
+    {SYM_HK("INSERT", INSERT_SYM)},
+    {SYM("NULL", NULL_SYM)},
+    {SYM("PERSIST", PERSIST_SYM)},
+    {SYM_HK("SELECT", SELECT_SYM)},
+    {SYM("WHERE", WHERE)},
+    {SYM("ZEROFILL", ZEROFILL_SYM)},
+    /*
+      Place keywords that accept optimizer hints below the commentary.
+    */
+    {SYM_H("MAX_EXECUTION_TIME", MAX_EXECUTION_TIME_HINT)},
+    {SYM_H("BKA", BKA_HINT)},
+    {SYM_H("NO_INDEX", NO_INDEX_HINT)},
+};
+
+#endif /* LEX_INCLUDED */
@@ -0,0 +1,51 @@
+/* A Bison parser, made by GNU Bison 3.8.2.  */
+/* Trimmed excerpt of a MySQL 8.4 build's sql/sql_yacc.h used as a tokengen fixture. */
+
+#ifndef YY_MY_SQL_PARSER_SQL_YACC_H_INCLUDED
+# define YY_MY_SQL_PARSER_SQL_YACC_H_INCLUDED
+/* Debug traces.  */
+#ifndef MY_SQL_PARSER_DEBUG
+# define MY_SQL_PARSER_DEBUG 0
+#endif
+
+/* Token kinds.  */
+#ifndef MY_SQL_PARSER_TOKENTYPE
+# define MY_SQL_PARSER_TOKENTYPE
+  enum my_sql_parser_tokentype
+  {
+    MY_SQL_PARSER_EMPTY = -2,
+    MY_SQL_PARSER_EOF = 0,         /* "end of file"  */
+    MY_SQL_PARSER_error = 256,     /* error  */
+    ABORT_SYM = 258,               /* ABORT_SYM  */
+    ACCESSIBLE_SYM = 259,          /* ACCESSIBLE_SYM  */
+    ADD = 262,                     /* ADD  */
+    ADDDATE_SYM = 263,             /* ADDDATE_SYM  */
+    ALL = 268,                     /* ALL  */
+    OBSOLETE_TOKEN_271 = 271,      /* OBSOLETE_TOKEN_271  */
+    AND_AND_SYM = 273,             /* AND_AND_SYM  */
+    AND_SYM = 274,                 /* AND_SYM  */
+    AS = 276,                      /* AS  */
+    END_OF_INPUT = 411,            /* END_OF_INPUT  */
+    EQ = 415,                      /* EQ  */
+    FROM = 452,                    /* FROM  */
+    INSERT_SYM = 496,              /* INSERT_SYM  */
+    IN_SYM = 504,                  /* IN_SYM  */
+    LE = 522,                      /* LE  */
+    LT = 549,                      /* LT  */
+    OBSOLETE_TOKEN_554 = 554,      /* OBSOLETE_TOKEN_554  */
+    NE = 614,                      /* NE  */
+    NULL_SYM = 627,                /* NULL_SYM  */
+    SELECT_SYM = 748,              /* SELECT_SYM  */
+    WHERE = 890,                   /* WHERE  */
+    ZEROFILL_SYM = 906,            /* ZEROFILL_SYM  */
+    PERSIST_SYM = 908,             /* PERSIST_SYM  */
+    MAX_EXECUTION_TIME_HINT = 1000, /* MAX_EXECUTION_TIME_HINT  */
+    BKA_HINT = 1002,               /* BKA_HINT  */
+    NO_INDEX_HINT = 1040,          /* NO_INDEX_HINT  */
+    MY_SQL_PARSER_UNDEF = 1150,    /* "invalid token"  */
+    YYUNDEF = 1150                 /* YYUNDEF  */
+  };
+  typedef enum my_sql_parser_tokentype my_sql_parser_token_kind_t;
+#endif
+
+#endif /* !YY_MY_SQL_PARSER_SQL_YACC_H_INCLUDED  */
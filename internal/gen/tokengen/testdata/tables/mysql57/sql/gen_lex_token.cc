@@ -0,0 +1,14 @@
+/* What tokengen reads from MySQL 5.7's sql/gen_lex_token.cc to generate the
+   tables committed in internal/; see TestGenerate_Committed. */
+  max_token_seen= 928;
+  tok_generic_value= max_token_seen++;
+  tok_generic_value_list= max_token_seen++;
+  tok_row_single_value= max_token_seen++;
+  tok_row_single_value_list= max_token_seen++;
+  tok_row_multiple_value= max_token_seen++;
+  tok_row_multiple_value_list= max_token_seen++;
+  tok_ident= max_token_seen++;
+  tok_ident_at= max_token_seen++;
+  tok_hint_comment_open= max_token_seen++;
+  tok_hint_comment_close= max_token_seen++;
+  tok_unused= max_token_seen++;
@@ -0,0 +1,680 @@
+/* What tokengen reads from MySQL 5.7's sql/sql_yacc.h to generate the
+   tables committed in internal/; see TestGenerate_Committed. */
+enum yytokentype
+{
+  ABORT_SYM = 258,
+  ACCESSIBLE_SYM = 259,
+  ACCOUNT_SYM = 260,
+  ACTION = 261,
+  ADD = 262,
+  ADDDATE_SYM = 263,
+  AFTER_SYM = 264,
+  AGAINST = 265,
+  AGGREGATE_SYM = 266,
+  ALGORITHM_SYM = 267,
+  ALL = 268,
+  ALTER = 269,
+  ALWAYS_SYM = 270,
+  ANALYSE_SYM = 271,
+  ANALYZE_SYM = 272,
+  AND_AND_SYM = 273,
+  AND_SYM = 274,
+  ANY_SYM = 275,
+  AS = 276,
+  ASC = 277,
+  ASCII_SYM = 278,
+  ASENSITIVE_SYM = 279,
+  AT_SYM = 280,
+  AUTOEXTEND_SIZE_SYM = 281,
+  AUTO_INC = 282,
+  AVG_ROW_LENGTH = 283,
+  AVG_SYM = 284,
+  BACKUP_SYM = 285,
+  BEFORE_SYM = 286,
+  BEGIN_SYM = 287,
+  BETWEEN_SYM = 288,
+  BIGINT = 289,
+  BINARY = 290,
+  BINLOG_SYM = 291,
+  BIN_NUM = 292,
+  BIT_AND = 293,
+  BIT_OR = 294,
+  BIT_SYM = 295,
+  BIT_XOR = 296,
+  BLOB_SYM = 297,
+  BLOCK_SYM = 298,
+  BOOLEAN_SYM = 299,
+  BOOL_SYM = 300,
+  BOTH = 301,
+  BTREE_SYM = 302,
+  BY = 303,
+  BYTE_SYM = 304,
+  CACHE_SYM = 305,
+  CALL_SYM = 306,
+  CASCADE = 307,
+  CASCADED = 308,
+  CASE_SYM = 309,
+  CAST_SYM = 310,
+  CATALOG_NAME_SYM = 311,
+  CHAIN_SYM = 312,
+  CHANGE = 313,
+  CHANGED = 314,
+  CHANNEL_SYM = 315,
+  CHARSET = 316,
+  CHAR_SYM = 317,
+  CHECKSUM_SYM = 318,
+  CHECK_SYM = 319,
+  CIPHER_SYM = 320,
+  CLASS_ORIGIN_SYM = 321,
+  CLIENT_SYM = 322,
+  CLOSE_SYM = 323,
+  COALESCE = 324,
+  CODE_SYM = 325,
+  COLLATE_SYM = 326,
+  COLLATION_SYM = 327,
+  COLUMNS = 328,
+  COLUMN_SYM = 329,
+  COLUMN_FORMAT_SYM = 330,
+  COLUMN_NAME_SYM = 331,
+  COMMENT_SYM = 332,
+  COMMITTED_SYM = 333,
+  COMMIT_SYM = 334,
+  COMPACT_SYM = 335,
+  COMPLETION_SYM = 336,
+  COMPRESSED_SYM = 337,
+  COMPRESSION_SYM = 338,
+  ENCRYPTION_SYM = 339,
+  CONCURRENT = 340,
+  CONDITION_SYM = 341,
+  CONNECTION_SYM = 342,
+  CONSISTENT_SYM = 343,
+  CONSTRAINT = 344,
+  CONSTRAINT_CATALOG_SYM = 345,
+  CONSTRAINT_NAME_SYM = 346,
+  CONSTRAINT_SCHEMA_SYM = 347,
+  CONTAINS_SYM = 348,
+  CONTEXT_SYM = 349,
+  CONTINUE_SYM = 350,
+  CONVERT_SYM = 351,
+  COUNT_SYM = 352,
+  CPU_SYM = 353,
+  CREATE = 354,
+  CROSS = 355,
+  CUBE_SYM = 356,
+  CURDATE = 357,
+  CURRENT_SYM = 358,
+  CURRENT_USER = 359,
+  CURSOR_SYM = 360,
+  CURSOR_NAME_SYM = 361,
+  CURTIME = 362,
+  DATABASE = 363,
+  DATABASES = 364,
+  DATAFILE_SYM = 365,
+  DATA_SYM = 366,
+  DATETIME = 367,
+  DATE_ADD_INTERVAL = 368,
+  DATE_SUB_INTERVAL = 369,
+  DATE_SYM = 370,
+  DAY_HOUR_SYM = 371,
+  DAY_MICROSECOND_SYM = 372,
+  DAY_MINUTE_SYM = 373,
+  DAY_SECOND_SYM = 374,
+  DAY_SYM = 375,
+  DEALLOCATE_SYM = 376,
+  DECIMAL_NUM = 377,
+  DECIMAL_SYM = 378,
+  DECLARE_SYM = 379,
+  DEFAULT = 380,
+  DEFAULT_AUTH_SYM = 381,
+  DEFINER_SYM = 382,
+  DELAYED_SYM = 383,
+  DELAY_KEY_WRITE_SYM = 384,
+  DELETE_SYM = 385,
+  DESC = 386,
+  DESCRIBE = 387,
+  DES_KEY_FILE = 388,
+  DETERMINISTIC_SYM = 389,
+  DIAGNOSTICS_SYM = 390,
+  DIRECTORY_SYM = 391,
+  DISABLE_SYM = 392,
+  DISCARD = 393,
+  DISK_SYM = 394,
+  DISTINCT = 395,
+  DIV_SYM = 396,
+  DOUBLE_SYM = 397,
+  DO_SYM = 398,
+  DROP = 399,
+  DUAL_SYM = 400,
+  DUMPFILE = 401,
+  DUPLICATE_SYM = 402,
+  DYNAMIC_SYM = 403,
+  EACH_SYM = 404,
+  ELSE = 405,
+  ELSEIF_SYM = 406,
+  ENABLE_SYM = 407,
+  ENCLOSED = 408,
+  END = 409,
+  ENDS_SYM = 410,
+  END_OF_INPUT = 411,
+  ENGINES_SYM = 412,
+  ENGINE_SYM = 413,
+  ENUM = 414,
+  EQ = 415,
+  EQUAL_SYM = 416,
+  ERROR_SYM = 417,
+  ERRORS = 418,
+  ESCAPED = 419,
+  ESCAPE_SYM = 420,
+  EVENTS_SYM = 421,
+  EVENT_SYM = 422,
+  EVERY_SYM = 423,
+  EXCHANGE_SYM = 424,
+  EXECUTE_SYM = 425,
+  EXISTS = 426,
+  EXIT_SYM = 427,
+  EXPANSION_SYM = 428,
+  EXPIRE_SYM = 429,
+  EXPORT_SYM = 430,
+  EXTENDED_SYM = 431,
+  EXTENT_SIZE_SYM = 432,
+  EXTRACT_SYM = 433,
+  FALSE_SYM = 434,
+  FAST_SYM = 435,
+  FAULTS_SYM = 436,
+  FETCH_SYM = 437,
+  FILE_SYM = 438,
+  FILE_BLOCK_SIZE_SYM = 439,
+  FILTER_SYM = 440,
+  FIRST_SYM = 441,
+  FIXED_SYM = 442,
+  FLOAT_NUM = 443,
+  FLOAT_SYM = 444,
+  FLUSH_SYM = 445,
+  FOLLOWS_SYM = 446,
+  FORCE_SYM = 447,
+  FOREIGN = 448,
+  FOR_SYM = 449,
+  FORMAT_SYM = 450,
+  FOUND_SYM = 451,
+  FROM = 452,
+  FULL = 453,
+  FULLTEXT_SYM = 454,
+  FUNCTION_SYM = 455,
+  GE = 456,
+  GENERAL = 457,
+  GENERATED = 458,
+  GROUP_REPLICATION = 459,
+  GEOMETRYCOLLECTION = 460,
+  GEOMETRY_SYM = 461,
+  GET_FORMAT = 462,
+  GET_SYM = 463,
+  GLOBAL_SYM = 464,
+  GRANT = 465,
+  GRANTS = 466,
+  GROUP_SYM = 467,
+  GROUP_CONCAT_SYM = 468,
+  GT_SYM = 469,
+  HANDLER_SYM = 470,
+  HASH_SYM = 471,
+  HAVING = 472,
+  HELP_SYM = 473,
+  HEX_NUM = 474,
+  HIGH_PRIORITY = 475,
+  HOST_SYM = 476,
+  HOSTS_SYM = 477,
+  HOUR_MICROSECOND_SYM = 478,
+  HOUR_MINUTE_SYM = 479,
+  HOUR_SECOND_SYM = 480,
+  HOUR_SYM = 481,
+  IDENT = 482,
+  IDENTIFIED_SYM = 483,
+  IDENT_QUOTED = 484,
+  IF = 485,
+  IGNORE_SYM = 486,
+  IGNORE_SERVER_IDS_SYM = 487,
+  IMPORT = 488,
+  INDEXES = 489,
+  INDEX_SYM = 490,
+  INFILE = 491,
+  INITIAL_SIZE_SYM = 492,
+  INNER_SYM = 493,
+  INOUT_SYM = 494,
+  INSENSITIVE_SYM = 495,
+  INSERT = 496,
+  INSERT_METHOD = 497,
+  INSTANCE_SYM = 498,
+  INSTALL_SYM = 499,
+  INTERVAL_SYM = 500,
+  INTO = 501,
+  INT_SYM = 502,
+  INVOKER_SYM = 503,
+  IN_SYM = 504,
+  IO_AFTER_GTIDS = 505,
+  IO_BEFORE_GTIDS = 506,
+  IO_SYM = 507,
+  IPC_SYM = 508,
+  IS = 509,
+  ISOLATION = 510,
+  ISSUER_SYM = 511,
+  ITERATE_SYM = 512,
+  JOIN_SYM = 513,
+  JSON_SEPARATOR_SYM = 514,
+  JSON_UNQUOTED_SEPARATOR_SYM = 515,
+  JSON_SYM = 516,
+  KEYS = 517,
+  KEY_BLOCK_SIZE = 518,
+  KEY_SYM = 519,
+  KILL_SYM = 520,
+  LANGUAGE_SYM = 521,
+  LAST_SYM = 522,
+  LE = 523,
+  LEADING = 524,
+  LEAVES = 525,
+  LEAVE_SYM = 526,
+  LEFT = 527,
+  LESS_SYM = 528,
+  LEVEL_SYM = 529,
+  LEX_HOSTNAME = 530,
+  LIKE = 531,
+  LIMIT = 532,
+  LINEAR_SYM = 533,
+  LINES = 534,
+  LINESTRING = 535,
+  LIST_SYM = 536,
+  LOAD = 537,
+  LOCAL_SYM = 538,
+  LOCATOR_SYM = 539,
+  LOCKS_SYM = 540,
+  LOCK_SYM = 541,
+  LOGFILE_SYM = 542,
+  LOGS_SYM = 543,
+  LONGBLOB = 544,
+  LONGTEXT = 545,
+  LONG_NUM = 546,
+  LONG_SYM = 547,
+  LOOP_SYM = 548,
+  LOW_PRIORITY = 549,
+  LT = 550,
+  MASTER_AUTO_POSITION_SYM = 551,
+  MASTER_BIND_SYM = 552,
+  MASTER_CONNECT_RETRY_SYM = 553,
+  MASTER_DELAY_SYM = 554,
+  MASTER_HOST_SYM = 555,
+  MASTER_LOG_FILE_SYM = 556,
+  MASTER_LOG_POS_SYM = 557,
+  MASTER_PASSWORD_SYM = 558,
+  MASTER_PORT_SYM = 559,
+  MASTER_RETRY_COUNT_SYM = 560,
+  MASTER_SERVER_ID_SYM = 561,
+  MASTER_SSL_CAPATH_SYM = 562,
+  MASTER_TLS_VERSION_SYM = 563,
+  MASTER_SSL_CA_SYM = 564,
+  MASTER_SSL_CERT_SYM = 565,
+  MASTER_SSL_CIPHER_SYM = 566,
+  MASTER_SSL_CRL_SYM = 567,
+  MASTER_SSL_CRLPATH_SYM = 568,
+  MASTER_SSL_KEY_SYM = 569,
+  MASTER_SSL_SYM = 570,
+  MASTER_SSL_VERIFY_SERVER_CERT_SYM = 571,
+  MASTER_SYM = 572,
+  MASTER_USER_SYM = 573,
+  MASTER_HEARTBEAT_PERIOD_SYM = 574,
+  MATCH = 575,
+  MAX_CONNECTIONS_PER_HOUR = 576,
+  MAX_QUERIES_PER_HOUR = 577,
+  MAX_ROWS = 578,
+  MAX_SIZE_SYM = 579,
+  MAX_SYM = 580,
+  MAX_UPDATES_PER_HOUR = 581,
+  MAX_USER_CONNECTIONS_SYM = 582,
+  MAX_VALUE_SYM = 583,
+  MEDIUMBLOB = 584,
+  MEDIUMINT = 585,
+  MEDIUMTEXT = 586,
+  MEDIUM_SYM = 587,
+  MEMORY_SYM = 588,
+  MERGE_SYM = 589,
+  MESSAGE_TEXT_SYM = 590,
+  MICROSECOND_SYM = 591,
+  MIGRATE_SYM = 592,
+  MINUTE_MICROSECOND_SYM = 593,
+  MINUTE_SECOND_SYM = 594,
+  MINUTE_SYM = 595,
+  MIN_ROWS = 596,
+  MIN_SYM = 597,
+  MODE_SYM = 598,
+  MODIFIES_SYM = 599,
+  MODIFY_SYM = 600,
+  MOD_SYM = 601,
+  MONTH_SYM = 602,
+  MULTILINESTRING = 603,
+  MULTIPOINT = 604,
+  MULTIPOLYGON = 605,
+  MUTEX_SYM = 606,
+  MYSQL_ERRNO_SYM = 607,
+  NAMES_SYM = 608,
+  NAME_SYM = 609,
+  NATIONAL_SYM = 610,
+  NATURAL = 611,
+  NCHAR_STRING = 612,
+  NCHAR_SYM = 613,
+  NDBCLUSTER_SYM = 614,
+  NE = 615,
+  NEG = 616,
+  NEVER_SYM = 617,
+  NEW_SYM = 618,
+  NEXT_SYM = 619,
+  NODEGROUP_SYM = 620,
+  NONE_SYM = 621,
+  NOT2_SYM = 622,
+  NOT_SYM = 623,
+  NOW_SYM = 624,
+  NO_SYM = 625,
+  NO_WAIT_SYM = 626,
+  NO_WRITE_TO_BINLOG = 627,
+  NULL_SYM = 628,
+  NUM = 629,
+  NUMBER_SYM = 630,
+  NUMERIC_SYM = 631,
+  NVARCHAR_SYM = 632,
+  OFFSET_SYM = 633,
+  ON = 634,
+  ONE_SYM = 635,
+  ONLY_SYM = 636,
+  OPEN_SYM = 637,
+  OPTIMIZE = 638,
+  OPTIMIZER_COSTS_SYM = 639,
+  OPTIONS_SYM = 640,
+  OPTION = 641,
+  OPTIONALLY = 642,
+  OR2_SYM = 643,
+  ORDER_SYM = 644,
+  OR_OR_SYM = 645,
+  OR_SYM = 646,
+  OUTER = 647,
+  OUTFILE = 648,
+  OUT_SYM = 649,
+  OWNER_SYM = 650,
+  PACK_KEYS_SYM = 651,
+  PAGE_SYM = 652,
+  PARAM_MARKER = 653,
+  PARSER_SYM = 654,
+  PARSE_GCOL_EXPR_SYM = 655,
+  PARTIAL = 656,
+  PARTITION_SYM = 657,
+  PARTITIONS_SYM = 658,
+  PARTITIONING_SYM = 659,
+  PASSWORD = 660,
+  PHASE_SYM = 661,
+  PLUGIN_DIR_SYM = 662,
+  PLUGIN_SYM = 663,
+  PLUGINS_SYM = 664,
+  POINT_SYM = 665,
+  POLYGON = 666,
+  PORT_SYM = 667,
+  POSITION_SYM = 668,
+  PRECEDES_SYM = 669,
+  PRECISION = 670,
+  PREPARE_SYM = 671,
+  PRESERVE_SYM = 672,
+  PREV_SYM = 673,
+  PRIMARY_SYM = 674,
+  PRIVILEGES = 675,
+  PROCEDURE_SYM = 676,
+  PROCESS = 677,
+  PROCESSLIST_SYM = 678,
+  PROFILE_SYM = 679,
+  PROFILES_SYM = 680,
+  PROXY_SYM = 681,
+  PURGE = 682,
+  QUARTER_SYM = 683,
+  QUERY_SYM = 684,
+  QUICK = 685,
+  RANGE_SYM = 686,
+  READS_SYM = 687,
+  READ_ONLY_SYM = 688,
+  READ_SYM = 689,
+  READ_WRITE_SYM = 690,
+  REAL = 691,
+  REBUILD_SYM = 692,
+  RECOVER_SYM = 693,
+  REDOFILE_SYM = 694,
+  REDO_BUFFER_SIZE_SYM = 695,
+  REDUNDANT_SYM = 696,
+  REFERENCES = 697,
+  REGEXP = 698,
+  RELAY = 699,
+  RELAYLOG_SYM = 700,
+  RELAY_LOG_FILE_SYM = 701,
+  RELAY_LOG_POS_SYM = 702,
+  RELAY_THREAD = 703,
+  RELEASE_SYM = 704,
+  RELOAD = 705,
+  REMOVE_SYM = 706,
+  RENAME = 707,
+  REORGANIZE_SYM = 708,
+  REPAIR = 709,
+  REPEATABLE_SYM = 710,
+  REPEAT_SYM = 711,
+  REPLACE = 712,
+  REPLICATION = 713,
+  REPLICATE_DO_DB = 714,
+  REPLICATE_IGNORE_DB = 715,
+  REPLICATE_DO_TABLE = 716,
+  REPLICATE_IGNORE_TABLE = 717,
+  REPLICATE_WILD_DO_TABLE = 718,
+  REPLICATE_WILD_IGNORE_TABLE = 719,
+  REPLICATE_REWRITE_DB = 720,
+  REQUIRE_SYM = 721,
+  RESET_SYM = 722,
+  RESIGNAL_SYM = 723,
+  RESOURCES = 724,
+  RESTORE_SYM = 725,
+  RESTRICT = 726,
+  RESUME_SYM = 727,
+  RETURNED_SQLSTATE_SYM = 728,
+  RETURNS_SYM = 729,
+  RETURN_SYM = 730,
+  REVERSE_SYM = 731,
+  REVOKE = 732,
+  RIGHT = 733,
+  ROLLBACK_SYM = 734,
+  ROLLUP_SYM = 735,
+  ROTATE_SYM = 736,
+  ROUTINE_SYM = 737,
+  ROWS_SYM = 738,
+  ROW_FORMAT_SYM = 739,
+  ROW_SYM = 740,
+  ROW_COUNT_SYM = 741,
+  RTREE_SYM = 742,
+  SAVEPOINT_SYM = 743,
+  SCHEDULE_SYM = 744,
+  SCHEMA_NAME_SYM = 745,
+  SECOND_MICROSECOND_SYM = 746,
+  SECOND_SYM = 747,
+  SECURITY_SYM = 748,
+  SELECT_SYM = 749,
+  SENSITIVE_SYM = 750,
+  SEPARATOR_SYM = 751,
+  SERIALIZABLE_SYM = 752,
+  SERIAL_SYM = 753,
+  SESSION_SYM = 754,
+  SERVER_SYM = 755,
+  SERVER_OPTIONS = 756,
+  SET = 757,
+  SET_VAR = 758,
+  SHARE_SYM = 759,
+  SHIFT_LEFT = 760,
+  SHIFT_RIGHT = 761,
+  SHOW = 762,
+  SHUTDOWN = 763,
+  SIGNAL_SYM = 764,
+  SIGNED_SYM = 765,
+  SIMPLE_SYM = 766,
+  SLAVE = 767,
+  SLOW = 768,
+  SMALLINT = 769,
+  SNAPSHOT_SYM = 770,
+  SOCKET_SYM = 771,
+  SONAME_SYM = 772,
+  SOUNDS_SYM = 773,
+  SOURCE_SYM = 774,
+  SPATIAL_SYM = 775,
+  SPECIFIC_SYM = 776,
+  SQLEXCEPTION_SYM = 777,
+  SQLSTATE_SYM = 778,
+  SQLWARNING_SYM = 779,
+  SQL_AFTER_GTIDS = 780,
+  SQL_AFTER_MTS_GAPS = 781,
+  SQL_BEFORE_GTIDS = 782,
+  SQL_BIG_RESULT = 783,
+  SQL_BUFFER_RESULT = 784,
+  SQL_CACHE_SYM = 785,
+  SQL_CALC_FOUND_ROWS = 786,
+  SQL_NO_CACHE_SYM = 787,
+  SQL_SMALL_RESULT = 788,
+  SQL_SYM = 789,
+  SQL_THREAD = 790,
+  SSL_SYM = 791,
+  STACKED_SYM = 792,
+  STARTING = 793,
+  STARTS_SYM = 794,
+  START_SYM = 795,
+  STATS_AUTO_RECALC_SYM = 796,
+  STATS_PERSISTENT_SYM = 797,
+  STATS_SAMPLE_PAGES_SYM = 798,
+  STATUS_SYM = 799,
+  STDDEV_SAMP_SYM = 800,
+  STD_SYM = 801,
+  STOP_SYM = 802,
+  STORAGE_SYM = 803,
+  STORED_SYM = 804,
+  STRAIGHT_JOIN = 805,
+  STRING_SYM = 806,
+  SUBCLASS_ORIGIN_SYM = 807,
+  SUBDATE_SYM = 808,
+  SUBJECT_SYM = 809,
+  SUBPARTITIONS_SYM = 810,
+  SUBPARTITION_SYM = 811,
+  SUBSTRING = 812,
+  SUM_SYM = 813,
+  SUPER_SYM = 814,
+  SUSPEND_SYM = 815,
+  SWAPS_SYM = 816,
+  SWITCHES_SYM = 817,
+  SYSDATE = 818,
+  TABLES = 819,
+  TABLESPACE_SYM = 820,
+  TABLE_REF_PRIORITY = 821,
+  TABLE_SYM = 822,
+  TABLE_CHECKSUM_SYM = 823,
+  TABLE_NAME_SYM = 824,
+  TEMPORARY = 825,
+  TEMPTABLE_SYM = 826,
+  TERMINATED = 827,
+  TEXT_STRING = 828,
+  TEXT_SYM = 829,
+  THAN_SYM = 830,
+  THEN_SYM = 831,
+  TIMESTAMP = 832,
+  TIMESTAMP_ADD = 833,
+  TIMESTAMP_DIFF = 834,
+  TIME_SYM = 835,
+  TINYBLOB = 836,
+  TINYINT = 837,
+  TINYTEXT = 838,
+  TO_SYM = 839,
+  TRAILING = 840,
+  TRANSACTION_SYM = 841,
+  TRIGGERS_SYM = 842,
+  TRIGGER_SYM = 843,
+  TRIM = 844,
+  TRUE_SYM = 845,
+  TRUNCATE_SYM = 846,
+  TYPES_SYM = 847,
+  TYPE_SYM = 848,
+  UDF_RETURNS_SYM = 849,
+  ULONGLONG_NUM = 850,
+  UNCOMMITTED_SYM = 851,
+  UNDEFINED_SYM = 852,
+  UNDERSCORE_CHARSET = 853,
+  UNDOFILE_SYM = 854,
+  UNDO_BUFFER_SIZE_SYM = 855,
+  UNDO_SYM = 856,
+  UNICODE_SYM = 857,
+  UNINSTALL_SYM = 858,
+  UNION_SYM = 859,
+  UNIQUE_SYM = 860,
+  UNKNOWN_SYM = 861,
+  UNLOCK_SYM = 862,
+  UNSIGNED = 863,
+  UNTIL_SYM = 864,
+  UPDATE_SYM = 865,
+  UPGRADE_SYM = 866,
+  USAGE = 867,
+  USER = 868,
+  USE_FRM = 869,
+  USE_SYM = 870,
+  USING = 871,
+  UTC_DATE_SYM = 872,
+  UTC_TIMESTAMP_SYM = 873,
+  UTC_TIME_SYM = 874,
+  VALIDATION_SYM = 875,
+  VALUES = 876,
+  VALUE_SYM = 877,
+  VARBINARY = 878,
+  VARCHAR = 879,
+  VARIABLES = 880,
+  VARIANCE_SYM = 881,
+  VARYING = 882,
+  VAR_SAMP_SYM = 883,
+  VIEW_SYM = 884,
+  VIRTUAL_SYM = 885,
+  WAIT_SYM = 886,
+  WARNINGS = 887,
+  WEEK_SYM = 888,
+  WEIGHT_STRING_SYM = 889,
+  WHEN_SYM = 890,
+  WHERE = 891,
+  WHILE_SYM = 892,
+  WITH = 893,
+  WITH_CUBE_SYM = 894,
+  WITH_ROLLUP_SYM = 895,
+  WITHOUT_SYM = 896,
+  WORK_SYM = 897,
+  WRAPPER_SYM = 898,
+  WRITE_SYM = 899,
+  X509_SYM = 900,
+  XA_SYM = 901,
+  XID_SYM = 902,
+  XML_SYM = 903,
+  XOR = 904,
+  YEAR_MONTH_SYM = 905,
+  YEAR_SYM = 906,
+  ZEROFILL = 907,
+  JSON_OBJECTAGG = 908,
+  JSON_ARRAYAGG = 909,
+  MAX_EXECUTION_TIME_HINT = 910,
+  BKA_HINT = 911,
+  BNL_HINT = 912,
+  DUPSWEEDOUT_HINT = 913,
+  FIRSTMATCH_HINT = 914,
+  INTOEXISTS_HINT = 915,
+  LOOSESCAN_HINT = 916,
+  MATERIALIZATION_HINT = 917,
+  NO_BKA_HINT = 918,
+  NO_BNL_HINT = 919,
+  NO_ICP_HINT = 920,
+  NO_MRR_HINT = 921,
+  NO_RANGE_OPTIMIZATION_HINT = 922,
+  NO_SEMIJOIN_HINT = 923,
+  MRR_HINT = 924,
+  QB_NAME_HINT = 925,
+  SEMIJOIN_HINT = 926,
+  SUBQUERY_HINT = 927,
+  HINT_ARG_NUMBER = 928,
+  HINT_ARG_IDENT = 929,
+  HINT_ARG_QB_NAME = 930,
+  HINT_CLOSE = 931,
+  HINT_ERROR = 932,
+};
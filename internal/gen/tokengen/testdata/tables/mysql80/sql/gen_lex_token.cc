@@ -0,0 +1,16 @@
+/* What tokengen reads from MySQL 8.0's sql/gen_lex_token.cc to generate the
+   tables committed in internal/; see TestGenerate_Committed. */
+  max_token_seen= 1100;
+  tok_generic_value= max_token_seen++;
+  tok_generic_value_list= max_token_seen++;
+  tok_row_single_value= max_token_seen++;
+  tok_row_single_value_list= max_token_seen++;
+  tok_row_multiple_value= max_token_seen++;
+  tok_row_multiple_value_list= max_token_seen++;
+  tok_ident= max_token_seen++;
+  tok_ident_at= max_token_seen++;
+  tok_hint_comment_open= max_token_seen++;
+  tok_hint_comment_close= max_token_seen++;
+  tok_in_generic_value_expression= max_token_seen++;
+  tok_by_numeric_column= max_token_seen++;
+  tok_unused= max_token_seen++;
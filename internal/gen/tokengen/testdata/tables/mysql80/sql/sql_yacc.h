@@ -0,0 +1,893 @@
+/* What tokengen reads from MySQL 8.0's sql/sql_yacc.h to generate the
+   tables committed in internal/; see TestGenerate_Committed. */
+enum yytokentype
+{
+  MY_SQL_PARSER_EOF = 0,
+  ABORT_SYM = 258,
+  ACCESSIBLE_SYM = 259,
+  ACCOUNT_SYM = 260,
+  ACTION = 261,
+  ADD = 262,
+  ADDDATE_SYM = 263,
+  AFTER_SYM = 264,
+  AGAINST = 265,
+  AGGREGATE_SYM = 266,
+  ALGORITHM_SYM = 267,
+  ALL = 268,
+  ALTER = 269,
+  ALWAYS_SYM = 270,
+  OBSOLETE_TOKEN_271 = 271,
+  ANALYZE_SYM = 272,
+  AND_AND_SYM = 273,
+  AND_SYM = 274,
+  ANY_SYM = 275,
+  AS = 276,
+  ASC = 277,
+  ASCII_SYM = 278,
+  ASENSITIVE_SYM = 279,
+  AT_SYM = 280,
+  AUTOEXTEND_SIZE_SYM = 281,
+  AUTO_INC = 282,
+  AVG_ROW_LENGTH = 283,
+  AVG_SYM = 284,
+  BACKUP_SYM = 285,
+  BEFORE_SYM = 286,
+  BEGIN_SYM = 287,
+  BETWEEN_SYM = 288,
+  BIGINT_SYM = 289,
+  BINARY_SYM = 290,
+  BINLOG_SYM = 291,
+  BIN_NUM = 292,
+  BIT_AND_SYM = 293,
+  BIT_OR_SYM = 294,
+  BIT_SYM = 295,
+  BIT_XOR_SYM = 296,
+  BLOB_SYM = 297,
+  BLOCK_SYM = 298,
+  BOOLEAN_SYM = 299,
+  BOOL_SYM = 300,
+  BOTH = 301,
+  BTREE_SYM = 302,
+  BY = 303,
+  BYTE_SYM = 304,
+  CACHE_SYM = 305,
+  CALL_SYM = 306,
+  CASCADE = 307,
+  CASCADED = 308,
+  CASE_SYM = 309,
+  CAST_SYM = 310,
+  CATALOG_NAME_SYM = 311,
+  CHAIN_SYM = 312,
+  CHANGE = 313,
+  CHANGED = 314,
+  CHANNEL_SYM = 315,
+  CHARSET = 316,
+  CHAR_SYM = 317,
+  CHECKSUM_SYM = 318,
+  CHECK_SYM = 319,
+  CIPHER_SYM = 320,
+  CLASS_ORIGIN_SYM = 321,
+  CLIENT_SYM = 322,
+  CLOSE_SYM = 323,
+  COALESCE = 324,
+  CODE_SYM = 325,
+  COLLATE_SYM = 326,
+  COLLATION_SYM = 327,
+  COLUMNS = 328,
+  COLUMN_SYM = 329,
+  COLUMN_FORMAT_SYM = 330,
+  COLUMN_NAME_SYM = 331,
+  COMMENT_SYM = 332,
+  COMMITTED_SYM = 333,
+  COMMIT_SYM = 334,
+  COMPACT_SYM = 335,
+  COMPLETION_SYM = 336,
+  COMPRESSED_SYM = 337,
+  COMPRESSION_SYM = 338,
+  ENCRYPTION_SYM = 339,
+  CONCURRENT = 340,
+  CONDITION_SYM = 341,
+  CONNECTION_SYM = 342,
+  CONSISTENT_SYM = 343,
+  CONSTRAINT = 344,
+  CONSTRAINT_CATALOG_SYM = 345,
+  CONSTRAINT_NAME_SYM = 346,
+  CONSTRAINT_SCHEMA_SYM = 347,
+  CONTAINS_SYM = 348,
+  CONTEXT_SYM = 349,
+  CONTINUE_SYM = 350,
+  CONVERT_SYM = 351,
+  COUNT_SYM = 352,
+  CPU_SYM = 353,
+  CREATE = 354,
+  CROSS = 355,
+  CUBE_SYM = 356,
+  CURDATE = 357,
+  CURRENT_SYM = 358,
+  CURRENT_USER = 359,
+  CURSOR_SYM = 360,
+  CURSOR_NAME_SYM = 361,
+  CURTIME = 362,
+  DATABASE = 363,
+  DATABASES = 364,
+  DATAFILE_SYM = 365,
+  DATA_SYM = 366,
+  DATETIME_SYM = 367,
+  DATE_ADD_INTERVAL = 368,
+  DATE_SUB_INTERVAL = 369,
+  DATE_SYM = 370,
+  DAY_HOUR_SYM = 371,
+  DAY_MICROSECOND_SYM = 372,
+  DAY_MINUTE_SYM = 373,
+  DAY_SECOND_SYM = 374,
+  DAY_SYM = 375,
+  DEALLOCATE_SYM = 376,
+  DECIMAL_NUM = 377,
+  DECIMAL_SYM = 378,
+  DECLARE_SYM = 379,
+  DEFAULT_SYM = 380,
+  DEFAULT_AUTH_SYM = 381,
+  DEFINER_SYM = 382,
+  DELAYED_SYM = 383,
+  DELAY_KEY_WRITE_SYM = 384,
+  DELETE_SYM = 385,
+  DESC = 386,
+  DESCRIBE = 387,
+  OBSOLETE_TOKEN_388 = 388,
+  DETERMINISTIC_SYM = 389,
+  DIAGNOSTICS_SYM = 390,
+  DIRECTORY_SYM = 391,
+  DISABLE_SYM = 392,
+  DISCARD_SYM = 393,
+  DISK_SYM = 394,
+  DISTINCT = 395,
+  DIV_SYM = 396,
+  DOUBLE_SYM = 397,
+  DO_SYM = 398,
+  DROP = 399,
+  DUAL_SYM = 400,
+  DUMPFILE = 401,
+  DUPLICATE_SYM = 402,
+  DYNAMIC_SYM = 403,
+  EACH_SYM = 404,
+  ELSE = 405,
+  ELSEIF_SYM = 406,
+  ENABLE_SYM = 407,
+  ENCLOSED = 408,
+  END = 409,
+  ENDS_SYM = 410,
+  END_OF_INPUT = 411,
+  ENGINES_SYM = 412,
+  ENGINE_SYM = 413,
+  ENUM_SYM = 414,
+  EQ = 415,
+  EQUAL_SYM = 416,
+  ERROR_SYM = 417,
+  ERRORS = 418,
+  ESCAPED = 419,
+  ESCAPE_SYM = 420,
+  EVENTS_SYM = 421,
+  EVENT_SYM = 422,
+  EVERY_SYM = 423,
+  EXCHANGE_SYM = 424,
+  EXECUTE_SYM = 425,
+  EXISTS = 426,
+  EXIT_SYM = 427,
+  EXPANSION_SYM = 428,
+  EXPIRE_SYM = 429,
+  EXPORT_SYM = 430,
+  EXTENDED_SYM = 431,
+  EXTENT_SIZE_SYM = 432,
+  EXTRACT_SYM = 433,
+  FALSE_SYM = 434,
+  FAST_SYM = 435,
+  FAULTS_SYM = 436,
+  FETCH_SYM = 437,
+  FILE_SYM = 438,
+  FILE_BLOCK_SIZE_SYM = 439,
+  FILTER_SYM = 440,
+  FIRST_SYM = 441,
+  FIXED_SYM = 442,
+  FLOAT_NUM = 443,
+  FLOAT_SYM = 444,
+  FLUSH_SYM = 445,
+  FOLLOWS_SYM = 446,
+  FORCE_SYM = 447,
+  FOREIGN = 448,
+  FOR_SYM = 449,
+  FORMAT_SYM = 450,
+  FOUND_SYM = 451,
+  FROM = 452,
+  FULL = 453,
+  FULLTEXT_SYM = 454,
+  FUNCTION_SYM = 455,
+  GE = 456,
+  GENERAL = 457,
+  GENERATED = 458,
+  GROUP_REPLICATION = 459,
+  GEOMETRYCOLLECTION_SYM = 460,
+  GEOMETRY_SYM = 461,
+  GET_FORMAT = 462,
+  GET_SYM = 463,
+  GLOBAL_SYM = 464,
+  GRANT = 465,
+  GRANTS = 466,
+  GROUP_SYM = 467,
+  GROUP_CONCAT_SYM = 468,
+  GT_SYM = 469,
+  HANDLER_SYM = 470,
+  HASH_SYM = 471,
+  HAVING = 472,
+  HELP_SYM = 473,
+  HEX_NUM = 474,
+  HIGH_PRIORITY = 475,
+  HOST_SYM = 476,
+  HOSTS_SYM = 477,
+  HOUR_MICROSECOND_SYM = 478,
+  HOUR_MINUTE_SYM = 479,
+  HOUR_SECOND_SYM = 480,
+  HOUR_SYM = 481,
+  IDENT = 482,
+  IDENTIFIED_SYM = 483,
+  IDENT_QUOTED = 484,
+  IF = 485,
+  IGNORE_SYM = 486,
+  IGNORE_SERVER_IDS_SYM = 487,
+  IMPORT = 488,
+  INDEXES = 489,
+  INDEX_SYM = 490,
+  INFILE_SYM = 491,
+  INITIAL_SIZE_SYM = 492,
+  INNER_SYM = 493,
+  INOUT_SYM = 494,
+  INSENSITIVE_SYM = 495,
+  INSERT_SYM = 496,
+  INSERT_METHOD = 497,
+  INSTANCE_SYM = 498,
+  INSTALL_SYM = 499,
+  INTERVAL_SYM = 500,
+  INTO = 501,
+  INT_SYM = 502,
+  INVOKER_SYM = 503,
+  IN_SYM = 504,
+  IO_AFTER_GTIDS = 505,
+  IO_BEFORE_GTIDS = 506,
+  IO_SYM = 507,
+  IPC_SYM = 508,
+  IS = 509,
+  ISOLATION = 510,
+  ISSUER_SYM = 511,
+  ITERATE_SYM = 512,
+  JOIN_SYM = 513,
+  JSON_SEPARATOR_SYM = 514,
+  JSON_SYM = 515,
+  KEYS = 516,
+  KEY_BLOCK_SIZE = 517,
+  KEY_SYM = 518,
+  KILL_SYM = 519,
+  LANGUAGE_SYM = 520,
+  LAST_SYM = 521,
+  LE = 522,
+  LEADING = 523,
+  LEAVES = 524,
+  LEAVE_SYM = 525,
+  LEFT = 526,
+  LESS_SYM = 527,
+  LEVEL_SYM = 528,
+  LEX_HOSTNAME = 529,
+  LIKE = 530,
+  LIMIT = 531,
+  LINEAR_SYM = 532,
+  LINES = 533,
+  LINESTRING_SYM = 534,
+  LIST_SYM = 535,
+  LOAD = 536,
+  LOCAL_SYM = 537,
+  OBSOLETE_TOKEN_538 = 538,
+  LOCKS_SYM = 539,
+  LOCK_SYM = 540,
+  LOGFILE_SYM = 541,
+  LOGS_SYM = 542,
+  LONGBLOB_SYM = 543,
+  LONGTEXT_SYM = 544,
+  LONG_NUM = 545,
+  LONG_SYM = 546,
+  LOOP_SYM = 547,
+  LOW_PRIORITY = 548,
+  LT = 549,
+  OBSOLETE_TOKEN_550 = 550,
+  OBSOLETE_TOKEN_551 = 551,
+  OBSOLETE_TOKEN_552 = 552,
+  OBSOLETE_TOKEN_553 = 553,
+  OBSOLETE_TOKEN_554 = 554,
+  OBSOLETE_TOKEN_555 = 555,
+  OBSOLETE_TOKEN_556 = 556,
+  OBSOLETE_TOKEN_557 = 557,
+  OBSOLETE_TOKEN_558 = 558,
+  OBSOLETE_TOKEN_559 = 559,
+  OBSOLETE_TOKEN_561 = 561,
+  OBSOLETE_TOKEN_562 = 562,
+  OBSOLETE_TOKEN_563 = 563,
+  OBSOLETE_TOKEN_564 = 564,
+  OBSOLETE_TOKEN_565 = 565,
+  OBSOLETE_TOKEN_566 = 566,
+  OBSOLETE_TOKEN_567 = 567,
+  OBSOLETE_TOKEN_568 = 568,
+  OBSOLETE_TOKEN_569 = 569,
+  OBSOLETE_TOKEN_570 = 570,
+  MASTER_SYM = 571,
+  OBSOLETE_TOKEN_572 = 572,
+  OBSOLETE_TOKEN_573 = 573,
+  MATCH = 574,
+  MAX_CONNECTIONS_PER_HOUR = 575,
+  MAX_QUERIES_PER_HOUR = 576,
+  MAX_ROWS = 577,
+  MAX_SIZE_SYM = 578,
+  MAX_SYM = 579,
+  MAX_UPDATES_PER_HOUR = 580,
+  MAX_USER_CONNECTIONS_SYM = 581,
+  MAX_VALUE_SYM = 582,
+  MEDIUMBLOB_SYM = 583,
+  MEDIUMINT_SYM = 584,
+  MEDIUMTEXT_SYM = 585,
+  MEDIUM_SYM = 586,
+  MEMORY_SYM = 587,
+  MERGE_SYM = 588,
+  MESSAGE_TEXT_SYM = 589,
+  MICROSECOND_SYM = 590,
+  MIGRATE_SYM = 591,
+  MINUTE_MICROSECOND_SYM = 592,
+  MINUTE_SECOND_SYM = 593,
+  MINUTE_SYM = 594,
+  MIN_ROWS = 595,
+  MIN_SYM = 596,
+  MODE_SYM = 597,
+  MODIFIES_SYM = 598,
+  MODIFY_SYM = 599,
+  MOD_SYM = 600,
+  MONTH_SYM = 601,
+  MULTILINESTRING_SYM = 602,
+  MULTIPOINT_SYM = 603,
+  MULTIPOLYGON_SYM = 604,
+  MUTEX_SYM = 605,
+  MYSQL_ERRNO_SYM = 606,
+  NAMES_SYM = 607,
+  NAME_SYM = 608,
+  NATIONAL_SYM = 609,
+  NATURAL = 610,
+  NCHAR_STRING = 611,
+  NCHAR_SYM = 612,
+  NDBCLUSTER_SYM = 613,
+  NE = 614,
+  NEG = 615,
+  NEVER_SYM = 616,
+  NEW_SYM = 617,
+  NEXT_SYM = 618,
+  NODEGROUP_SYM = 619,
+  NONE_SYM = 620,
+  NOT2_SYM = 621,
+  NOT_SYM = 622,
+  NOW_SYM = 623,
+  NO_SYM = 624,
+  NO_WAIT_SYM = 625,
+  NO_WRITE_TO_BINLOG = 626,
+  NULL_SYM = 627,
+  NUM = 628,
+  NUMBER_SYM = 629,
+  NUMERIC_SYM = 630,
+  NVARCHAR_SYM = 631,
+  OFFSET_SYM = 632,
+  ON_SYM = 633,
+  ONE_SYM = 634,
+  ONLY_SYM = 635,
+  OPEN_SYM = 636,
+  OPTIMIZE = 637,
+  OPTIMIZER_COSTS_SYM = 638,
+  OPTIONS_SYM = 639,
+  OPTION = 640,
+  OPTIONALLY = 641,
+  OR2_SYM = 642,
+  ORDER_SYM = 643,
+  OR_OR_SYM = 644,
+  OR_SYM = 645,
+  OUTER_SYM = 646,
+  OUTFILE = 647,
+  OUT_SYM = 648,
+  OWNER_SYM = 649,
+  PACK_KEYS_SYM = 650,
+  PAGE_SYM = 651,
+  PARAM_MARKER = 652,
+  PARSER_SYM = 653,
+  OBSOLETE_TOKEN_654 = 654,
+  PARTIAL = 655,
+  PARTITION_SYM = 656,
+  PARTITIONS_SYM = 657,
+  PARTITIONING_SYM = 658,
+  PASSWORD = 659,
+  PHASE_SYM = 660,
+  PLUGIN_DIR_SYM = 661,
+  PLUGIN_SYM = 662,
+  PLUGINS_SYM = 663,
+  POINT_SYM = 664,
+  POLYGON_SYM = 665,
+  PORT_SYM = 666,
+  POSITION_SYM = 667,
+  PRECEDES_SYM = 668,
+  PRECISION = 669,
+  PREPARE_SYM = 670,
+  PRESERVE_SYM = 671,
+  PREV_SYM = 672,
+  PRIMARY_SYM = 673,
+  PRIVILEGES = 674,
+  PROCEDURE_SYM = 675,
+  PROCESS = 676,
+  PROCESSLIST_SYM = 677,
+  PROFILE_SYM = 678,
+  PROFILES_SYM = 679,
+  PROXY_SYM = 680,
+  PURGE = 681,
+  QUARTER_SYM = 682,
+  QUERY_SYM = 683,
+  QUICK = 684,
+  RANGE_SYM = 685,
+  READS_SYM = 686,
+  READ_ONLY_SYM = 687,
+  READ_SYM = 688,
+  READ_WRITE_SYM = 689,
+  REAL_SYM = 690,
+  REBUILD_SYM = 691,
+  RECOVER_SYM = 692,
+  OBSOLETE_TOKEN_693 = 693,
+  REDO_BUFFER_SIZE_SYM = 694,
+  REDUNDANT_SYM = 695,
+  REFERENCES = 696,
+  REGEXP = 697,
+  RELAY = 698,
+  RELAYLOG_SYM = 699,
+  RELAY_LOG_FILE_SYM = 700,
+  RELAY_LOG_POS_SYM = 701,
+  RELAY_THREAD = 702,
+  RELEASE_SYM = 703,
+  RELOAD = 704,
+  REMOVE_SYM = 705,
+  RENAME = 706,
+  REORGANIZE_SYM = 707,
+  REPAIR = 708,
+  REPEATABLE_SYM = 709,
+  REPEAT_SYM = 710,
+  REPLACE_SYM = 711,
+  REPLICATION = 712,
+  REPLICATE_DO_DB = 713,
+  REPLICATE_IGNORE_DB = 714,
+  REPLICATE_DO_TABLE = 715,
+  REPLICATE_IGNORE_TABLE = 716,
+  REPLICATE_WILD_DO_TABLE = 717,
+  REPLICATE_WILD_IGNORE_TABLE = 718,
+  REPLICATE_REWRITE_DB = 719,
+  REQUIRE_SYM = 720,
+  RESET_SYM = 721,
+  RESIGNAL_SYM = 722,
+  RESOURCES = 723,
+  RESTORE_SYM = 724,
+  RESTRICT = 725,
+  RESUME_SYM = 726,
+  RETURNED_SQLSTATE_SYM = 727,
+  RETURNS_SYM = 728,
+  RETURN_SYM = 729,
+  REVERSE_SYM = 730,
+  REVOKE = 731,
+  RIGHT = 732,
+  ROLLBACK_SYM = 733,
+  ROLLUP_SYM = 734,
+  ROTATE_SYM = 735,
+  ROUTINE_SYM = 736,
+  ROWS_SYM = 737,
+  ROW_FORMAT_SYM = 738,
+  ROW_SYM = 739,
+  ROW_COUNT_SYM = 740,
+  RTREE_SYM = 741,
+  SAVEPOINT_SYM = 742,
+  SCHEDULE_SYM = 743,
+  SCHEMA_NAME_SYM = 744,
+  SECOND_MICROSECOND_SYM = 745,
+  SECOND_SYM = 746,
+  SECURITY_SYM = 747,
+  SELECT_SYM = 748,
+  SENSITIVE_SYM = 749,
+  SEPARATOR_SYM = 750,
+  SERIALIZABLE_SYM = 751,
+  SERIAL_SYM = 752,
+  SESSION_SYM = 753,
+  SERVER_SYM = 754,
+  OBSOLETE_TOKEN_755 = 755,
+  SET_SYM = 756,
+  SET_VAR = 757,
+  SHARE_SYM = 758,
+  SHIFT_LEFT = 759,
+  SHIFT_RIGHT = 760,
+  SHOW = 761,
+  SHUTDOWN = 762,
+  SIGNAL_SYM = 763,
+  SIGNED_SYM = 764,
+  SIMPLE_SYM = 765,
+  SLAVE = 766,
+  SLOW = 767,
+  SMALLINT_SYM = 768,
+  SNAPSHOT_SYM = 769,
+  SOCKET_SYM = 770,
+  SONAME_SYM = 771,
+  SOUNDS_SYM = 772,
+  SOURCE_SYM = 773,
+  SPATIAL_SYM = 774,
+  SPECIFIC_SYM = 775,
+  SQLEXCEPTION_SYM = 776,
+  SQLSTATE_SYM = 777,
+  SQLWARNING_SYM = 778,
+  SQL_AFTER_GTIDS = 779,
+  SQL_AFTER_MTS_GAPS = 780,
+  SQL_BEFORE_GTIDS = 781,
+  SQL_BIG_RESULT = 782,
+  SQL_BUFFER_RESULT = 783,
+  OBSOLETE_TOKEN_784 = 784,
+  SQL_CALC_FOUND_ROWS = 785,
+  SQL_NO_CACHE_SYM = 786,
+  SQL_SMALL_RESULT = 787,
+  SQL_SYM = 788,
+  SQL_THREAD = 789,
+  SSL_SYM = 790,
+  STACKED_SYM = 791,
+  STARTING = 792,
+  STARTS_SYM = 793,
+  START_SYM = 794,
+  STATS_AUTO_RECALC_SYM = 795,
+  STATS_PERSISTENT_SYM = 796,
+  STATS_SAMPLE_PAGES_SYM = 797,
+  STATUS_SYM = 798,
+  STDDEV_SAMP_SYM = 799,
+  STD_SYM = 800,
+  STOP_SYM = 801,
+  STORAGE_SYM = 802,
+  STORED_SYM = 803,
+  STRAIGHT_JOIN = 804,
+  STRING_SYM = 805,
+  SUBCLASS_ORIGIN_SYM = 806,
+  SUBDATE_SYM = 807,
+  SUBJECT_SYM = 808,
+  SUBPARTITIONS_SYM = 809,
+  SUBPARTITION_SYM = 810,
+  SUBSTRING = 811,
+  SUM_SYM = 812,
+  SUPER_SYM = 813,
+  SUSPEND_SYM = 814,
+  SWAPS_SYM = 815,
+  SWITCHES_SYM = 816,
+  SYSDATE = 817,
+  TABLES = 818,
+  TABLESPACE_SYM = 819,
+  OBSOLETE_TOKEN_820 = 820,
+  TABLE_SYM = 821,
+  TABLE_CHECKSUM_SYM = 822,
+  TABLE_NAME_SYM = 823,
+  TEMPORARY = 824,
+  TEMPTABLE_SYM = 825,
+  TERMINATED = 826,
+  TEXT_STRING = 827,
+  TEXT_SYM = 828,
+  THAN_SYM = 829,
+  THEN_SYM = 830,
+  TIMESTAMP_SYM = 831,
+  TIMESTAMP_ADD = 832,
+  TIMESTAMP_DIFF = 833,
+  TIME_SYM = 834,
+  TINYBLOB_SYM = 835,
+  TINYINT_SYM = 836,
+  TINYTEXT_SYN = 837,
+  TO_SYM = 838,
+  TRAILING = 839,
+  TRANSACTION_SYM = 840,
+  TRIGGERS_SYM = 841,
+  TRIGGER_SYM = 842,
+  TRIM = 843,
+  TRUE_SYM = 844,
+  TRUNCATE_SYM = 845,
+  TYPES_SYM = 846,
+  TYPE_SYM = 847,
+  OBSOLETE_TOKEN_848 = 848,
+  ULONGLONG_NUM = 849,
+  UNCOMMITTED_SYM = 850,
+  UNDEFINED_SYM = 851,
+  UNDERSCORE_CHARSET = 852,
+  UNDOFILE_SYM = 853,
+  UNDO_BUFFER_SIZE_SYM = 854,
+  UNDO_SYM = 855,
+  UNICODE_SYM = 856,
+  UNINSTALL_SYM = 857,
+  UNION_SYM = 858,
+  UNIQUE_SYM = 859,
+  UNKNOWN_SYM = 860,
+  UNLOCK_SYM = 861,
+  UNSIGNED_SYM = 862,
+  UNTIL_SYM = 863,
+  UPDATE_SYM = 864,
+  UPGRADE_SYM = 865,
+  USAGE = 866,
+  USER = 867,
+  USE_FRM = 868,
+  USE_SYM = 869,
+  USING = 870,
+  UTC_DATE_SYM = 871,
+  UTC_TIMESTAMP_SYM = 872,
+  UTC_TIME_SYM = 873,
+  VALIDATION_SYM = 874,
+  VALUES = 875,
+  VALUE_SYM = 876,
+  VARBINARY_SYM = 877,
+  VARCHAR_SYM = 878,
+  VARIABLES = 879,
+  VARIANCE_SYM = 880,
+  VARYING = 881,
+  VAR_SAMP_SYM = 882,
+  VIEW_SYM = 883,
+  VIRTUAL_SYM = 884,
+  WAIT_SYM = 885,
+  WARNINGS = 886,
+  WEEK_SYM = 887,
+  WEIGHT_STRING_SYM = 888,
+  WHEN_SYM = 889,
+  WHERE = 890,
+  WHILE_SYM = 891,
+  WITH = 892,
+  OBSOLETE_TOKEN_893 = 893,
+  WITH_ROLLUP_SYM = 894,
+  WITHOUT_SYM = 895,
+  WORK_SYM = 896,
+  WRAPPER_SYM = 897,
+  WRITE_SYM = 898,
+  X509_SYM = 899,
+  XA_SYM = 900,
+  XID_SYM = 901,
+  XML_SYM = 902,
+  XOR = 903,
+  YEAR_MONTH_SYM = 904,
+  YEAR_SYM = 905,
+  ZEROFILL_SYM = 906,
+  JSON_UNQUOTED_SEPARATOR_SYM = 907,
+  PERSIST_SYM = 908,
+  ROLE_SYM = 909,
+  ADMIN_SYM = 910,
+  INVISIBLE_SYM = 911,
+  VISIBLE_SYM = 912,
+  EXCEPT_SYM = 913,
+  COMPONENT_SYM = 914,
+  RECURSIVE_SYM = 915,
+  GRAMMAR_SELECTOR_EXPR = 916,
+  GRAMMAR_SELECTOR_GCOL = 917,
+  GRAMMAR_SELECTOR_PART = 918,
+  GRAMMAR_SELECTOR_CTE = 919,
+  JSON_OBJECTAGG = 920,
+  JSON_ARRAYAGG = 921,
+  OF_SYM = 922,
+  SKIP_SYM = 923,
+  LOCKED_SYM = 924,
+  NOWAIT_SYM = 925,
+  GROUPING_SYM = 926,
+  PERSIST_ONLY_SYM = 927,
+  HISTOGRAM_SYM = 928,
+  BUCKETS_SYM = 929,
+  OBSOLETE_TOKEN_930 = 930,
+  CLONE_SYM = 931,
+  CUME_DIST_SYM = 932,
+  DENSE_RANK_SYM = 933,
+  EXCLUDE_SYM = 934,
+  FIRST_VALUE_SYM = 935,
+  FOLLOWING_SYM = 936,
+  GROUPS_SYM = 937,
+  LAG_SYM = 938,
+  LAST_VALUE_SYM = 939,
+  LEAD_SYM = 940,
+  NTH_VALUE_SYM = 941,
+  NTILE_SYM = 942,
+  NULLS_SYM = 943,
+  OTHERS_SYM = 944,
+  OVER_SYM = 945,
+  PERCENT_RANK_SYM = 946,
+  PRECEDING_SYM = 947,
+  RANK_SYM = 948,
+  RESPECT_SYM = 949,
+  ROW_NUMBER_SYM = 950,
+  TIES_SYM = 951,
+  UNBOUNDED_SYM = 952,
+  WINDOW_SYM = 953,
+  EMPTY_SYM = 954,
+  JSON_TABLE_SYM = 955,
+  NESTED_SYM = 956,
+  ORDINALITY_SYM = 957,
+  PATH_SYM = 958,
+  HISTORY_SYM = 959,
+  REUSE_SYM = 960,
+  SRID_SYM = 961,
+  THREAD_PRIORITY_SYM = 962,
+  RESOURCE_SYM = 963,
+  SYSTEM_SYM = 964,
+  VCPU_SYM = 965,
+  OBSOLETE_TOKEN_966 = 966,
+  OBSOLETE_TOKEN_967 = 967,
+  RESTART_SYM = 968,
+  DEFINITION_SYM = 969,
+  DESCRIPTION_SYM = 970,
+  ORGANIZATION_SYM = 971,
+  REFERENCE_SYM = 972,
+  ACTIVE_SYM = 973,
+  INACTIVE_SYM = 974,
+  LATERAL_SYM = 975,
+  ARRAY_SYM = 976,
+  MEMBER_SYM = 977,
+  OPTIONAL_SYM = 978,
+  SECONDARY_SYM = 979,
+  SECONDARY_ENGINE_SYM = 980,
+  SECONDARY_LOAD_SYM = 981,
+  SECONDARY_UNLOAD_SYM = 982,
+  RETAIN_SYM = 983,
+  OLD_SYM = 984,
+  ENFORCED_SYM = 985,
+  OJ_SYM = 986,
+  NETWORK_NAMESPACE_SYM = 987,
+  RANDOM_SYM = 988,
+  OBSOLETE_TOKEN_989 = 989,
+  OBSOLETE_TOKEN_990 = 990,
+  PRIVILEGE_CHECKS_USER_SYM = 991,
+  OBSOLETE_TOKEN_992 = 992,
+  REQUIRE_ROW_FORMAT_SYM = 993,
+  PASSWORD_LOCK_TIME_SYM = 994,
+  FAILED_LOGIN_ATTEMPTS_SYM = 995,
+  REQUIRE_TABLE_PRIMARY_KEY_CHECK_SYM = 996,
+  STREAM_SYM = 997,
+  OFF_SYM = 998,
+  RETURNING_SYM = 999,
+  MAX_EXECUTION_TIME_HINT = 1000,
+  RESOURCE_GROUP_HINT = 1001,
+  BKA_HINT = 1002,
+  BNL_HINT = 1003,
+  DUPSWEEDOUT_HINT = 1004,
+  FIRSTMATCH_HINT = 1005,
+  INTOEXISTS_HINT = 1006,
+  LOOSESCAN_HINT = 1007,
+  MATERIALIZATION_HINT = 1008,
+  NO_BKA_HINT = 1009,
+  NO_BNL_HINT = 1010,
+  NO_ICP_HINT = 1011,
+  NO_MRR_HINT = 1012,
+  NO_RANGE_OPTIMIZATION_HINT = 1013,
+  NO_SEMIJOIN_HINT = 1014,
+  MRR_HINT = 1015,
+  QB_NAME_HINT = 1016,
+  SEMIJOIN_HINT = 1017,
+  SUBQUERY_HINT = 1018,
+  DERIVED_MERGE_HINT = 1019,
+  NO_DERIVED_MERGE_HINT = 1020,
+  JOIN_PREFIX_HINT = 1021,
+  JOIN_SUFFIX_HINT = 1022,
+  JOIN_ORDER_HINT = 1023,
+  JOIN_FIXED_ORDER_HINT = 1024,
+  INDEX_MERGE_HINT = 1025,
+  NO_INDEX_MERGE_HINT = 1026,
+  SET_VAR_HINT = 1027,
+  SKIP_SCAN_HINT = 1028,
+  NO_SKIP_SCAN_HINT = 1029,
+  HASH_JOIN_HINT = 1030,
+  NO_HASH_JOIN_HINT = 1031,
+  HINT_ARG_NUMBER = 1032,
+  HINT_ARG_IDENT = 1033,
+  HINT_ARG_QB_NAME = 1034,
+  HINT_ARG_TEXT = 1035,
+  HINT_IDENT_OR_NUMBER_WITH_SCALE = 1036,
+  HINT_CLOSE = 1037,
+  HINT_ERROR = 1038,
+  INDEX_HINT = 1039,
+  NO_INDEX_HINT = 1040,
+  JOIN_INDEX_HINT = 1041,
+  NO_JOIN_INDEX_HINT = 1042,
+  GROUP_INDEX_HINT = 1043,
+  NO_GROUP_INDEX_HINT = 1044,
+  ORDER_INDEX_HINT = 1045,
+  NO_ORDER_INDEX_HINT = 1046,
+  DERIVED_CONDITION_PUSHDOWN_HINT = 1047,
+  NO_DERIVED_CONDITION_PUSHDOWN_HINT = 1048,
+  HINT_ARG_FLOATING_POINT_NUMBER = 1049,
+  MY_SQL_PARSER_UNDEF = 1150,
+  YYUNDEF = 1150,
+  JSON_VALUE_SYM = 1151,
+  TLS_SYM = 1152,
+  ATTRIBUTE_SYM = 1153,
+  ENGINE_ATTRIBUTE_SYM = 1154,
+  SECONDARY_ENGINE_ATTRIBUTE_SYM = 1155,
+  SOURCE_CONNECTION_AUTO_FAILOVER_SYM = 1156,
+  ZONE_SYM = 1157,
+  GRAMMAR_SELECTOR_DERIVED_EXPR = 1158,
+  REPLICA_SYM = 1159,
+  REPLICAS_SYM = 1160,
+  ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_SYM = 1161,
+  GET_SOURCE_PUBLIC_KEY_SYM = 1162,
+  SOURCE_AUTO_POSITION_SYM = 1163,
+  SOURCE_BIND_SYM = 1164,
+  SOURCE_COMPRESSION_ALGORITHM_SYM = 1165,
+  SOURCE_CONNECT_RETRY_SYM = 1166,
+  SOURCE_DELAY_SYM = 1167,
+  SOURCE_HEARTBEAT_PERIOD_SYM = 1168,
+  SOURCE_HOST_SYM = 1169,
+  SOURCE_LOG_FILE_SYM = 1170,
+  SOURCE_LOG_POS_SYM = 1171,
+  SOURCE_PASSWORD_SYM = 1172,
+  SOURCE_PORT_SYM = 1173,
+  SOURCE_PUBLIC_KEY_PATH_SYM = 1174,
+  SOURCE_RETRY_COUNT_SYM = 1175,
+  SOURCE_SSL_SYM = 1176,
+  SOURCE_SSL_CA_SYM = 1177,
+  SOURCE_SSL_CAPATH_SYM = 1178,
+  SOURCE_SSL_CERT_SYM = 1179,
+  SOURCE_SSL_CIPHER_SYM = 1180,
+  SOURCE_SSL_CRL_SYM = 1181,
+  SOURCE_SSL_CRLPATH_SYM = 1182,
+  SOURCE_SSL_KEY_SYM = 1183,
+  SOURCE_SSL_VERIFY_SERVER_CERT_SYM = 1184,
+  SOURCE_TLS_CIPHERSUITES_SYM = 1185,
+  SOURCE_TLS_VERSION_SYM = 1186,
+  SOURCE_USER_SYM = 1187,
+  SOURCE_ZSTD_COMPRESSION_LEVEL_SYM = 1188,
+  ST_COLLECT_SYM = 1189,
+  KEYRING_SYM = 1190,
+  AUTHENTICATION_SYM = 1191,
+  FACTOR_SYM = 1192,
+  FINISH_SYM = 1193,
+  INITIATE_SYM = 1194,
+  REGISTRATION_SYM = 1195,
+  UNREGISTER_SYM = 1196,
+  INITIAL_SYM = 1197,
+  CHALLENGE_RESPONSE_SYM = 1198,
+  GTID_ONLY_SYM = 1199,
+  INTERSECT_SYM = 1200,
+  BULK_SYM = 1201,
+  URL_SYM = 1202,
+  GENERATE_SYM = 1203,
+  DOLLAR_QUOTED_STRING_SYM = 1204,
+  PARSE_TREE_SYM = 1205,
+  LOG_SYM = 1206,
+  GTIDS_SYM = 1207,
+  PARALLEL_SYM = 1208,
+  S3_SYM = 1209,
+  QUALIFY_SYM = 1210,
+  AUTO_SYM = 1211,
+  MANUAL_SYM = 1212,
+  BERNOULLI_SYM = 1213,
+  TABLESAMPLE_SYM = 1214,
+  VECTOR_SYM = 1215,
+  PARAMETERS_SYM = 1216,
+  HEADER_SYM = 1217,
+  LIBRARY_SYM = 1218,
+  URI_SYM = 1219,
+  DUALITY_SYM = 1220,
+  RELATIONAL_SYM = 1221,
+  JSON_DUALITY_OBJECT_SYM = 1222,
+  ABSENT_SYM = 1223,
+  FILE_FORMAT_SYM = 1224,
+  FILES_SYM = 1225,
+  FILE_NAME_SYM = 1226,
+  FILE_PATTERN_SYM = 1227,
+  FILE_PREFIX_SYM = 1228,
+  ALLOW_MISSING_FILES_SYM = 1229,
+  AUTO_REFRESH_SYM = 1230,
+  AUTO_REFRESH_SOURCE_SYM = 1231,
+  VERIFY_KEY_CONSTRAINTS_SYM = 1232,
+  STRICT_LOAD_SYM = 1233,
+  EXTERNAL_FORMAT_SYM = 1234,
+  EXTERNAL_SYM = 1235,
+  MATERIALIZED_SYM = 1236,
+  GUIDED_SYM = 1237,
+  SETS_SYM = 1238,
+  VALIDATE_SYM = 1239,
+  KEYWORD_USED_AS_IDENT = 1240,
+  KEYWORD_USED_AS_KEYWORD = 1241,
+  CONDITIONLESS_JOIN = 1242,
+  PREFER_PARENTHESES = 1243,
+  EMPTY_FROM_CLAUSE = 1244,
+};
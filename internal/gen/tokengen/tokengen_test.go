@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files")
+
+func generateFixtures(t *testing.T) map[string][]byte {
+	t.Helper()
+	files, err := generate("testdata/mysql80", "", "testdata/mysql57", "", "obsolete57.txt")
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	return files
+}
+
+func TestGenerate_Golden(t *testing.T) {
+	files := generateFixtures(t)
+
+	for _, name := range []string{"tokens.go", "keywords.go", "token_names.go", "tokens57.go"} {
+		t.Run(name, func(t *testing.T) {
+			got, ok := files[name]
+			if !ok {
+				t.Fatalf("%s was not generated", name)
+			}
+			golden := filepath.Join("testdata", "golden", name+".golden")
+			if *update {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s differs from %s; run go test -update to refresh\n%s", name, golden, got)
+			}
+		})
+	}
+}
+
+func TestGenerate_Deterministic(t *testing.T) {
+	first := generateFixtures(t)
+	for i := 0; i < 5; i++ {
+		again := generateFixtures(t)
+		for name, want := range first {
+			if !bytes.Equal(again[name], want) {
+				t.Fatalf("run %d: %s output changed between runs", i, name)
+			}
+		}
+	}
+}
+
+func TestParseYaccHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want map[string]int
+		skip []string
+	}{
+		{
+			name: "bison 3 enum",
+			path: "testdata/mysql80/sql/sql_yacc.h",
+			want: map[string]int{"ABORT_SYM": 258, "MY_SQL_PARSER_EOF": 0, "YYUNDEF": 1150},
+			skip: []string{"MY_SQL_PARSER_EMPTY", "MY_SQL_PARSER_error", "MY_SQL_PARSER_DEBUG"},
+		},
+		{
+			name: "bison 2 defines",
+			path: "testdata/mysql57/sql/sql_yacc.h",
+			want: map[string]int{"ABORT_SYM": 258, "ZEROFILL": 907},
+			skip: []string{"YYDEBUG", "YYSTYPE_IS_DECLARED"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toks, err := parseYaccHeader(tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make(map[string]int, len(toks))
+			for _, tok := range toks {
+				got[tok.Name] = tok.Value
+			}
+			for name, want := range tt.want {
+				if v, ok := got[name]; !ok || v != want {
+					t.Errorf("%s = %d (present %v), want %d", name, v, ok, want)
+				}
+			}
+			for _, name := range tt.skip {
+				if _, ok := got[name]; ok {
+					t.Errorf("%s should not be treated as a token", name)
+				}
+			}
+		})
+	}
+}
+
+func TestParseLexH_Groups(t *testing.T) {
+	syms, err := parseLexH("testdata/mysql80/sql/lex.h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups := make(map[string]symbolGroup)
+	for _, s := range syms {
+		groups[s.Text] = s.Group
+	}
+	tests := []struct {
+		text string
+		want symbolGroup
+	}{
+		{"SELECT", groupKeyword},
+		{"ADDDATE", groupKeyword},
+		{"&&", groupKeyword},
+		{"BKA", groupHint},
+	}
+	for _, tt := range tests {
+		if got, ok := groups[tt.text]; !ok || got != tt.want {
+			t.Errorf("group of %q = %v (present %v), want %v", tt.text, got, ok, tt.want)
+		}
+	}
+}
+
+// TestGenerate_Committed checks that the tables committed in internal/
+// are what tokengen makes of the source entries in testdata/tables, so
+// that regenerating them from a server tree only brings in what changed
+// in the server.
+func TestGenerate_Committed(t *testing.T) {
+	files, err := generate("testdata/tables/mysql80", "", "testdata/tables/mysql57", "", "obsolete57.txt")
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	for _, name := range []string{"tokens.go", "keywords.go", "token_names.go", "tokens57.go"} {
+		want, err := os.ReadFile(filepath.Join("..", "..", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(files[name], want) {
+			t.Errorf("internal/%s is not what tokengen generates from testdata/tables", name)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package internal
+
+// The keyword and token tables are generated from MySQL source trees. Point
+// the environment variables at checked out (and configured) sources to
+// refresh them after a server release; without them the committed tables
+// are kept. gen/tokengen's tests check those against testdata/tables.
+//go:generate go run ./gen/tokengen -optional -src=$MYSQL_SRC -build=$MYSQL_BUILD -src57=$MYSQL57_SRC -build57=$MYSQL57_BUILD -out=.
+
+// The perfect hash keyword tables are built from the keyword maps above and
+// the per-version sets in token_config.go.
+//go:generate go run ./gen/kwtable -tokens=tokens.go -out=keyword_tables.go
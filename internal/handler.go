@@ -1,9 +1,27 @@
 package internal
 
+import (
+	"context"
+	"hash"
+	"strings"
+)
+
+// ctxCheckEvery is how many tokens are handled between two checks of the
+// handler's context.
+const ctxCheckEvery = 256
+
 type tokenHandler struct {
 	lexer   *Lexer
 	store   *tokenStore
 	reducer *reducer
+	replay  []Token // recorded tokens to read instead of lexing
+	lenient bool    // end the digest with ABORT_SYM on a lex error
+
+	ctx       context.Context // checked while handling tokens, if not nil
+	maxTokens int             // 0 means no limit
+
+	literals LiteralMode
+	mac      hash.Hash // keyed HMAC for LiteralHMAC
 }
 
 // NewTokenHandler creates a new token handler.
@@ -16,14 +34,35 @@ func NewTokenHandler(lexer *Lexer, store *tokenStore, reducer *reducer) *tokenHa
 }
 
 func (h *tokenHandler) ProcessAll() error {
-	for {
-		tok := h.lexer.Lex()
+	for n := 0; ; n++ {
+		if h.ctx != nil && n%ctxCheckEvery == 0 {
+			if err := h.ctx.Err(); err != nil {
+				return err
+			}
+		}
+
+		tok := h.next()
+		h.store.inVersionComment = h.replay == nil && h.lexer.inVersionComment
+		if h.store.trace != nil {
+			h.store.trace.lex(h.lexer, tok)
+		}
 
 		if tok.Type == END_OF_INPUT {
-			h.store.removeTrailingSemicolon()
+			// A digest text has already lost its trailing ';'.
+			if !h.lexer.digestText {
+				h.store.removeTrailingSemicolon()
+			}
 			return nil
 		}
+		if h.maxTokens > 0 && n >= h.maxTokens {
+			return &LimitError{Err: ErrTooManyTokens, Limit: h.maxTokens}
+		}
 		if tok.Type == ABORT_SYM {
+			// The server's lexer hands ABORT_SYM to the digest like any
+			// other token before the parser gives up.
+			if h.lenient {
+				h.store.push(ABORT_SYM)
+			}
 			return tok.Err
 		}
 
@@ -33,16 +72,27 @@ func (h *tokenHandler) ProcessAll() error {
 	}
 }
 
+func (h *tokenHandler) next() Token {
+	if h.replay == nil {
+		return h.lexer.Lex()
+	}
+	tok := h.replay[0]
+	if len(h.replay) > 1 {
+		h.replay = h.replay[1:]
+	}
+	return tok
+}
+
 func (h *tokenHandler) handleToken(tok Token) error {
 	switch {
 	case isNumericLiteral(tok.Type):
-		h.handleNumericLiteral()
+		h.handleNumericLiteral(tok)
 
 	case isStringLiteral(tok.Type):
-		h.handleLiteral()
+		h.handleLiteral(tok)
 
 	case tok.Type == NULL_SYM:
-		h.handleNull()
+		h.handleNull(tok)
 
 	case tok.Type == ')':
 		h.handleCloseParen()
@@ -58,23 +108,23 @@ func (h *tokenHandler) handleToken(tok Token) error {
 }
 
 // Absorbs any preceding unary +/- signs before normalizing.
-func (h *tokenHandler) handleNumericLiteral() {
-	h.reducer.reduceUnarySign()
-	h.store.push(TOK_GENERIC_VALUE)
+func (h *tokenHandler) handleNumericLiteral(tok Token) {
+	sign := h.reducer.reduceUnarySign()
+	h.store.pushLiteral(TOK_GENERIC_VALUE, h.literalText(tok, sign))
 	h.reducer.reduceAfterValue()
 }
 
-func (h *tokenHandler) handleLiteral() {
-	h.store.push(TOK_GENERIC_VALUE)
+func (h *tokenHandler) handleLiteral(tok Token) {
+	h.store.pushLiteral(TOK_GENERIC_VALUE, h.literalText(tok, ""))
 	h.reducer.reduceAfterValue()
 }
 
 // NULL is kept as a keyword after IS/IS NOT, otherwise normalized to a value.
-func (h *tokenHandler) handleNull() {
+func (h *tokenHandler) handleNull(tok Token) {
 	if h.isNullKeywordContext() {
 		h.store.push(NULL_SYM)
 	} else {
-		h.store.push(TOK_GENERIC_VALUE)
+		h.store.pushLiteral(TOK_GENERIC_VALUE, h.literalText(tok, ""))
 		h.reducer.reduceAfterValue()
 	}
 }
@@ -92,6 +142,9 @@ func (h *tokenHandler) handleIdentifier(tok Token) error {
 	if tok.Type == IDENT_QUOTED {
 		text = stripIdentifierQuotes(text)
 	}
+	if h.lexer.Streaming() {
+		text = strings.Clone(text) // the window is reused
+	}
 	h.store.pushIdent(text)
 	return nil
 }
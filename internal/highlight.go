@@ -0,0 +1,161 @@
+package internal
+
+import "strings"
+
+// TokenClass is what a highlighter colors a piece of a statement as.
+type TokenClass int
+
+const (
+	ClassPlain       TokenClass = iota // whitespace, and text after an error
+	ClassKeyword                       // SELECT, NULL, COUNT, ...
+	ClassIdentifier                    // names, quoted or not, and variables
+	ClassPlaceholder                   // ? and the value tokens of a digest text
+	ClassLiteral                       // strings and numbers
+	ClassOperator                      // punctuation and operators
+	ClassHint                          // an optimizer hint, /*+ to */
+	ClassComment                       // comments, and the markers of versioned ones
+)
+
+var classNames = [...]string{
+	ClassPlain:       "plain",
+	ClassKeyword:     "keyword",
+	ClassIdentifier:  "identifier",
+	ClassPlaceholder: "placeholder",
+	ClassLiteral:     "literal",
+	ClassOperator:    "operator",
+	ClassHint:        "hint",
+	ClassComment:     "comment",
+}
+
+func (c TokenClass) String() string {
+	if c >= 0 && int(c) < len(classNames) {
+		return classNames[c]
+	}
+	return "unknown"
+}
+
+// Span is a piece of the input, Start to End, of one class.
+type Span struct {
+	Start, End int
+	Class      TokenClass
+}
+
+// Highlight splits the input of l into spans that cover all of it, in
+// order: a span per token, and spans of comments and blanks between them.
+// An optimizer hint is one span. Everything from a token l cannot read on
+// is one plain span.
+func Highlight(l *Lexer) []Span {
+	var spans []Span
+	prev, inHint := 0, false
+	for {
+		tok := l.Lex()
+		if tok.Type == END_OF_INPUT || tok.Type == ABORT_SYM {
+			spans = appendGap(spans, l.input, prev, tok.Start, inHint)
+			if tok.Start < len(l.input) {
+				spans = append(spans, Span{Start: tok.Start, End: len(l.input), Class: ClassPlain})
+			}
+			return spans
+		}
+		spans = appendGap(spans, l.input, prev, tok.Start, inHint)
+
+		class := tokenClass(tok.Type)
+		switch tok.Type {
+		case TOK_HINT_COMMENT_OPEN:
+			inHint = true
+		case TOK_HINT_COMMENT_CLOSE:
+			inHint = false
+			class = ClassHint
+		}
+		if inHint {
+			class = ClassHint
+		}
+		spans = appendSpan(spans, Span{Start: tok.Start, End: tok.End, Class: class})
+		prev = tok.End
+	}
+}
+
+// appendGap appends the spans of the text between two tokens: comments,
+// and the blanks around them. Inside a hint it is all hint.
+func appendGap(spans []Span, input string, start, end int, inHint bool) []Span {
+	if start >= end {
+		return spans
+	}
+	if inHint {
+		return appendSpan(spans, Span{Start: start, End: end, Class: ClassHint})
+	}
+	plain := start
+	for i := start; i < end; {
+		rest := input[i:end]
+		n := 0
+		switch {
+		case strings.HasPrefix(rest, "/*"):
+			// A whole comment, a versioned one the lexer skipped, or the
+			// opening of one in effect.
+			n = len(rest)
+			if j := strings.Index(rest[2:], "*/"); j >= 0 {
+				n = j + 4
+			}
+		case strings.HasPrefix(rest, "*/"):
+			n = 2 // the end of a versioned comment in effect
+		case rest[0] == '#', strings.HasPrefix(rest, "--"):
+			n = lineCommentLen(rest)
+		default:
+			i++
+			continue
+		}
+		if plain < i {
+			spans = append(spans, Span{Start: plain, End: i, Class: ClassPlain})
+		}
+		spans = append(spans, Span{Start: i, End: i + n, Class: ClassComment})
+		i += n
+		plain = i
+	}
+	if plain < end {
+		spans = append(spans, Span{Start: plain, End: end, Class: ClassPlain})
+	}
+	return spans
+}
+
+// appendSpan appends s, or extends the last span with it when both are
+// parts of a hint.
+func appendSpan(spans []Span, s Span) []Span {
+	if n := len(spans); n > 0 && s.Class == ClassHint && spans[n-1].Class == ClassHint && spans[n-1].End == s.Start {
+		spans[n-1].End = s.End
+		return spans
+	}
+	return append(spans, s)
+}
+
+func tokenClass(tok int) TokenClass {
+	switch {
+	case tok == PARAM_MARKER, tok == '?', isValueToken(tok):
+		return ClassPlaceholder
+	case isNumericLiteral(tok), isRedactedString(tok):
+		return ClassLiteral
+	case tok == IDENT, tok == IDENT_QUOTED, tok == LEX_HOSTNAME,
+		tok == TOK_IDENT, tok == TOK_IDENT_AT:
+		return ClassIdentifier
+	case tok == UNDERSCORE_CHARSET:
+		return ClassKeyword
+	case tok < 256:
+		return ClassOperator
+	}
+	s := TokenString(tok)
+	if s != "" && (s[0] == '_' || s[0] >= 'A' && s[0] <= 'Z') {
+		return ClassKeyword
+	}
+	return ClassOperator
+}
+
+// isValueToken reports whether tok is one of the value tokens a digest
+// text writes for literals.
+func isValueToken(tok int) bool {
+	switch tok {
+	case TOK_GENERIC_VALUE, TOK_GENERIC_VALUE_LIST,
+		TOK_ROW_SINGLE_VALUE, TOK_ROW_SINGLE_VALUE_LIST,
+		TOK_ROW_MULTIPLE_VALUE, TOK_ROW_MULTIPLE_VALUE_LIST,
+		TOK_IN_GENERIC_VALUE_EXPRESSION:
+		return true
+	}
+	return false
+}
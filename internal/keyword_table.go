@@ -0,0 +1,172 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+)
+
+// KeywordTable is a minimal perfect hash over upper-case keywords. Lookups
+// fold case while hashing, so they need neither an upper-cased copy of the
+// word nor a map. The tables used by the lexer are generated by
+// gen/kwtable into keyword_tables.go.
+type KeywordTable struct {
+	Seed     uint32
+	Displace []uint32 // per bucket seed for the second level
+	Entries  []KeywordEntry
+	MaxLen   int
+}
+
+// KeywordEntry is one slot of a KeywordTable.
+type KeywordEntry struct {
+	Word  string
+	Token int
+}
+
+// Lookup returns the token for word, in any case, or 0.
+func (t *KeywordTable) Lookup(word string) int {
+	if len(word) > t.MaxLen || len(t.Entries) == 0 {
+		return 0
+	}
+	h := foldHash(t.Seed, word)
+	d := t.Displace[h%uint32(len(t.Displace))]
+	e := &t.Entries[mixHash(h^d)%uint32(len(t.Entries))]
+	if !equalFold(e.Word, word) {
+		return 0
+	}
+	return e.Token
+}
+
+// foldHash is FNV-1a over the upper-cased bytes of s.
+func foldHash(seed uint32, s string) uint32 {
+	h := seed ^ 2166136261
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'a' && c <= 'z' {
+			c -= 32
+		}
+		h ^= uint32(c)
+		h *= 16777619
+	}
+	return h
+}
+
+// mixHash is a 32-bit finalizer that spreads a displaced hash over the slots.
+func mixHash(x uint32) uint32 {
+	x ^= x >> 16
+	x *= 0x7feb352d
+	x ^= x >> 15
+	x *= 0x846ca68b
+	x ^= x >> 16
+	return x
+}
+
+// equalFold reports whether s equals the upper-case keyword upper, ignoring
+// ASCII case in s.
+func equalFold(upper, s string) bool {
+	if len(upper) != len(s) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'a' && c <= 'z' {
+			c -= 32
+		}
+		if c != upper[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// NewKeywordTable builds a table holding words, which must be upper case.
+// It is used by the generator and by tests; the lexer only reads tables
+// generated ahead of time.
+func NewKeywordTable(words map[string]int) (*KeywordTable, error) {
+	keys := make([]string, 0, len(words))
+	maxLen := 0
+	for w := range words {
+		keys = append(keys, w)
+		maxLen = max(maxLen, len(w))
+	}
+	sort.Strings(keys)
+
+	for seed := uint32(0); seed < 1000; seed++ {
+		if t, ok := buildKeywordTable(seed, keys, words); ok {
+			t.MaxLen = maxLen
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("no perfect hash found for %d keywords", len(keys))
+}
+
+func buildKeywordTable(seed uint32, keys []string, words map[string]int) (*KeywordTable, bool) {
+	n := len(keys)
+	if n == 0 {
+		return &KeywordTable{Seed: seed, Displace: []uint32{0}}, true
+	}
+
+	hashes := make(map[uint32]bool, n)
+	nb := (n + 3) / 4
+	buckets := make([][]string, nb)
+	for _, k := range keys {
+		h := foldHash(seed, k)
+		if hashes[h] {
+			return nil, false // two keywords share a first level hash
+		}
+		hashes[h] = true
+		buckets[h%uint32(nb)] = append(buckets[h%uint32(nb)], k)
+	}
+
+	order := make([]int, nb)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(buckets[order[i]]) > len(buckets[order[j]])
+	})
+
+	t := &KeywordTable{
+		Seed:     seed,
+		Displace: make([]uint32, nb),
+		Entries:  make([]KeywordEntry, n),
+	}
+	used := make([]bool, n)
+	for _, b := range order {
+		if len(buckets[b]) == 0 {
+			continue
+		}
+		found := false
+		for d := uint32(1); d < 1<<20 && !found; d++ {
+			slots := make([]uint32, 0, len(buckets[b]))
+			found = true
+			for _, k := range buckets[b] {
+				s := mixHash(foldHash(seed, k)^d) % uint32(n)
+				if used[s] || containsSlot(slots, s) {
+					found = false
+					break
+				}
+				slots = append(slots, s)
+			}
+			if found {
+				t.Displace[b] = d
+				for i, k := range buckets[b] {
+					used[slots[i]] = true
+					t.Entries[slots[i]] = KeywordEntry{Word: k, Token: words[k]}
+				}
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	return t, true
+}
+
+func containsSlot(slots []uint32, s uint32) bool {
+	for _, x := range slots {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
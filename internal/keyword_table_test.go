@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+)
+
+var keywordVersions = []MySQLVersion{MySQL80, MySQL84, MySQL90, MySQL57}
+
+func TestKeywordTables_MatchKeywordMaps(t *testing.T) {
+	for _, v := range keywordVersions {
+		words := KeywordsFor(v)
+		table := GetTokenConfig(v).Keywords
+		if len(table.Entries) != len(words) {
+			t.Errorf("%v: table has %d keywords, want %d; run go generate", v, len(table.Entries), len(words))
+		}
+		for word, tok := range words {
+			if got := table.Lookup(word); got != tok {
+				t.Errorf("%v: Lookup(%q) = %d, want %d", v, word, got, tok)
+			}
+			if got := table.Lookup(strings.ToLower(word)); got != tok {
+				t.Errorf("%v: Lookup(%q) = %d, want %d", v, strings.ToLower(word), got, tok)
+			}
+		}
+	}
+
+	for word, tok := range HintKeywords() {
+		if got := hintKeywordTable.Lookup(word); got != tok {
+			t.Errorf("hint Lookup(%q) = %d, want %d", word, got, tok)
+		}
+	}
+	for word := range VersionedKeywords() {
+		if versionedKeywordTable.Lookup(word) == 0 {
+			t.Errorf("%q is missing from versionedKeywordTable", word)
+		}
+	}
+}
+
+func TestKeywordTable_NotKeywords(t *testing.T) {
+	table := GetTokenConfig(MySQL80).Keywords
+	for _, word := range []string{"", "users", "SELECTX", "SELEC", "sel ect", "ÄÖÜ", strings.Repeat("A", 200)} {
+		if got := table.Lookup(word); got != 0 {
+			t.Errorf("Lookup(%q) = %d, want 0", word, got)
+		}
+	}
+	if got := GetTokenConfig(MySQL80).LookupKeyword("QUALIFY"); got != 0 {
+		t.Errorf("8.0 knows QUALIFY as %d", got)
+	}
+	if got := GetTokenConfig(MySQL84).LookupKeyword("qualify"); got == 0 {
+		t.Error("8.4 does not know QUALIFY")
+	}
+}
+
+func TestNewKeywordTable(t *testing.T) {
+	words := map[string]int{"A": 1, "BB": 2, "CCC": 3, "<=>": 4}
+	table, err := NewKeywordTable(words)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for word, tok := range words {
+		if got := table.Lookup(word); got != tok {
+			t.Errorf("Lookup(%q) = %d, want %d", word, got, tok)
+		}
+	}
+
+	empty, err := NewKeywordTable(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := empty.Lookup("A"); got != 0 {
+		t.Errorf("empty Lookup = %d", got)
+	}
+}
+
+var benchWords = []string{"select", "users", "FROM", "id", "Where", "order_id", "LIMIT", "created_at", "AND", "status"}
+
+func BenchmarkLookupKeyword_Table(b *testing.B) {
+	c := GetTokenConfig(MySQL80)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.LookupKeyword(benchWords[i%len(benchWords)])
+	}
+}
+
+// BenchmarkLookupKeyword_Map is the map lookup on an upper-cased copy the
+// tables replaced, for comparison.
+func BenchmarkLookupKeyword_Map(b *testing.B) {
+	m := KeywordsFor(MySQL80)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = m[strings.ToUpper(benchWords[i%len(benchWords)])]
+	}
+}
+
+func BenchmarkTokenConfigInit(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buildMySQL80Config()
+		buildMySQL84Config()
+		buildMySQL90Config()
+		buildMySQL57Config()
+	}
+}
+
+// BenchmarkKeywordMapsInit is the per-version map copying init used to do.
+func BenchmarkKeywordMapsInit(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, v := range keywordVersions {
+			KeywordsFor(v)
+		}
+	}
+}
@@ -0,0 +1,3551 @@
+// Code generated by kwtable from the internal keyword maps. DO NOT EDIT.
+
+package internal
+
+// keywordTable80 holds the MySQL 8.0 keywords.
+var keywordTable80 = &KeywordTable{
+	Seed:   0,
+	MaxLen: 38,
+	Displace: []uint32{
+		14, 19, 28, 1, 93, 13, 28, 8, 29, 5, 24, 14, 86, 1, 2, 0,
+		2, 2, 167, 2, 218, 13, 28, 3, 216, 44, 2, 10, 20, 241, 20, 83,
+		12, 2, 48, 19, 11, 1, 7, 5, 1, 10, 90, 3, 21, 6, 11, 6,
+		10, 17, 35, 38, 17, 24, 15, 94, 23, 15, 0, 4, 30, 41, 2, 8,
+		87, 34, 170, 33, 55, 1, 39, 159, 1, 22, 35, 778, 3, 12, 67, 23,
+		89, 178, 116, 1, 88, 3, 31, 109, 63, 3, 129, 555, 2, 14, 1, 103,
+		1, 196, 47, 27, 5, 131, 178, 65, 24, 1, 4, 58, 125, 9, 97, 194,
+		147, 260, 33, 310, 37, 1, 66, 1057, 330, 27, 6, 174, 1, 544, 89, 60,
+		14, 35, 1, 253, 373, 62, 0, 116, 791, 49, 15, 1, 93, 36, 564, 132,
+		1, 241, 561, 307, 40, 112, 372, 177, 650, 4, 30, 235, 223, 54, 1389, 16,
+		6, 30, 3, 75, 591, 403, 1030, 153, 22, 199, 202, 333, 477, 2, 58, 8,
+		27, 2184, 63, 868, 804, 5, 1, 3, 88, 45, 437, 333, 5, 1462, 29, 5,
+		177, 16, 1229, 335, 1, 173, 398, 2, 1, 87, 1,
+	},
+	Entries: []KeywordEntry{
+		{"CLASS_ORIGIN", CLASS_ORIGIN_SYM},
+		{"SQL_TSI_SECOND", SECOND_SYM},
+		{"SKIP_SCAN", SKIP_SCAN_HINT},
+		{"GROUP_REPLICATION", GROUP_REPLICATION},
+		{"BOOLEAN", BOOLEAN_SYM},
+		{"SHOW", SHOW},
+		{"SQL_BUFFER_RESULT", SQL_BUFFER_RESULT},
+		{"ROW_NUMBER", ROW_NUMBER_SYM},
+		{"HASH_JOIN", HASH_JOIN_HINT},
+		{"MEDIUMBLOB", MEDIUMBLOB_SYM},
+		{"DEC", DECIMAL_SYM},
+		{"AFTER", AFTER_SYM},
+		{"DELAY_KEY_WRITE", DELAY_KEY_WRITE_SYM},
+		{"NOWAIT", NOWAIT_SYM},
+		{"XML", XML_SYM},
+		{"CHECKSUM", CHECKSUM_SYM},
+		{"DOUBLE", DOUBLE_SYM},
+		{"INACTIVE", INACTIVE_SYM},
+		{"LOCKS", LOCKS_SYM},
+		{"MEMORY", MEMORY_SYM},
+		{"START", START_SYM},
+		{"WORK", WORK_SYM},
+		{"SQL_BIG_RESULT", SQL_BIG_RESULT},
+		{"DELAYED", DELAYED_SYM},
+		{"CHAIN", CHAIN_SYM},
+		{"BACKUP", BACKUP_SYM},
+		{"SOURCE_SSL", SOURCE_SSL_SYM},
+		{"CONSTRAINT", CONSTRAINT},
+		{"COMPRESSED", COMPRESSED_SYM},
+		{"UTC_DATE", UTC_DATE_SYM},
+		{"LOOP", LOOP_SYM},
+		{"ROUTINE", ROUTINE_SYM},
+		{"MAX_CONNECTIONS_PER_HOUR", MAX_CONNECTIONS_PER_HOUR},
+		{"TYPE", TYPE_SYM},
+		{"CASCADED", CASCADED},
+		{"SWAPS", SWAPS_SYM},
+		{"SQL_THREAD", SQL_THREAD},
+		{"CURSOR_NAME", CURSOR_NAME_SYM},
+		{"REPLICATION", REPLICATION},
+		{"CURSOR", CURSOR_SYM},
+		{"AUTO_INCREMENT", AUTO_INC},
+		{"UNSIGNED", UNSIGNED_SYM},
+		{"ELSEIF", ELSEIF_SYM},
+		{"SYSDATE", SYSDATE},
+		{"NULLS", NULLS_SYM},
+		{"MATERIALIZATION", MATERIALIZATION_HINT},
+		{"FIRSTMATCH", FIRSTMATCH_HINT},
+		{"UNINSTALL", UNINSTALL_SYM},
+		{"DEFAULT_AUTH", DEFAULT_AUTH_SYM},
+		{"FACTOR", FACTOR_SYM},
+		{"SSL", SSL_SYM},
+		{"ALTER", ALTER},
+		{"RETAIN", RETAIN_SYM},
+		{"UTC_TIME", UTC_TIME_SYM},
+		{"TERMINATED", TERMINATED},
+		{"DATE_SUB", DATE_SUB_INTERVAL},
+		{"EXCHANGE", EXCHANGE_SYM},
+		{"DUPLICATE", DUPLICATE_SYM},
+		{"SMALLINT", SMALLINT_SYM},
+		{"STATUS", STATUS_SYM},
+		{"DATE_ADD", DATE_ADD_INTERVAL},
+		{"KEY", KEY_SYM},
+		{"ASENSITIVE", ASENSITIVE_SYM},
+		{"SPATIAL", SPATIAL_SYM},
+		{"CODE", CODE_SYM},
+		{"INSTANCE", INSTANCE_SYM},
+		{"ISSUER", ISSUER_SYM},
+		{"NO_RANGE_OPTIMIZATION", NO_RANGE_OPTIMIZATION_HINT},
+		{"SYSTEM", SYSTEM_SYM},
+		{"TIES", TIES_SYM},
+		{"PRECEDING", PRECEDING_SYM},
+		{"NO_SKIP_SCAN", NO_SKIP_SCAN_HINT},
+		{"SUBPARTITIONS", SUBPARTITIONS_SYM},
+		{"FLUSH", FLUSH_SYM},
+		{"PARTIAL", PARTIAL},
+		{"ASCII", ASCII_SYM},
+		{"PLUGIN_DIR", PLUGIN_DIR_SYM},
+		{"SQLWARNING", SQLWARNING_SYM},
+		{"HISTOGRAM", HISTOGRAM_SYM},
+		{"FAST", FAST_SYM},
+		{"INT4", INT_SYM},
+		{"QB_NAME", QB_NAME_HINT},
+		{"XOR", XOR},
+		{"ON", ON_SYM},
+		{"REQUIRE", REQUIRE_SYM},
+		{"UPDATE", UPDATE_SYM},
+		{"INT3", MEDIUMINT_SYM},
+		{"JSON_TABLE", JSON_TABLE_SYM},
+		{"KEYRING", KEYRING_SYM},
+		{"SOURCE_SSL_CRL", SOURCE_SSL_CRL_SYM},
+		{"AVG", AVG_SYM},
+		{"FOLLOWING", FOLLOWING_SYM},
+		{"NEW", NEW_SYM},
+		{"OUTFILE", OUTFILE},
+		{"SQL_TSI_QUARTER", QUARTER_SYM},
+		{"TIME", TIME_SYM},
+		{"SECONDARY_ENGINE", SECONDARY_ENGINE_SYM},
+		{"DATE", DATE_SYM},
+		{"OJ", OJ_SYM},
+		{"BINARY", BINARY_SYM},
+		{"BIT_XOR", BIT_XOR_SYM},
+		{"RELAY_LOG_POS", RELAY_LOG_POS_SYM},
+		{"DISK", DISK_SYM},
+		{"EMPTY", EMPTY_SYM},
+		{"INITIAL_SIZE", INITIAL_SIZE_SYM},
+		{"MRR", MRR_HINT},
+		{"INTOEXISTS", INTOEXISTS_HINT},
+		{"REFERENCE", REFERENCE_SYM},
+		{"FLOAT", FLOAT_SYM},
+		{"GEOMCOLLECTION", GEOMETRYCOLLECTION_SYM},
+		{"USER_RESOURCES", RESOURCES},
+		{"SECURITY", SECURITY_SYM},
+		{"CLIENT", CLIENT_SYM},
+		{"SUBCLASS_ORIGIN", SUBCLASS_ORIGIN_SYM},
+		{">=", GE},
+		{"JSON_ARRAYAGG", JSON_ARRAYAGG},
+		{"ERRORS", ERRORS},
+		{"FOREIGN", FOREIGN},
+		{"INT1", TINYINT_SYM},
+		{"COMPRESSION", COMPRESSION_SYM},
+		{"WEIGHT_STRING", WEIGHT_STRING_SYM},
+		{"VARIABLES", VARIABLES},
+		{"MAX_SIZE", MAX_SIZE_SYM},
+		{"DO", DO_SYM},
+		{"REPAIR", REPAIR},
+		{"SERIAL", SERIAL_SYM},
+		{"HISTORY", HISTORY_SYM},
+		{"DIAGNOSTICS", DIAGNOSTICS_SYM},
+		{"LOGFILE", LOGFILE_SYM},
+		{"HOST", HOST_SYM},
+		{"ENUM", ENUM_SYM},
+		{"END", END},
+		{"IF", IF},
+		{"CONSTRAINT_CATALOG", CONSTRAINT_CATALOG_SYM},
+		{"ENGINE", ENGINE_SYM},
+		{"NULL", NULL_SYM},
+		{"LOGS", LOGS_SYM},
+		{"TINYINT", TINYINT_SYM},
+		{"TRUE", TRUE_SYM},
+		{"BLOB", BLOB_SYM},
+		{"PHASE", PHASE_SYM},
+		{"DIV", DIV_SYM},
+		{"NDBCLUSTER", NDBCLUSTER_SYM},
+		{"NTILE", NTILE_SYM},
+		{"SUBSTR", SUBSTRING},
+		{"SET", SET_SYM},
+		{"SOURCE", SOURCE_SYM},
+		{"ORDINALITY", ORDINALITY_SYM},
+		{"ENGINE_ATTRIBUTE", ENGINE_ATTRIBUTE_SYM},
+		{"ALGORITHM", ALGORITHM_SYM},
+		{"NO_BKA", NO_BKA_HINT},
+		{"TABLES", TABLES},
+		{"CONNECTION", CONNECTION_SYM},
+		{"EVENT", EVENT_SYM},
+		{"TIMESTAMPDIFF", TIMESTAMP_DIFF},
+		{"HOUR_MINUTE", HOUR_MINUTE_SYM},
+		{"SUBQUERY", SUBQUERY_HINT},
+		{"WEEK", WEEK_SYM},
+		{"DATABASE", DATABASE},
+		{"NO_ICP", NO_ICP_HINT},
+		{"LIKE", LIKE},
+		{"CHANGE", CHANGE},
+		{"BIT_OR", BIT_OR_SYM},
+		{"CHALLENGE_RESPONSE", CHALLENGE_RESPONSE_SYM},
+		{"VALUE", VALUE_SYM},
+		{"GENERATED", GENERATED},
+		{"MATCH", MATCH},
+		{"PURGE", PURGE},
+		{"PARSER", PARSER_SYM},
+		{"RESTRICT", RESTRICT},
+		{"REPLICA", REPLICA_SYM},
+		{"INTEGER", INT_SYM},
+		{"EXCLUDE", EXCLUDE_SYM},
+		{"HANDLER", HANDLER_SYM},
+		{"RELOAD", RELOAD},
+		{"REUSE", REUSE_SYM},
+		{"CHANNEL", CHANNEL_SYM},
+		{"ENGINES", ENGINES_SYM},
+		{"VARIANCE", VARIANCE_SYM},
+		{"LONGTEXT", LONGTEXT_SYM},
+		{"MAXVALUE", MAX_VALUE_SYM},
+		{"PREV", PREV_SYM},
+		{"BNL", BNL_HINT},
+		{"MODIFIES", MODIFIES_SYM},
+		{"RETURNING", RETURNING_SYM},
+		{"SKIP", SKIP_SYM},
+		{"NONE", NONE_SYM},
+		{"MEDIUM", MEDIUM_SYM},
+		{"HOSTS", HOSTS_SYM},
+		{"FIRST", FIRST_SYM},
+		{"BINLOG", BINLOG_SYM},
+		{"ENCRYPTION", ENCRYPTION_SYM},
+		{"ERROR", ERROR_SYM},
+		{"TRIGGERS", TRIGGERS_SYM},
+		{"UNLOCK", UNLOCK_SYM},
+		{"MIGRATE", MIGRATE_SYM},
+		{"BYTE", BYTE_SYM},
+		{"GROUP_INDEX", GROUP_INDEX_HINT},
+		{"DELETE", DELETE_SYM},
+		{"<=", LE},
+		{"WHILE", WHILE_SYM},
+		{"KEYS", KEYS},
+		{"HOUR", HOUR_SYM},
+		{"READ_ONLY", READ_ONLY_SYM},
+		{"PROCEDURE", PROCEDURE_SYM},
+		{"HAVING", HAVING},
+		{"ROLLBACK", ROLLBACK_SYM},
+		{"TABLE_NAME", TABLE_NAME_SYM},
+		{"IS", IS},
+		{"REPLICATE_WILD_DO_TABLE", REPLICATE_WILD_DO_TABLE},
+		{"MAX_QUERIES_PER_HOUR", MAX_QUERIES_PER_HOUR},
+		{"EXPORT", EXPORT_SYM},
+		{"LONGBLOB", LONGBLOB_SYM},
+		{"SUBPARTITION", SUBPARTITION_SYM},
+		{"CHAR", CHAR_SYM},
+		{"SECONDARY_LOAD", SECONDARY_LOAD_SYM},
+		{"LEVEL", LEVEL_SYM},
+		{"SOURCE_SSL_CERT", SOURCE_SSL_CERT_SYM},
+		{"ACTION", ACTION},
+		{"COLLATION", COLLATION_SYM},
+		{"DEFINITION", DEFINITION_SYM},
+		{"SQL_BEFORE_GTIDS", SQL_BEFORE_GTIDS},
+		{"CREATE", CREATE},
+		{"PARTITIONING", PARTITIONING_SYM},
+		{"ORDER_INDEX", ORDER_INDEX_HINT},
+		{"SQL_TSI_HOUR", HOUR_SYM},
+		{"CURRENT", CURRENT_SYM},
+		{"WAIT", WAIT_SYM},
+		{"WHERE", WHERE},
+		{"UNDO_BUFFER_SIZE", UNDO_BUFFER_SIZE_SYM},
+		{"PRIMARY", PRIMARY_SYM},
+		{"BKA", BKA_HINT},
+		{"BTREE", BTREE_SYM},
+		{"COLUMNS", COLUMNS},
+		{"MICROSECOND", MICROSECOND_SYM},
+		{"MIN_ROWS", MIN_ROWS},
+		{"CROSS", CROSS},
+		{"COLUMN_NAME", COLUMN_NAME_SYM},
+		{"USER", USER},
+		{"WITH", WITH},
+		{"READ", READ_SYM},
+		{"DATA", DATA_SYM},
+		{"BLOCK", BLOCK_SYM},
+		{"CONTINUE", CONTINUE_SYM},
+		{"EXPANSION", EXPANSION_SYM},
+		{"NO_HASH_JOIN", NO_HASH_JOIN_HINT},
+		{"PRECEDES", PRECEDES_SYM},
+		{"SESSION_USER", USER},
+		{"SOURCE_BIND", SOURCE_BIND_SYM},
+		{"NO_ORDER_INDEX", NO_ORDER_INDEX_HINT},
+		{"FUNCTION", FUNCTION_SYM},
+		{"CHANGED", CHANGED},
+		{"VAR_POP", VARIANCE_SYM},
+		{"CASE", CASE_SYM},
+		{"ADMIN", ADMIN_SYM},
+		{"LESS", LESS_SYM},
+		{"GRANT", GRANT},
+		{"SOURCE_CONNECT_RETRY", SOURCE_CONNECT_RETRY_SYM},
+		{"PARTITION", PARTITION_SYM},
+		{"DAY_HOUR", DAY_HOUR_SYM},
+		{"UNICODE", UNICODE_SYM},
+		{"BEGIN", BEGIN_SYM},
+		{"REQUIRE_ROW_FORMAT", REQUIRE_ROW_FORMAT_SYM},
+		{"PLUGINS", PLUGINS_SYM},
+		{"VARCHAR", VARCHAR_SYM},
+		{"MERGE", MERGE_SYM},
+		{"RELAY_THREAD", RELAY_THREAD},
+		{"LOOSESCAN", LOOSESCAN_HINT},
+		{"UNION", UNION_SYM},
+		{"STDDEV", STD_SYM},
+		{"COMPLETION", COMPLETION_SYM},
+		{"UNTIL", UNTIL_SYM},
+		{"DAY_SECOND", DAY_SECOND_SYM},
+		{"SECOND_MICROSECOND", SECOND_MICROSECOND_SYM},
+		{"RANGE", RANGE_SYM},
+		{"EXISTS", EXISTS},
+		{"CONSTRAINT_SCHEMA", CONSTRAINT_SCHEMA_SYM},
+		{"EVENTS", EVENTS_SYM},
+		{"=", EQ},
+		{"LATERAL", LATERAL_SYM},
+		{"STDDEV_POP", STD_SYM},
+		{"NO_WRITE_TO_BINLOG", NO_WRITE_TO_BINLOG},
+		{"TYPES", TYPES_SYM},
+		{"NO_GROUP_INDEX", NO_GROUP_INDEX_HINT},
+		{"FAULTS", FAULTS_SYM},
+		{"||", OR_OR_SYM},
+		{"SOME", ANY_SYM},
+		{"HIGH_PRIORITY", HIGH_PRIORITY},
+		{"REVOKE", REVOKE},
+		{"LINESTRING", LINESTRING_SYM},
+		{"PASSWORD_LOCK_TIME", PASSWORD_LOCK_TIME_SYM},
+		{"ENABLE", ENABLE_SYM},
+		{"PROFILES", PROFILES_SYM},
+		{"FOR", FOR_SYM},
+		{"IO_AFTER_GTIDS", IO_AFTER_GTIDS},
+		{"DATETIME", DATETIME_SYM},
+		{"LIMIT", LIMIT},
+		{"FULLTEXT", FULLTEXT_SYM},
+		{"REPLICAS", REPLICAS_SYM},
+		{"SECOND", SECOND_SYM},
+		{"COALESCE", COALESCE},
+		{"PATH", PATH_SYM},
+		{"URL", URL_SYM},
+		{"INDEX", INDEX_SYM},
+		{"BETWEEN", BETWEEN_SYM},
+		{"TINYBLOB", TINYBLOB_SYM},
+		{"WARNINGS", WARNINGS},
+		{"LOCAL", LOCAL_SYM},
+		{"NOT", NOT_SYM},
+		{"STRAIGHT_JOIN", STRAIGHT_JOIN},
+		{"OFF", OFF_SYM},
+		{"LEFT", LEFT},
+		{"ACTIVE", ACTIVE_SYM},
+		{"NO_INDEX", NO_INDEX_HINT},
+		{"REMOVE", REMOVE_SYM},
+		{"SET_VAR", SET_VAR_HINT},
+		{"DERIVED_CONDITION_PUSHDOWN", DERIVED_CONDITION_PUSHDOWN_HINT},
+		{"TRAILING", TRAILING},
+		{"CURRENT_USER", CURRENT_USER},
+		{"PERSIST", PERSIST_SYM},
+		{"JSON_OBJECTAGG", JSON_OBJECTAGG},
+		{"FULL", FULL},
+		{"FILE_BLOCK_SIZE", FILE_BLOCK_SIZE_SYM},
+		{"NAME", NAME_SYM},
+		{"SQL", SQL_SYM},
+		{"SOURCE_LOG_FILE", SOURCE_LOG_FILE_SYM},
+		{"JOIN_INDEX", JOIN_INDEX_HINT},
+		{"GROUPING", GROUPING_SYM},
+		{"ROW_COUNT", ROW_COUNT_SYM},
+		{"MEMBER", MEMBER_SYM},
+		{"ARRAY", ARRAY_SYM},
+		{"ZONE", ZONE_SYM},
+		{"LOCALTIMESTAMP", NOW_SYM},
+		{"BULK", BULK_SYM},
+		{"CIPHER", CIPHER_SYM},
+		{"CAST", CAST_SYM},
+		{"FLOAT8", DOUBLE_SYM},
+		{"JOIN", JOIN_SYM},
+		{"SOURCE_SSL_CA", SOURCE_SSL_CA_SYM},
+		{"RESTART", RESTART_SYM},
+		{"SONAME", SONAME_SYM},
+		{"GET_FORMAT", GET_FORMAT},
+		{"PERSIST_ONLY", PERSIST_ONLY_SYM},
+		{"GEOMETRY", GEOMETRY_SYM},
+		{"DISTINCT", DISTINCT},
+		{"INNER", INNER_SYM},
+		{"CLOSE", CLOSE_SYM},
+		{"SPECIFIC", SPECIFIC_SYM},
+		{"INDEXES", INDEXES},
+		{"ALWAYS", ALWAYS_SYM},
+		{"XA", XA_SYM},
+		{"SHUTDOWN", SHUTDOWN},
+		{"INDEX_MERGE", INDEX_MERGE_HINT},
+		{"VARYING", VARYING},
+		{"OR", OR_SYM},
+		{"SOURCE_PASSWORD", SOURCE_PASSWORD_SYM},
+		{"SUBDATE", SUBDATE_SYM},
+		{"LEAVES", LEAVES},
+		{"ADDDATE", ADDDATE_SYM},
+		{"CONTAINS", CONTAINS_SYM},
+		{"RANK", RANK_SYM},
+		{"JOIN_FIXED_ORDER", JOIN_FIXED_ORDER_HINT},
+		{"DESCRIBE", DESCRIBE},
+		{"STORAGE", STORAGE_SYM},
+		{"RESPECT", RESPECT_SYM},
+		{"GEOMETRYCOLLECTION", GEOMETRYCOLLECTION_SYM},
+		{"PLUGIN", PLUGIN_SYM},
+		{"SECONDARY_ENGINE_ATTRIBUTE", SECONDARY_ENGINE_ATTRIBUTE_SYM},
+		{"JOIN_PREFIX", JOIN_PREFIX_HINT},
+		{"CASCADE", CASCADE},
+		{"SQLSTATE", SQLSTATE_SYM},
+		{"<", LT},
+		{"CUBE", CUBE_SYM},
+		{"SCHEDULE", SCHEDULE_SYM},
+		{"MODIFY", MODIFY_SYM},
+		{"IGNORE", IGNORE_SYM},
+		{"VALIDATION", VALIDATION_SYM},
+		{"TEXT", TEXT_SYM},
+		{"SQL_CALC_FOUND_ROWS", SQL_CALC_FOUND_ROWS},
+		{"GTID_ONLY", GTID_ONLY_SYM},
+		{"PERCENT_RANK", PERCENT_RANK_SYM},
+		{"CONCURRENT", CONCURRENT},
+		{"SQL_TSI_DAY", DAY_SYM},
+		{"GROUP_CONCAT", GROUP_CONCAT_SYM},
+		{"LEAD", LEAD_SYM},
+		{"NUMERIC", NUMERIC_SYM},
+		{"GET_SOURCE_PUBLIC_KEY", GET_SOURCE_PUBLIC_KEY_SYM},
+		{"READS", READS_SYM},
+		{"ASC", ASC},
+		{"TRUNCATE", TRUNCATE_SYM},
+		{"BY", BY},
+		{"SELECT", SELECT_SYM},
+		{"TEMPORARY", TEMPORARY},
+		{"KILL", KILL_SYM},
+		{"FOLLOWS", FOLLOWS_SYM},
+		{"ROW_FORMAT", ROW_FORMAT_SYM},
+		{"SOUNDS", SOUNDS_SYM},
+		{"REFERENCES", REFERENCES},
+		{"POSITION", POSITION_SYM},
+		{"CHARACTER", CHAR_SYM},
+		{"CURTIME", CURTIME},
+		{"EXTENDED", EXTENDED_SYM},
+		{"REPLICATE_DO_TABLE", REPLICATE_DO_TABLE},
+		{"CATALOG_NAME", CATALOG_NAME_SYM},
+		{"SIMPLE", SIMPLE_SYM},
+		{"RENAME", RENAME},
+		{"UNBOUNDED", UNBOUNDED_SYM},
+		{"ANY", ANY_SYM},
+		{"OPEN", OPEN_SYM},
+		{"TINYTEXT", TINYTEXT_SYN},
+		{"JOIN_SUFFIX", JOIN_SUFFIX_HINT},
+		{"COMMIT", COMMIT_SYM},
+		{"RETURNS", RETURNS_SYM},
+		{"RESOURCE", RESOURCE_SYM},
+		{"UNIQUE", UNIQUE_SYM},
+		{"STACKED", STACKED_SYM},
+		{"LIST", LIST_SYM},
+		{"DISABLE", DISABLE_SYM},
+		{"IN", IN_SYM},
+		{"LANGUAGE", LANGUAGE_SYM},
+		{"TABLESPACE", TABLESPACE_SYM},
+		{"SOURCE_PORT", SOURCE_PORT_SYM},
+		{"MULTILINESTRING", MULTILINESTRING_SYM},
+		{"INSTALL", INSTALL_SYM},
+		{"SOURCE_ZSTD_COMPRESSION_LEVEL", SOURCE_ZSTD_COMPRESSION_LEVEL_SYM},
+		{"NEVER", NEVER_SYM},
+		{"LEAVE", LEAVE_SYM},
+		{"LINES", LINES},
+		{"OPTIMIZE", OPTIMIZE},
+		{"DAY_MICROSECOND", DAY_MICROSECOND_SYM},
+		{"RTREE", RTREE_SYM},
+		{"XID", XID_SYM},
+		{"REPLICATE_IGNORE_DB", REPLICATE_IGNORE_DB},
+		{"UNDO", UNDO_SYM},
+		{"AGGREGATE", AGGREGATE_SYM},
+		{"GET_MASTER_PUBLIC_KEY", OBSOLETE_TOKEN_967},
+		{"DATAFILE", DATAFILE_SYM},
+		{"CONSTRAINT_NAME", CONSTRAINT_NAME_SYM},
+		{"GET", GET_SYM},
+		{"ST_COLLECT", ST_COLLECT_SYM},
+		{"OPTIMIZER_COSTS", OPTIMIZER_COSTS_SYM},
+		{"GROUPS", GROUPS_SYM},
+		{"TRIGGER", TRIGGER_SYM},
+		{"THAN", THAN_SYM},
+		{"MID", SUBSTRING},
+		{"OPTIONS", OPTIONS_SYM},
+		{"NO_BNL", NO_BNL_HINT},
+		{"SOURCE_HOST", SOURCE_HOST_SYM},
+		{"CURRENT_TIME", CURTIME},
+		{"NO_MRR", NO_MRR_HINT},
+		{"UNCOMMITTED", UNCOMMITTED_SYM},
+		{"VARCHARACTER", VARCHAR_SYM},
+		{"REQUIRE_TABLE_PRIMARY_KEY_CHECK", REQUIRE_TABLE_PRIMARY_KEY_CHECK_SYM},
+		{"VCPU", VCPU_SYM},
+		{"ORDER", ORDER_SYM},
+		{"SERIALIZABLE", SERIALIZABLE_SYM},
+		{"AND", AND_SYM},
+		{"MAX", MAX_SYM},
+		{"ESCAPE", ESCAPE_SYM},
+		{"AGAINST", AGAINST},
+		{"SQL_TSI_MONTH", MONTH_SYM},
+		{"BUCKETS", BUCKETS_SYM},
+		{"SOURCE_CONNECTION_AUTO_FAILOVER", SOURCE_CONNECTION_AUTO_FAILOVER_SYM},
+		{"DEFAULT", DEFAULT_SYM},
+		{"TABLE_CHECKSUM", TABLE_CHECKSUM_SYM},
+		{"RESTORE", RESTORE_SYM},
+		{"VIEW", VIEW_SYM},
+		{"OFFSET", OFFSET_SYM},
+		{"SCHEMA_NAME", SCHEMA_NAME_SYM},
+		{"ONLY", ONLY_SYM},
+		{"LOAD", LOAD},
+		{"INTERSECT", INTERSECT_SYM},
+		{"CPU", CPU_SYM},
+		{"INT8", BIGINT_SYM},
+		{"SOURCE_DELAY", SOURCE_DELAY_SYM},
+		{"HELP", HELP_SYM},
+		{"MINUTE_SECOND", MINUTE_SECOND_SYM},
+		{"INTO", INTO},
+		{"MAX_UPDATES_PER_HOUR", MAX_UPDATES_PER_HOUR},
+		{"INSENSITIVE", INSENSITIVE_SYM},
+		{"PARTITIONS", PARTITIONS_SYM},
+		{"RELAYLOG", RELAYLOG_SYM},
+		{"COMPACT", COMPACT_SYM},
+		{"DEFINER", DEFINER_SYM},
+		{"PRIVILEGE_CHECKS_USER", PRIVILEGE_CHECKS_USER_SYM},
+		{"SERVER", SERVER_SYM},
+		{"CUME_DIST", CUME_DIST_SYM},
+		{"STATS_SAMPLE_PAGES", STATS_SAMPLE_PAGES_SYM},
+		{"SQL_NO_CACHE", SQL_NO_CACHE_SYM},
+		{"REORGANIZE", REORGANIZE_SYM},
+		{"NESTED", NESTED_SYM},
+		{"JSON_VALUE", JSON_VALUE_SYM},
+		{"SNAPSHOT", SNAPSHOT_SYM},
+		{"ROLLUP", ROLLUP_SYM},
+		{"REPLICATE_WILD_IGNORE_TABLE", REPLICATE_WILD_IGNORE_TABLE},
+		{"RESUME", RESUME_SYM},
+		{"SOURCE_TLS_CIPHERSUITES", SOURCE_TLS_CIPHERSUITES_SYM},
+		{"SQL_TSI_YEAR", YEAR_SYM},
+		{"ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS", ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_SYM},
+		{"BIT", BIT_SYM},
+		{"MAX_ROWS", MAX_ROWS},
+		{"DAY_MINUTE", DAY_MINUTE_SYM},
+		{"MONTH", MONTH_SYM},
+		{"ROW", ROW_SYM},
+		{"SQLEXCEPTION", SQLEXCEPTION_SYM},
+		{"GLOBAL", GLOBAL_SYM},
+		{"DATABASES", DATABASES},
+		{"GENERAL", GENERAL},
+		{"LOCKED", LOCKED_SYM},
+		{"INSERT_METHOD", INSERT_METHOD},
+		{"NO_SEMIJOIN", NO_SEMIJOIN_HINT},
+		{"TRANSACTION", TRANSACTION_SYM},
+		{"SUBJECT", SUBJECT_SYM},
+		{"SOCKET", SOCKET_SYM},
+		{"LONG", LONG_SYM},
+		{"LAST_VALUE", LAST_VALUE_SYM},
+		{"DESC", DESC},
+		{"UPGRADE", UPGRADE_SYM},
+		{"ROTATE", ROTATE_SYM},
+		{"DISTINCTROW", DISTINCT},
+		{"RIGHT", RIGHT},
+		{"SWITCHES", SWITCHES_SYM},
+		{"GROUP", GROUP_SYM},
+		{"IO_BEFORE_GTIDS", IO_BEFORE_GTIDS},
+		{"REDUNDANT", REDUNDANT_SYM},
+		{"SEMIJOIN", SEMIJOIN_HINT},
+		{"SYSTEM_USER", USER},
+		{"VAR_SAMP", VAR_SAMP_SYM},
+		{"UNKNOWN", UNKNOWN_SYM},
+		{"DYNAMIC", DYNAMIC_SYM},
+		{"INTERVAL", INTERVAL_SYM},
+		{"MASTER", MASTER_SYM},
+		{"ENFORCED", ENFORCED_SYM},
+		{"YEAR_MONTH", YEAR_MONTH_SYM},
+		{"STRING", STRING_SYM},
+		{"VISIBLE", VISIBLE_SYM},
+		{"STARTS", STARTS_SYM},
+		{"VALUES", VALUES},
+		{"PROCESSLIST", PROCESSLIST_SYM},
+		{"CURRENT_DATE", CURDATE},
+		{"CONDITION", CONDITION_SYM},
+		{"SLOW", SLOW},
+		{"YEAR", YEAR_SYM},
+		{"LEADING", LEADING},
+		{"DUMPFILE", DUMPFILE},
+		{"HOUR_MICROSECOND", HOUR_MICROSECOND_SYM},
+		{"TEMPTABLE", TEMPTABLE_SYM},
+		{"TO", TO_SYM},
+		{"PRIVILEGES", PRIVILEGES},
+		{"REGEXP", REGEXP},
+		{"WITHOUT", WITHOUT_SYM},
+		{"INVOKER", INVOKER_SYM},
+		{"NDB", NDBCLUSTER_SYM},
+		{"LOCALTIME", NOW_SYM},
+		{"EXPLAIN", DESCRIBE},
+		{"NEXT", NEXT_SYM},
+		{"CACHE", CACHE_SYM},
+		{"BEFORE", BEFORE_SYM},
+		{"SOURCE_SSL_KEY", SOURCE_SSL_KEY_SYM},
+		{"MULTIPOINT", MULTIPOINT_SYM},
+		{"VARBINARY", VARBINARY_SYM},
+		{"X509", X509_SYM},
+		{"REDO_BUFFER_SIZE", REDO_BUFFER_SIZE_SYM},
+		{"HOUR_SECOND", HOUR_SECOND_SYM},
+		{"NVARCHAR", NVARCHAR_SYM},
+		{"SESSION", SESSION_SYM},
+		{"KEY_BLOCK_SIZE", KEY_BLOCK_SIZE},
+		{"ENCLOSED", ENCLOSED},
+		{"AT", AT_SYM},
+		{"TIMESTAMPADD", TIMESTAMP_ADD},
+		{"INVISIBLE", INVISIBLE_SYM},
+		{"DETERMINISTIC", DETERMINISTIC_SYM},
+		{"FOUND", FOUND_SYM},
+		{"QUERY", QUERY_SYM},
+		{"USAGE", USAGE},
+		{"GENERATE", GENERATE_SYM},
+		{"OUT", OUT_SYM},
+		{"OPTION", OPTION},
+		{"USING", USING},
+		{"OVER", OVER_SYM},
+		{"SIGNED", SIGNED_SYM},
+		{"LAG", LAG_SYM},
+		{"SQL_AFTER_MTS_GAPS", SQL_AFTER_MTS_GAPS},
+		{"CHARSET", CHARSET},
+		{"DIRECTORY", DIRECTORY_SYM},
+		{"NATURAL", NATURAL},
+		{"OF", OF_SYM},
+		{"UNDOFILE", UNDOFILE_SYM},
+		{"REBUILD", REBUILD_SYM},
+		{"RESIGNAL", RESIGNAL_SYM},
+		{"READ_WRITE", READ_WRITE_SYM},
+		{"EXECUTE", EXECUTE_SYM},
+		{"RELAY_LOG_FILE", RELAY_LOG_FILE_SYM},
+		{"STREAM", STREAM_SYM},
+		{"DISCARD", DISCARD_SYM},
+		{"DENSE_RANK", DENSE_RANK_SYM},
+		{"QUICK", QUICK},
+		{"BIT_AND", BIT_AND_SYM},
+		{"INFILE", INFILE_SYM},
+		{"WRAPPER", WRAPPER_SYM},
+		{"SEPARATOR", SEPARATOR_SYM},
+		{"PROXY", PROXY_SYM},
+		{"DECLARE", DECLARE_SYM},
+		{"JSON", JSON_SYM},
+		{"SECONDARY", SECONDARY_SYM},
+		{"PROFILE", PROFILE_SYM},
+		{"ALL", ALL},
+		{"FINISH", FINISH_SYM},
+		{"OUTER", OUTER_SYM},
+		{"REVERSE", REVERSE_SYM},
+		{"NAMES", NAMES_SYM},
+		{"MESSAGE_TEXT", MESSAGE_TEXT_SYM},
+		{"EXTRACT", EXTRACT_SYM},
+		{"REPLACE", REPLACE_SYM},
+		{"EXIT", EXIT_SYM},
+		{"NTH_VALUE", NTH_VALUE_SYM},
+		{"DECIMAL", DECIMAL_SYM},
+		{"SOURCE_LOG_POS", SOURCE_LOG_POS_SYM},
+		{"PREPARE", PREPARE_SYM},
+		{"EACH", EACH_SYM},
+		{"LINEAR", LINEAR_SYM},
+		{"NO_JOIN_INDEX", NO_JOIN_INDEX_HINT},
+		{"SQL_TSI_WEEK", WEEK_SYM},
+		{"SUPER", SUPER_SYM},
+		{"CURRENT_TIMESTAMP", NOW_SYM},
+		{"EXCEPT", EXCEPT_SYM},
+		{"COLUMN_FORMAT", COLUMN_FORMAT_SYM},
+		{"INSERT", INSERT_SYM},
+		{">", GT_SYM},
+		{"RELEASE", RELEASE_SYM},
+		{"FLOAT4", FLOAT_SYM},
+		{"MOD", MOD_SYM},
+		{"THEN", THEN_SYM},
+		{"COMMITTED", COMMITTED_SYM},
+		{"IGNORE_SERVER_IDS", IGNORE_SERVER_IDS_SYM},
+		{"OPTIONAL", OPTIONAL_SYM},
+		{">>", SHIFT_RIGHT},
+		{"CALL", CALL_SYM},
+		{"USE_FRM", USE_FRM},
+		{"ZEROFILL", ZEROFILL_SYM},
+		{"SECONDARY_UNLOAD", SECONDARY_UNLOAD_SYM},
+		{"COLLATE", COLLATE_SYM},
+		{"INOUT", INOUT_SYM},
+		{"OPTIONALLY", OPTIONALLY},
+		{"INITIAL", INITIAL_SYM},
+		{"NO", NO_SYM},
+		{"AUTHENTICATION", AUTHENTICATION_SYM},
+		{"UNREGISTER", UNREGISTER_SYM},
+		{"PRECISION", PRECISION},
+		{"RELAY", RELAY},
+		{"FORMAT", FORMAT_SYM},
+		{"IO_THREAD", RELAY_THREAD},
+		{"RANDOM", RANDOM_SYM},
+		{"SOURCE_SSL_VERIFY_SERVER_CERT", SOURCE_SSL_VERIFY_SERVER_CERT_SYM},
+		{"FALSE", FALSE_SYM},
+		{"STARTING", STARTING},
+		{"SUBSTRING", SUBSTRING},
+		{"SOURCE_RETRY_COUNT", SOURCE_RETRY_COUNT_SYM},
+		{"ATTRIBUTE", ATTRIBUTE_SYM},
+		{"ESCAPED", ESCAPED},
+		{"COMMENT", COMMENT_SYM},
+		{"SOURCE_COMPRESSION_ALGORITHMS", SOURCE_COMPRESSION_ALGORITHM_SYM},
+		{"RETURNED_SQLSTATE", RETURNED_SQLSTATE_SYM},
+		{"&&", AND_AND_SYM},
+		{"AS", AS},
+		{"SCHEMAS", DATABASES},
+		{"FAILED_LOGIN_ATTEMPTS", FAILED_LOGIN_ATTEMPTS_SYM},
+		{"INT", INT_SYM},
+		{"PORT", PORT_SYM},
+		{"CONSISTENT", CONSISTENT_SYM},
+		{"IDENTIFIED", IDENTIFIED_SYM},
+		{"SOURCE_USER", SOURCE_USER_SYM},
+		{"CLONE", CLONE_SYM},
+		{"TRIM", TRIM},
+		{"SOURCE_SSL_CIPHER", SOURCE_SSL_CIPHER_SYM},
+		{"SOURCE_PUBLIC_KEY_PATH", SOURCE_PUBLIC_KEY_PATH_SYM},
+		{"REAL", REAL_SYM},
+		{"ACCOUNT", ACCOUNT_SYM},
+		{"BOTH", BOTH},
+		{"PAGE", PAGE_SYM},
+		{"PASSWORD", PASSWORD},
+		{"ISOLATION", ISOLATION},
+		{"USE", USE_SYM},
+		{"NO_DERIVED_CONDITION_PUSHDOWN", NO_DERIVED_CONDITION_PUSHDOWN_HINT},
+		{"AVG_ROW_LENGTH", AVG_ROW_LENGTH},
+		{"LOCK", LOCK_SYM},
+		{"STATS_PERSISTENT", STATS_PERSISTENT_SYM},
+		{"NETWORK_NAMESPACE", NETWORK_NAMESPACE_SYM},
+		{"MAX_EXECUTION_TIME", MAX_EXECUTION_TIME_HINT},
+		{"STDDEV_SAMP", STDDEV_SAMP_SYM},
+		{"NO_INDEX_MERGE", NO_INDEX_MERGE_HINT},
+		{"COLUMN", COLUMN_SYM},
+		{"LOW_PRIORITY", LOW_PRIORITY},
+		{"NODEGROUP", NODEGROUP_SYM},
+		{"COUNT", COUNT_SYM},
+		{"FIXED", FIXED_SYM},
+		{"MINUTE", MINUTE_SYM},
+		{"THREAD_PRIORITY", THREAD_PRIORITY_SYM},
+		{"TLS", TLS_SYM},
+		{"VIRTUAL", VIRTUAL_SYM},
+		{"REGISTRATION", REGISTRATION_SYM},
+		{"FIRST_VALUE", FIRST_VALUE_SYM},
+		{"SCHEMA", DATABASE},
+		{"HASH", HASH_SYM},
+		{"REPEAT", REPEAT_SYM},
+		{"STATS_AUTO_RECALC", STATS_AUTO_RECALC_SYM},
+		{"STORED", STORED_SYM},
+		{"BOOL", BOOL_SYM},
+		{"GRANTS", GRANTS},
+		{"CONTEXT", CONTEXT_SYM},
+		{"ROLE", ROLE_SYM},
+		{"ANALYZE", ANALYZE_SYM},
+		{"MIDDLEINT", MEDIUMINT_SYM},
+		{"OTHERS", OTHERS_SYM},
+		{"ADD", ADD},
+		{"WRITE", WRITE_SYM},
+		{"DEALLOCATE", DEALLOCATE_SYM},
+		{"MAX_USER_CONNECTIONS", MAX_USER_CONNECTIONS_SYM},
+		{"SLAVE", SLAVE},
+		{"<>", NE},
+		{"QUARTER", QUARTER_SYM},
+		{"IMPORT", IMPORT},
+		{"SUM", SUM_SYM},
+		{"NOW", NOW_SYM},
+		{"RLIKE", REGEXP},
+		{"EVERY", EVERY_SYM},
+		{"<<", SHIFT_LEFT},
+		{"FROM", FROM},
+		{"ITERATE", ITERATE_SYM},
+		{"SQL_SMALL_RESULT", SQL_SMALL_RESULT},
+		{"MINUTE_MICROSECOND", MINUTE_MICROSECOND_SYM},
+		{"SOURCE_TLS_VERSION", SOURCE_TLS_VERSION_SYM},
+		{"WHEN", WHEN_SYM},
+		{"SOURCE_SSL_CAPATH", SOURCE_SSL_CAPATH_SYM},
+		{"PROCESS", PROCESS},
+		{"ORGANIZATION", ORGANIZATION_SYM},
+		{"SOURCE_HEARTBEAT_PERIOD", SOURCE_HEARTBEAT_PERIOD_SYM},
+		{"ACCESSIBLE", ACCESSIBLE_SYM},
+		{"DROP", DROP},
+		{"FORCE", FORCE_SYM},
+		{"DUPSWEEDOUT", DUPSWEEDOUT_HINT},
+		{"EXTENT_SIZE", EXTENT_SIZE_SYM},
+		{"INT2", SMALLINT_SYM},
+		{"DAY", DAY_SYM},
+		{"MEDIUMINT", MEDIUMINT_SYM},
+		{"INITIATE", INITIATE_SYM},
+		{"ONE", ONE_SYM},
+		{"REPEATABLE", REPEATABLE_SYM},
+		{"SENSITIVE", SENSITIVE_SYM},
+		{"CONVERT", CONVERT_SYM},
+		{"NATIONAL", NATIONAL_SYM},
+		{"BIGINT", BIGINT_SYM},
+		{"FETCH", FETCH_SYM},
+		{"POLYGON", POLYGON_SYM},
+		{"TIMESTAMP", TIMESTAMP_SYM},
+		{"ROWS", ROWS_SYM},
+		{"MYSQL_ERRNO", MYSQL_ERRNO_SYM},
+		{"NUMBER", NUMBER_SYM},
+		{"SHARE", SHARE_SYM},
+		{"SUSPEND", SUSPEND_SYM},
+		{"DESCRIPTION", DESCRIPTION_SYM},
+		{"PRESERVE", PRESERVE_SYM},
+		{"FILE", FILE_SYM},
+		{"JOIN_ORDER", JOIN_ORDER_HINT},
+		{"ELSE", ELSE},
+		{"CURDATE", CURDATE},
+		{"POINT", POINT_SYM},
+		{"SIGNAL", SIGNAL_SYM},
+		{"UTC_TIMESTAMP", UTC_TIMESTAMP_SYM},
+		{"MEDIUMTEXT", MEDIUMTEXT_SYM},
+		{"MIN", MIN_SYM},
+		{"EXPIRE", EXPIRE_SYM},
+		{"SQL_TSI_MINUTE", MINUTE_SYM},
+		{"RESET", RESET_SYM},
+		{"<=>", EQUAL_SYM},
+		{"SQL_AFTER_GTIDS", SQL_AFTER_GTIDS},
+		{"TABLE", TABLE_SYM},
+		{"MUTEX", MUTEX_SYM},
+		{"UNDEFINED", UNDEFINED_SYM},
+		{"ENDS", ENDS_SYM},
+		{"FIELDS", COLUMNS},
+		{"SOURCE_AUTO_POSITION", SOURCE_AUTO_POSITION_SYM},
+		{"IO", IO_SYM},
+		{"OWNER", OWNER_SYM},
+		{"AUTOEXTEND_SIZE", AUTOEXTEND_SIZE_SYM},
+		{"STOP", STOP_SYM},
+		{"NO_MERGE", NO_DERIVED_MERGE_HINT},
+		{"DUAL", DUAL_SYM},
+		{"RECURSIVE", RECURSIVE_SYM},
+		{"CHECK", CHECK_SYM},
+		{"STD", STD_SYM},
+		{"RECOVER", RECOVER_SYM},
+		{"REPLICATE_IGNORE_TABLE", REPLICATE_IGNORE_TABLE},
+		{"!=", NE},
+		{"MULTIPOLYGON", MULTIPOLYGON_SYM},
+		{"OLD", OLD_SYM},
+		{"PACK_KEYS", PACK_KEYS_SYM},
+		{"REPLICATE_REWRITE_DB", REPLICATE_REWRITE_DB},
+		{"NO_WAIT", NO_WAIT_SYM},
+		{"FILTER", FILTER_SYM},
+		{"SOURCE_SSL_CRLPATH", SOURCE_SSL_CRLPATH_SYM},
+		{"SRID", SRID_SYM},
+		{"MODE", MODE_SYM},
+		{"RETURN", RETURN_SYM},
+		{"LAST", LAST_SYM},
+		{"RESOURCE_GROUP", RESOURCE_GROUP_HINT},
+		{"SAVEPOINT", SAVEPOINT_SYM},
+		{"REPLICATE_DO_DB", REPLICATE_DO_DB},
+		{"IPC", IPC_SYM},
+		{"WINDOW", WINDOW_SYM},
+		{"NCHAR", NCHAR_SYM},
+		{"COMPONENT", COMPONENT_SYM},
+	},
+}
+
+// keywordTable84 holds the MySQL 8.4 keywords.
+var keywordTable84 = &KeywordTable{
+	Seed:   0,
+	MaxLen: 38,
+	Displace: []uint32{
+		12, 48, 1, 23, 3, 2, 60, 4, 0, 10, 17, 2, 86, 1, 41, 18,
+		7, 8, 1, 11, 64, 2, 4, 1, 171, 5, 103, 223, 6, 1, 17, 2,
+		2, 33, 8, 100, 9, 35, 47, 13, 21, 48, 8, 25, 1, 422, 3, 2,
+		6, 353, 2, 7, 76, 92, 104, 13, 1, 71, 40, 24, 144, 5, 6, 351,
+		25, 129, 174, 333, 5, 651, 2, 208, 22, 215, 3, 645, 1, 1021, 1, 44,
+		199, 47, 3, 597, 7, 25, 1, 3, 301, 18, 34, 887, 5, 120, 371, 575,
+		1, 1, 378, 2, 33, 6, 1, 35, 8, 702, 1, 99, 25, 0, 34, 1,
+		29, 43, 4, 4, 34, 5, 1, 58, 77, 24, 31, 167, 40, 1536, 118, 272,
+		1, 103, 57, 91, 2, 261, 151, 224, 603, 7, 14, 97, 15, 122, 1141, 228,
+		26, 1131, 4, 45, 1, 2078, 21, 5, 23, 916, 110, 102, 374, 3166, 93, 1,
+		32, 1, 474, 666, 127, 594, 639, 18, 253, 1, 1613, 171, 1426, 2, 1520, 6174,
+		517, 91, 185, 11, 5, 145, 460, 22, 190, 550, 6, 29, 48, 4393, 13, 552,
+		33, 1, 1, 69, 68, 252, 11, 7, 984, 683, 226, 421, 25, 687,
+	},
+	Entries: []KeywordEntry{
+		{"BNL", BNL_HINT},
+		{"GTID_ONLY", GTID_ONLY_SYM},
+		{"ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS", ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_SYM},
+		{"UNDEFINED", UNDEFINED_SYM},
+		{"PRECEDING", PRECEDING_SYM},
+		{"CREATE", CREATE},
+		{"LOOP", LOOP_SYM},
+		{"WARNINGS", WARNINGS},
+		{"STATS_AUTO_RECALC", STATS_AUTO_RECALC_SYM},
+		{"UPGRADE", UPGRADE_SYM},
+		{"STORAGE", STORAGE_SYM},
+		{"EMPTY", EMPTY_SYM},
+		{"IF", IF},
+		{"WEEK", WEEK_SYM},
+		{"NATIONAL", NATIONAL_SYM},
+		{"SMALLINT", SMALLINT_SYM},
+		{"JSON", JSON_SYM},
+		{"BIGINT", BIGINT_SYM},
+		{"DUPSWEEDOUT", DUPSWEEDOUT_HINT},
+		{"INVOKER", INVOKER_SYM},
+		{"POLYGON", POLYGON_SYM},
+		{"PROCESS", PROCESS},
+		{"ELSE", ELSE},
+		{"CACHE", CACHE_SYM},
+		{"SQL_NO_CACHE", SQL_NO_CACHE_SYM},
+		{"SOURCE_HOST", SOURCE_HOST_SYM},
+		{"UNDO", UNDO_SYM},
+		{"FROM", FROM},
+		{"SECONDARY_UNLOAD", SECONDARY_UNLOAD_SYM},
+		{"FACTOR", FACTOR_SYM},
+		{"ORGANIZATION", ORGANIZATION_SYM},
+		{"MAX_SIZE", MAX_SIZE_SYM},
+		{"PASSWORD", PASSWORD},
+		{"NEXT", NEXT_SYM},
+		{"DATE_ADD", DATE_ADD_INTERVAL},
+		{"LAST_VALUE", LAST_VALUE_SYM},
+		{"SENSITIVE", SENSITIVE_SYM},
+		{"NO_ICP", NO_ICP_HINT},
+		{"NULLS", NULLS_SYM},
+		{"REPLICATE_IGNORE_TABLE", REPLICATE_IGNORE_TABLE},
+		{"UNDO_BUFFER_SIZE", UNDO_BUFFER_SIZE_SYM},
+		{"SQL_BUFFER_RESULT", SQL_BUFFER_RESULT},
+		{"SESSION", SESSION_SYM},
+		{"RESTRICT", RESTRICT},
+		{"NO_SKIP_SCAN", NO_SKIP_SCAN_HINT},
+		{"MYSQL_ERRNO", MYSQL_ERRNO_SYM},
+		{"STRAIGHT_JOIN", STRAIGHT_JOIN},
+		{"COMPONENT", COMPONENT_SYM},
+		{"COLUMN", COLUMN_SYM},
+		{"DETERMINISTIC", DETERMINISTIC_SYM},
+		{"COMMITTED", COMMITTED_SYM},
+		{"MUTEX", MUTEX_SYM},
+		{"LATERAL", LATERAL_SYM},
+		{"DEALLOCATE", DEALLOCATE_SYM},
+		{"REPLICATE_REWRITE_DB", REPLICATE_REWRITE_DB},
+		{"<=", LE},
+		{"CONTINUE", CONTINUE_SYM},
+		{"CIPHER", CIPHER_SYM},
+		{"MERGE", MERGE_SYM},
+		{"PERCENT_RANK", PERCENT_RANK_SYM},
+		{"INTERVAL", INTERVAL_SYM},
+		{"RESUME", RESUME_SYM},
+		{"LONGBLOB", LONGBLOB_SYM},
+		{"STDDEV", STD_SYM},
+		{"SELECT", SELECT_SYM},
+		{"EXPIRE", EXPIRE_SYM},
+		{"REFERENCES", REFERENCES},
+		{"RELAY", RELAY},
+		{"PATH", PATH_SYM},
+		{"LOCKED", LOCKED_SYM},
+		{"RETURNS", RETURNS_SYM},
+		{"PRIVILEGES", PRIVILEGES},
+		{"REFERENCE", REFERENCE_SYM},
+		{"REQUIRE_TABLE_PRIMARY_KEY_CHECK", REQUIRE_TABLE_PRIMARY_KEY_CHECK_SYM},
+		{"OLD", OLD_SYM},
+		{"VARIABLES", VARIABLES},
+		{"CHALLENGE_RESPONSE", CHALLENGE_RESPONSE_SYM},
+		{"NODEGROUP", NODEGROUP_SYM},
+		{"VAR_POP", VARIANCE_SYM},
+		{"STRING", STRING_SYM},
+		{"RELAY_LOG_POS", RELAY_LOG_POS_SYM},
+		{"OVER", OVER_SYM},
+		{"MODE", MODE_SYM},
+		{"LEAD", LEAD_SYM},
+		{"SQLSTATE", SQLSTATE_SYM},
+		{"SECURITY", SECURITY_SYM},
+		{"LEADING", LEADING},
+		{"POINT", POINT_SYM},
+		{"DISCARD", DISCARD_SYM},
+		{"SQL_BEFORE_GTIDS", SQL_BEFORE_GTIDS},
+		{"MIN_ROWS", MIN_ROWS},
+		{"&&", AND_AND_SYM},
+		{"INT1", TINYINT_SYM},
+		{"NTH_VALUE", NTH_VALUE_SYM},
+		{"NO_WRITE_TO_BINLOG", NO_WRITE_TO_BINLOG},
+		{"SERIAL", SERIAL_SYM},
+		{"EXTRACT", EXTRACT_SYM},
+		{"OPTIONALLY", OPTIONALLY},
+		{"SOURCE_PASSWORD", SOURCE_PASSWORD_SYM},
+		{"DAY_MINUTE", DAY_MINUTE_SYM},
+		{"ROUTINE", ROUTINE_SYM},
+		{"CONSISTENT", CONSISTENT_SYM},
+		{"ONLY", ONLY_SYM},
+		{"RELAY_LOG_FILE", RELAY_LOG_FILE_SYM},
+		{"SKIP_SCAN", SKIP_SCAN_HINT},
+		{"SIMPLE", SIMPLE_SYM},
+		{"READS", READS_SYM},
+		{"FORCE", FORCE_SYM},
+		{"ISOLATION", ISOLATION},
+		{"COLLATE", COLLATE_SYM},
+		{"AUTO_INCREMENT", AUTO_INC},
+		{"AUTO", AUTO_SYM},
+		{"NEW", NEW_SYM},
+		{"SUBQUERY", SUBQUERY_HINT},
+		{"EXCHANGE", EXCHANGE_SYM},
+		{"AS", AS},
+		{"FIELDS", COLUMNS},
+		{"OWNER", OWNER_SYM},
+		{"UNICODE", UNICODE_SYM},
+		{"EXIT", EXIT_SYM},
+		{"LEVEL", LEVEL_SYM},
+		{"DISABLE", DISABLE_SYM},
+		{"USAGE", USAGE},
+		{"SQL_BIG_RESULT", SQL_BIG_RESULT},
+		{"PERSIST_ONLY", PERSIST_ONLY_SYM},
+		{"HOUR_MINUTE", HOUR_MINUTE_SYM},
+		{"LOG", LOG_SYM},
+		{"RESPECT", RESPECT_SYM},
+		{"TABLESPACE", TABLESPACE_SYM},
+		{"DATE", DATE_SYM},
+		{"SOCKET", SOCKET_SYM},
+		{"REPLICATE_IGNORE_DB", REPLICATE_IGNORE_DB},
+		{"INFILE", INFILE_SYM},
+		{"NO_DERIVED_CONDITION_PUSHDOWN", NO_DERIVED_CONDITION_PUSHDOWN_HINT},
+		{"CURRENT_DATE", CURDATE},
+		{"SUBSTRING", SUBSTRING},
+		{"RANK", RANK_SYM},
+		{"CALL", CALL_SYM},
+		{"KEY", KEY_SYM},
+		{"ROW_NUMBER", ROW_NUMBER_SYM},
+		{"CUME_DIST", CUME_DIST_SYM},
+		{"PROCESSLIST", PROCESSLIST_SYM},
+		{"RESOURCE_GROUP", RESOURCE_GROUP_HINT},
+		{"INTERSECT", INTERSECT_SYM},
+		{"SQL_TSI_YEAR", YEAR_SYM},
+		{"RELAY_THREAD", RELAY_THREAD},
+		{"INT3", MEDIUMINT_SYM},
+		{"REPLICATE_DO_DB", REPLICATE_DO_DB},
+		{"RECURSIVE", RECURSIVE_SYM},
+		{"INACTIVE", INACTIVE_SYM},
+		{"DELAY_KEY_WRITE", DELAY_KEY_WRITE_SYM},
+		{"SOURCE_SSL_CERT", SOURCE_SSL_CERT_SYM},
+		{"SOURCE_CONNECT_RETRY", SOURCE_CONNECT_RETRY_SYM},
+		{"DYNAMIC", DYNAMIC_SYM},
+		{"OUTFILE", OUTFILE},
+		{"MASTER", MASTER_SYM},
+		{"REPLICATE_DO_TABLE", REPLICATE_DO_TABLE},
+		{"THAN", THAN_SYM},
+		{"REPLACE", REPLACE_SYM},
+		{"VALUE", VALUE_SYM},
+		{"CASCADED", CASCADED},
+		{"COMMENT", COMMENT_SYM},
+		{"NO_HASH_JOIN", NO_HASH_JOIN_HINT},
+		{"DEFAULT_AUTH", DEFAULT_AUTH_SYM},
+		{"HOUR_SECOND", HOUR_SECOND_SYM},
+		{"MULTILINESTRING", MULTILINESTRING_SYM},
+		{"NOW", NOW_SYM},
+		{"NO", NO_SYM},
+		{"CURSOR_NAME", CURSOR_NAME_SYM},
+		{"LOCAL", LOCAL_SYM},
+		{"ORDER", ORDER_SYM},
+		{"ESCAPE", ESCAPE_SYM},
+		{"SECONDARY_ENGINE", SECONDARY_ENGINE_SYM},
+		{"SCHEMAS", DATABASES},
+		{"UNLOCK", UNLOCK_SYM},
+		{"FILTER", FILTER_SYM},
+		{"PARTITION", PARTITION_SYM},
+		{"SUBPARTITIONS", SUBPARTITIONS_SYM},
+		{"INNER", INNER_SYM},
+		{"MAX", MAX_SYM},
+		{"JOIN_FIXED_ORDER", JOIN_FIXED_ORDER_HINT},
+		{"CONTEXT", CONTEXT_SYM},
+		{"ENGINE_ATTRIBUTE", ENGINE_ATTRIBUTE_SYM},
+		{"DERIVED_CONDITION_PUSHDOWN", DERIVED_CONDITION_PUSHDOWN_HINT},
+		{"SOURCE_ZSTD_COMPRESSION_LEVEL", SOURCE_ZSTD_COMPRESSION_LEVEL_SYM},
+		{"PLUGIN", PLUGIN_SYM},
+		{"MOD", MOD_SYM},
+		{"CHARACTER", CHAR_SYM},
+		{"SQL_TSI_SECOND", SECOND_SYM},
+		{"TRIGGER", TRIGGER_SYM},
+		{"AT", AT_SYM},
+		{"VAR_SAMP", VAR_SAMP_SYM},
+		{"SEPARATOR", SEPARATOR_SYM},
+		{"OPTIONS", OPTIONS_SYM},
+		{"DESCRIBE", DESCRIBE},
+		{"TABLESAMPLE", TABLESAMPLE_SYM},
+		{"XA", XA_SYM},
+		{"INTO", INTO},
+		{"HAVING", HAVING},
+		{"MAX_QUERIES_PER_HOUR", MAX_QUERIES_PER_HOUR},
+		{"FLUSH", FLUSH_SYM},
+		{"MINUTE_MICROSECOND", MINUTE_MICROSECOND_SYM},
+		{"CHANGE", CHANGE},
+		{"JSON_TABLE", JSON_TABLE_SYM},
+		{"SOURCE_USER", SOURCE_USER_SYM},
+		{"PAGE", PAGE_SYM},
+		{">>", SHIFT_RIGHT},
+		{"IO_BEFORE_GTIDS", IO_BEFORE_GTIDS},
+		{"FILE_BLOCK_SIZE", FILE_BLOCK_SIZE_SYM},
+		{"ARRAY", ARRAY_SYM},
+		{"CUBE", CUBE_SYM},
+		{"ERROR", ERROR_SYM},
+		{"DESCRIPTION", DESCRIPTION_SYM},
+		{"CLOSE", CLOSE_SYM},
+		{"DAY", DAY_SYM},
+		{"SOURCE_SSL_KEY", SOURCE_SSL_KEY_SYM},
+		{"IMPORT", IMPORT},
+		{"REPLICA", REPLICA_SYM},
+		{"QUALIFY", QUALIFY_SYM},
+		{"TRUNCATE", TRUNCATE_SYM},
+		{"OPTIONAL", OPTIONAL_SYM},
+		{"SOURCE_TLS_VERSION", SOURCE_TLS_VERSION_SYM},
+		{"REBUILD", REBUILD_SYM},
+		{"UNIQUE", UNIQUE_SYM},
+		{"CHANNEL", CHANNEL_SYM},
+		{"ISSUER", ISSUER_SYM},
+		{"AUTHENTICATION", AUTHENTICATION_SYM},
+		{"SCHEMA", DATABASE},
+		{"ALWAYS", ALWAYS_SYM},
+		{"CURRENT_TIMESTAMP", NOW_SYM},
+		{"SRID", SRID_SYM},
+		{"LOGS", LOGS_SYM},
+		{"LOGFILE", LOGFILE_SYM},
+		{"ROLLBACK", ROLLBACK_SYM},
+		{"SQL_AFTER_MTS_GAPS", SQL_AFTER_MTS_GAPS},
+		{"UTC_DATE", UTC_DATE_SYM},
+		{"FALSE", FALSE_SYM},
+		{"SECOND", SECOND_SYM},
+		{"SCHEMA_NAME", SCHEMA_NAME_SYM},
+		{"TIME", TIME_SYM},
+		{"CHAIN", CHAIN_SYM},
+		{"DIAGNOSTICS", DIAGNOSTICS_SYM},
+		{"AGGREGATE", AGGREGATE_SYM},
+		{"GTIDS", GTIDS_SYM},
+		{"DATETIME", DATETIME_SYM},
+		{"SOURCE_SSL_CAPATH", SOURCE_SSL_CAPATH_SYM},
+		{"SECONDARY_ENGINE_ATTRIBUTE", SECONDARY_ENGINE_ATTRIBUTE_SYM},
+		{"HASH", HASH_SYM},
+		{"SOME", ANY_SYM},
+		{"USE", USE_SYM},
+		{"GET", GET_SYM},
+		{"RESTORE", RESTORE_SYM},
+		{"RANDOM", RANDOM_SYM},
+		{"TINYINT", TINYINT_SYM},
+		{"GEOMETRYCOLLECTION", GEOMETRYCOLLECTION_SYM},
+		{"CONVERT", CONVERT_SYM},
+		{"IN", IN_SYM},
+		{"LOCALTIME", NOW_SYM},
+		{"CONNECTION", CONNECTION_SYM},
+		{"RLIKE", REGEXP},
+		{"CLIENT", CLIENT_SYM},
+		{"ATTRIBUTE", ATTRIBUTE_SYM},
+		{"VARCHARACTER", VARCHAR_SYM},
+		{"PREV", PREV_SYM},
+		{"PROXY", PROXY_SYM},
+		{"RTREE", RTREE_SYM},
+		{"RECOVER", RECOVER_SYM},
+		{"SCHEDULE", SCHEDULE_SYM},
+		{"NONE", NONE_SYM},
+		{"GROUPING", GROUPING_SYM},
+		{"SQL_CALC_FOUND_ROWS", SQL_CALC_FOUND_ROWS},
+		{"INITIAL", INITIAL_SYM},
+		{"LOCKS", LOCKS_SYM},
+		{"CURDATE", CURDATE},
+		{"LAG", LAG_SYM},
+		{"INT2", SMALLINT_SYM},
+		{"MEDIUMBLOB", MEDIUMBLOB_SYM},
+		{"DO", DO_SYM},
+		{"FOLLOWS", FOLLOWS_SYM},
+		{"!=", NE},
+		{"TRAILING", TRAILING},
+		{"UNREGISTER", UNREGISTER_SYM},
+		{"NO_BNL", NO_BNL_HINT},
+		{"RELAYLOG", RELAYLOG_SYM},
+		{"IGNORE_SERVER_IDS", IGNORE_SERVER_IDS_SYM},
+		{"DATA", DATA_SYM},
+		{"LAST", LAST_SYM},
+		{"OUT", OUT_SYM},
+		{"MINUTE_SECOND", MINUTE_SECOND_SYM},
+		{"DEFINITION", DEFINITION_SYM},
+		{"REPAIR", REPAIR},
+		{"ROW_COUNT", ROW_COUNT_SYM},
+		{"COMPRESSION", COMPRESSION_SYM},
+		{"DELAYED", DELAYED_SYM},
+		{"BY", BY},
+		{"CONSTRAINT_CATALOG", CONSTRAINT_CATALOG_SYM},
+		{"SAVEPOINT", SAVEPOINT_SYM},
+		{"ENGINES", ENGINES_SYM},
+		{"LINES", LINES},
+		{"DEFAULT", DEFAULT_SYM},
+		{"<=>", EQUAL_SYM},
+		{"TERMINATED", TERMINATED},
+		{"SOURCE_BIND", SOURCE_BIND_SYM},
+		{"BYTE", BYTE_SYM},
+		{"HISTORY", HISTORY_SYM},
+		{"NVARCHAR", NVARCHAR_SYM},
+		{"FETCH", FETCH_SYM},
+		{"QUERY", QUERY_SYM},
+		{"SYSTEM_USER", USER},
+		{"ENCLOSED", ENCLOSED},
+		{"GROUP_REPLICATION", GROUP_REPLICATION},
+		{"READ_ONLY", READ_ONLY_SYM},
+		{"LOCK", LOCK_SYM},
+		{"DIV", DIV_SYM},
+		{"OPTION", OPTION},
+		{"KEY_BLOCK_SIZE", KEY_BLOCK_SIZE},
+		{"MONTH", MONTH_SYM},
+		{"INITIATE", INITIATE_SYM},
+		{"MAXVALUE", MAX_VALUE_SYM},
+		{"REVOKE", REVOKE},
+		{"TIMESTAMPADD", TIMESTAMP_ADD},
+		{"EACH", EACH_SYM},
+		{"CHECK", CHECK_SYM},
+		{"SOURCE_SSL_CRLPATH", SOURCE_SSL_CRLPATH_SYM},
+		{"NAME", NAME_SYM},
+		{"FIRST_VALUE", FIRST_VALUE_SYM},
+		{"CHECKSUM", CHECKSUM_SYM},
+		{"TRANSACTION", TRANSACTION_SYM},
+		{"SQLEXCEPTION", SQLEXCEPTION_SYM},
+		{"PURGE", PURGE},
+		{"SPATIAL", SPATIAL_SYM},
+		{"AGAINST", AGAINST},
+		{"<>", NE},
+		{"COMPRESSED", COMPRESSED_SYM},
+		{"<", LT},
+		{"AVG_ROW_LENGTH", AVG_ROW_LENGTH},
+		{"PROFILE", PROFILE_SYM},
+		{"ADD", ADD},
+		{"MULTIPOLYGON", MULTIPOLYGON_SYM},
+		{"CURRENT", CURRENT_SYM},
+		{"MAX_ROWS", MAX_ROWS},
+		{"AFTER", AFTER_SYM},
+		{"OFFSET", OFFSET_SYM},
+		{"REPLICATE_WILD_IGNORE_TABLE", REPLICATE_WILD_IGNORE_TABLE},
+		{"FLOAT8", DOUBLE_SYM},
+		{"MODIFY", MODIFY_SYM},
+		{"NO_INDEX", NO_INDEX_HINT},
+		{"BKA", BKA_HINT},
+		{"UNION", UNION_SYM},
+		{"PARSER", PARSER_SYM},
+		{"LEAVES", LEAVES},
+		{"RETURNING", RETURNING_SYM},
+		{"SOURCE_SSL_CRL", SOURCE_SSL_CRL_SYM},
+		{"NAMES", NAMES_SYM},
+		{"BERNOULLI", BERNOULLI_SYM},
+		{"INT4", INT_SYM},
+		{"GRANT", GRANT},
+		{"DISTINCT", DISTINCT},
+		{"JOIN_ORDER", JOIN_ORDER_HINT},
+		{"INDEXES", INDEXES},
+		{"LIKE", LIKE},
+		{"MID", SUBSTRING},
+		{"BOTH", BOTH},
+		{"SQL_TSI_MONTH", MONTH_SYM},
+		{"GET_SOURCE_PUBLIC_KEY", GET_SOURCE_PUBLIC_KEY_SYM},
+		{"SOURCE_HEARTBEAT_PERIOD", SOURCE_HEARTBEAT_PERIOD_SYM},
+		{"REPLICATE_WILD_DO_TABLE", REPLICATE_WILD_DO_TABLE},
+		{"DESC", DESC},
+		{"CONSTRAINT_SCHEMA", CONSTRAINT_SCHEMA_SYM},
+		{"SOURCE_LOG_FILE", SOURCE_LOG_FILE_SYM},
+		{"NO_INDEX_MERGE", NO_INDEX_MERGE_HINT},
+		{"SOURCE_DELAY", SOURCE_DELAY_SYM},
+		{"DATAFILE", DATAFILE_SYM},
+		{"ANY", ANY_SYM},
+		{"START", START_SYM},
+		{"NO_WAIT", NO_WAIT_SYM},
+		{"BINARY", BINARY_SYM},
+		{"LOCALTIMESTAMP", NOW_SYM},
+		{"OFF", OFF_SYM},
+		{"RETURNED_SQLSTATE", RETURNED_SQLSTATE_SYM},
+		{"INSERT_METHOD", INSERT_METHOD},
+		{"EXCEPT", EXCEPT_SYM},
+		{"<<", SHIFT_LEFT},
+		{"EXPANSION", EXPANSION_SYM},
+		{"SLOW", SLOW},
+		{"LANGUAGE", LANGUAGE_SYM},
+		{"ANALYZE", ANALYZE_SYM},
+		{"RESET", RESET_SYM},
+		{"NCHAR", NCHAR_SYM},
+		{"RENAME", RENAME},
+		{"TEMPTABLE", TEMPTABLE_SYM},
+		{"NUMERIC", NUMERIC_SYM},
+		{"REDUNDANT", REDUNDANT_SYM},
+		{"SIGNAL", SIGNAL_SYM},
+		{"HISTOGRAM", HISTOGRAM_SYM},
+		{"ADDDATE", ADDDATE_SYM},
+		{"SQL_AFTER_GTIDS", SQL_AFTER_GTIDS},
+		{"EXPORT", EXPORT_SYM},
+		{"PRESERVE", PRESERVE_SYM},
+		{"CPU", CPU_SYM},
+		{"EXTENT_SIZE", EXTENT_SIZE_SYM},
+		{"REVERSE", REVERSE_SYM},
+		{"PRIMARY", PRIMARY_SYM},
+		{"BACKUP", BACKUP_SYM},
+		{"FAILED_LOGIN_ATTEMPTS", FAILED_LOGIN_ATTEMPTS_SYM},
+		{"TYPE", TYPE_SYM},
+		{"FIRSTMATCH", FIRSTMATCH_HINT},
+		{"NO_RANGE_OPTIMIZATION", NO_RANGE_OPTIMIZATION_HINT},
+		{"DENSE_RANK", DENSE_RANK_SYM},
+		{"ENFORCED", ENFORCED_SYM},
+		{"OPTIMIZE", OPTIMIZE},
+		{"REAL", REAL_SYM},
+		{"SOURCE_SSL", SOURCE_SSL_SYM},
+		{"PRECISION", PRECISION},
+		{"EVERY", EVERY_SYM},
+		{"INSERT", INSERT_SYM},
+		{"ALGORITHM", ALGORITHM_SYM},
+		{"PLUGINS", PLUGINS_SYM},
+		{"DUAL", DUAL_SYM},
+		{"AND", AND_SYM},
+		{"XID", XID_SYM},
+		{"VARBINARY", VARBINARY_SYM},
+		{"MEMORY", MEMORY_SYM},
+		{"DEFINER", DEFINER_SYM},
+		{"BOOL", BOOL_SYM},
+		{"X509", X509_SYM},
+		{"FOREIGN", FOREIGN},
+		{"ZEROFILL", ZEROFILL_SYM},
+		{"SET_VAR", SET_VAR_HINT},
+		{"STREAM", STREAM_SYM},
+		{"WRITE", WRITE_SYM},
+		{"SYSDATE", SYSDATE},
+		{"PROCEDURE", PROCEDURE_SYM},
+		{"IO_THREAD", RELAY_THREAD},
+		{"VISIBLE", VISIBLE_SYM},
+		{"WRAPPER", WRAPPER_SYM},
+		{"FILE", FILE_SYM},
+		{"ENDS", ENDS_SYM},
+		{"SQLWARNING", SQLWARNING_SYM},
+		{"IO_AFTER_GTIDS", IO_AFTER_GTIDS},
+		{"BUCKETS", BUCKETS_SYM},
+		{"RETAIN", RETAIN_SYM},
+		{"ACTION", ACTION},
+		{"ROTATE", ROTATE_SYM},
+		{"FUNCTION", FUNCTION_SYM},
+		{"THEN", THEN_SYM},
+		{"INVISIBLE", INVISIBLE_SYM},
+		{"WINDOW", WINDOW_SYM},
+		{"IPC", IPC_SYM},
+		{"GENERATE", GENERATE_SYM},
+		{"REPEATABLE", REPEATABLE_SYM},
+		{"FLOAT", FLOAT_SYM},
+		{"CURRENT_TIME", CURTIME},
+		{"TABLE_NAME", TABLE_NAME_SYM},
+		{"KEYS", KEYS},
+		{"NETWORK_NAMESPACE", NETWORK_NAMESPACE_SYM},
+		{"LONG", LONG_SYM},
+		{"DROP", DROP},
+		{"SQL_TSI_HOUR", HOUR_SYM},
+		{"SIGNED", SIGNED_SYM},
+		{"ACCOUNT", ACCOUNT_SYM},
+		{"USE_FRM", USE_FRM},
+		{"READ_WRITE", READ_WRITE_SYM},
+		{"SYSTEM", SYSTEM_SYM},
+		{"GET_FORMAT", GET_FORMAT},
+		{"REPLICATION", REPLICATION},
+		{"GROUPS", GROUPS_SYM},
+		{"SESSION_USER", USER},
+		{"HOST", HOST_SYM},
+		{"SOURCE_SSL_CA", SOURCE_SSL_CA_SYM},
+		{"JOIN_PREFIX", JOIN_PREFIX_HINT},
+		{"PARTITIONS", PARTITIONS_SYM},
+		{"DIRECTORY", DIRECTORY_SYM},
+		{"WAIT", WAIT_SYM},
+		{"CHAR", CHAR_SYM},
+		{"EXECUTE", EXECUTE_SYM},
+		{"MEDIUMINT", MEDIUMINT_SYM},
+		{"EVENTS", EVENTS_SYM},
+		{"USING", USING},
+		{"HANDLER", HANDLER_SYM},
+		{"SPECIFIC", SPECIFIC_SYM},
+		{"ESCAPED", ESCAPED},
+		{"KEYRING", KEYRING_SYM},
+		{"GLOBAL", GLOBAL_SYM},
+		{"IGNORE", IGNORE_SYM},
+		{"FOUND", FOUND_SYM},
+		{"NOWAIT", NOWAIT_SYM},
+		{"IS", IS},
+		{"NO_ORDER_INDEX", NO_ORDER_INDEX_HINT},
+		{"SUBCLASS_ORIGIN", SUBCLASS_ORIGIN_SYM},
+		{"TRIGGERS", TRIGGERS_SYM},
+		{"PERSIST", PERSIST_SYM},
+		{"FULLTEXT", FULLTEXT_SYM},
+		{"UTC_TIMESTAMP", UTC_TIMESTAMP_SYM},
+		{"CLONE", CLONE_SYM},
+		{"ERRORS", ERRORS},
+		{"ON", ON_SYM},
+		{"MIDDLEINT", MEDIUMINT_SYM},
+		{"DAY_MICROSECOND", DAY_MICROSECOND_SYM},
+		{"SUBSTR", SUBSTRING},
+		{"STATUS", STATUS_SYM},
+		{"REPEAT", REPEAT_SYM},
+		{"MODIFIES", MODIFIES_SYM},
+		{"CAST", CAST_SYM},
+		{"DISTINCTROW", DISTINCT},
+		{"NDBCLUSTER", NDBCLUSTER_SYM},
+		{"GENERAL", GENERAL},
+		{"REUSE", REUSE_SYM},
+		{"BEGIN", BEGIN_SYM},
+		{"EVENT", EVENT_SYM},
+		{"YEAR_MONTH", YEAR_MONTH_SYM},
+		{"PREPARE", PREPARE_SYM},
+		{"FOLLOWING", FOLLOWING_SYM},
+		{"QB_NAME", QB_NAME_HINT},
+		{"SHOW", SHOW},
+		{"ROWS", ROWS_SYM},
+		{"LIST", LIST_SYM},
+		{"CHANGED", CHANGED},
+		{"OJ", OJ_SYM},
+		{"ENGINE", ENGINE_SYM},
+		{"WHEN", WHEN_SYM},
+		{"ACCESSIBLE", ACCESSIBLE_SYM},
+		{"MAX_USER_CONNECTIONS", MAX_USER_CONNECTIONS_SYM},
+		{"JOIN", JOIN_SYM},
+		{"SUM", SUM_SYM},
+		{"ROW_FORMAT", ROW_FORMAT_SYM},
+		{"CONTAINS", CONTAINS_SYM},
+		{"PORT", PORT_SYM},
+		{"SET", SET_SYM},
+		{"DATABASE", DATABASE},
+		{"MAX_EXECUTION_TIME", MAX_EXECUTION_TIME_HINT},
+		{"NESTED", NESTED_SYM},
+		{"HELP", HELP_SYM},
+		{"RANGE", RANGE_SYM},
+		{"FORMAT", FORMAT_SYM},
+		{"LEFT", LEFT},
+		{"GRANTS", GRANTS},
+		{"CROSS", CROSS},
+		{"SQL_TSI_DAY", DAY_SYM},
+		{"ASENSITIVE", ASENSITIVE_SYM},
+		{"GEOMCOLLECTION", GEOMETRYCOLLECTION_SYM},
+		{"COLUMN_NAME", COLUMN_NAME_SYM},
+		{"STACKED", STACKED_SYM},
+		{"UNDOFILE", UNDOFILE_SYM},
+		{"LESS", LESS_SYM},
+		{"TABLE", TABLE_SYM},
+		{"PARTIAL", PARTIAL},
+		{"ENABLE", ENABLE_SYM},
+		{"MEDIUMTEXT", MEDIUMTEXT_SYM},
+		{"BIT_OR", BIT_OR_SYM},
+		{"SQL", SQL_SYM},
+		{"UNINSTALL", UNINSTALL_SYM},
+		{"MAX_UPDATES_PER_HOUR", MAX_UPDATES_PER_HOUR},
+		{"SHARE", SHARE_SYM},
+		{"ENCRYPTION", ENCRYPTION_SYM},
+		{"WHERE", WHERE},
+		{"VARIANCE", VARIANCE_SYM},
+		{"BIT_AND", BIT_AND_SYM},
+		{"COLUMN_FORMAT", COLUMN_FORMAT_SYM},
+		{">", GT_SYM},
+		{"DATABASES", DATABASES},
+		{"REPLICAS", REPLICAS_SYM},
+		{"CONDITION", CONDITION_SYM},
+		{"WORK", WORK_SYM},
+		{"INOUT", INOUT_SYM},
+		{"SOURCE_PUBLIC_KEY_PATH", SOURCE_PUBLIC_KEY_PATH_SYM},
+		{"ASC", ASC},
+		{"SOURCE", SOURCE_SYM},
+		{"BTREE", BTREE_SYM},
+		{"SOURCE_SSL_VERIFY_SERVER_CERT", SOURCE_SSL_VERIFY_SERVER_CERT_SYM},
+		{"UNTIL", UNTIL_SYM},
+		{"ACTIVE", ACTIVE_SYM},
+		{"TEXT", TEXT_SYM},
+		{"UPDATE", UPDATE_SYM},
+		{"SUPER", SUPER_SYM},
+		{"REGISTRATION", REGISTRATION_SYM},
+		{"UNKNOWN", UNKNOWN_SYM},
+		{"ALTER", ALTER},
+		{"HOSTS", HOSTS_SYM},
+		{"INSTANCE", INSTANCE_SYM},
+		{"STOP", STOP_SYM},
+		{"QUICK", QUICK},
+		{"JSON_ARRAYAGG", JSON_ARRAYAGG},
+		{"MINUTE", MINUTE_SYM},
+		{"COMMIT", COMMIT_SYM},
+		{"LEAVE", LEAVE_SYM},
+		{"PRECEDES", PRECEDES_SYM},
+		{"MAX_CONNECTIONS_PER_HOUR", MAX_CONNECTIONS_PER_HOUR},
+		{"ORDINALITY", ORDINALITY_SYM},
+		{"NOT", NOT_SYM},
+		{"ELSEIF", ELSEIF_SYM},
+		{"WITHOUT", WITHOUT_SYM},
+		{"SERVER", SERVER_SYM},
+		{"CONSTRAINT_NAME", CONSTRAINT_NAME_SYM},
+		{"WITH", WITH},
+		{"PLUGIN_DIR", PLUGIN_DIR_SYM},
+		{">=", GE},
+		{"FULL", FULL},
+		{"INT8", BIGINT_SYM},
+		{"NO_MRR", NO_MRR_HINT},
+		{"COMPLETION", COMPLETION_SYM},
+		{"SQL_TSI_WEEK", WEEK_SYM},
+		{"STATS_PERSISTENT", STATS_PERSISTENT_SYM},
+		{"BIT", BIT_SYM},
+		{"SUBJECT", SUBJECT_SYM},
+		{"RELEASE", RELEASE_SYM},
+		{"CATALOG_NAME", CATALOG_NAME_SYM},
+		{"RELOAD", RELOAD},
+		{"BINLOG", BINLOG_SYM},
+		{"SOURCE_CONNECTION_AUTO_FAILOVER", SOURCE_CONNECTION_AUTO_FAILOVER_SYM},
+		{"MEDIUM", MEDIUM_SYM},
+		{"CASCADE", CASCADE},
+		{"MICROSECOND", MICROSECOND_SYM},
+		{"CURRENT_USER", CURRENT_USER},
+		{"RIGHT", RIGHT},
+		{"INTEGER", INT_SYM},
+		{"MESSAGE_TEXT", MESSAGE_TEXT_SYM},
+		{"INTOEXISTS", INTOEXISTS_HINT},
+		{"TYPES", TYPES_SYM},
+		{"SOURCE_TLS_CIPHERSUITES", SOURCE_TLS_CIPHERSUITES_SYM},
+		{"REGEXP", REGEXP},
+		{"UNBOUNDED", UNBOUNDED_SYM},
+		{"LOAD", LOAD},
+		{"SSL", SSL_SYM},
+		{"GEOMETRY", GEOMETRY_SYM},
+		{"DATE_SUB", DATE_SUB_INTERVAL},
+		{"YEAR", YEAR_SYM},
+		{"READ", READ_SYM},
+		{"OTHERS", OTHERS_SYM},
+		{"VALUES", VALUES},
+		{"SOURCE_LOG_POS", SOURCE_LOG_POS_SYM},
+		{"HIGH_PRIORITY", HIGH_PRIORITY},
+		{"LIMIT", LIMIT},
+		{"COLLATION", COLLATION_SYM},
+		{"SOURCE_RETRY_COUNT", SOURCE_RETRY_COUNT_SYM},
+		{"MIN", MIN_SYM},
+		{"STD", STD_SYM},
+		{"SQL_TSI_MINUTE", MINUTE_SYM},
+		{"TIMESTAMP", TIMESTAMP_SYM},
+		{"SONAME", SONAME_SYM},
+		{"GENERATED", GENERATED},
+		{"FAST", FAST_SYM},
+		{"FIRST", FIRST_SYM},
+		{"BOOLEAN", BOOLEAN_SYM},
+		{"USER", USER},
+		{"PROFILES", PROFILES_SYM},
+		{"RESTART", RESTART_SYM},
+		{"SQL_THREAD", SQL_THREAD},
+		{"CONCURRENT", CONCURRENT},
+		{"CASE", CASE_SYM},
+		{"SEMIJOIN", SEMIJOIN_HINT},
+		{"ORDER_INDEX", ORDER_INDEX_HINT},
+		{"VIRTUAL", VIRTUAL_SYM},
+		{"IO", IO_SYM},
+		{"ZONE", ZONE_SYM},
+		{"COALESCE", COALESCE},
+		{"VCPU", VCPU_SYM},
+		{"TRUE", TRUE_SYM},
+		{"STARTING", STARTING},
+		{"RESIGNAL", RESIGNAL_SYM},
+		{"THREAD_PRIORITY", THREAD_PRIORITY_SYM},
+		{"TINYTEXT", TINYTEXT_SYN},
+		{"DUPLICATE", DUPLICATE_SYM},
+		{"REQUIRE", REQUIRE_SYM},
+		{"CHARSET", CHARSET},
+		{"PARSE_TREE", PARSE_TREE_SYM},
+		{"USER_RESOURCES", RESOURCES},
+		{"ROLE", ROLE_SYM},
+		{"GROUP_CONCAT", GROUP_CONCAT_SYM},
+		{"VIEW", VIEW_SYM},
+		{"INITIAL_SIZE", INITIAL_SIZE_SYM},
+		{"TIMESTAMPDIFF", TIMESTAMP_DIFF},
+		{"ALL", ALL},
+		{"BEFORE", BEFORE_SYM},
+		{"DELETE", DELETE_SYM},
+		{"SWAPS", SWAPS_SYM},
+		{"FOR", FOR_SYM},
+		{"LOW_PRIORITY", LOW_PRIORITY},
+		{"INDEX_MERGE", INDEX_MERGE_HINT},
+		{"DEC", DECIMAL_SYM},
+		{"NO_GROUP_INDEX", NO_GROUP_INDEX_HINT},
+		{"MIGRATE", MIGRATE_SYM},
+		{"BLOB", BLOB_SYM},
+		{"HOUR_MICROSECOND", HOUR_MICROSECOND_SYM},
+		{"TABLE_CHECKSUM", TABLE_CHECKSUM_SYM},
+		{"COLUMNS", COLUMNS},
+		{"KILL", KILL_SYM},
+		{"AVG", AVG_SYM},
+		{"SOURCE_AUTO_POSITION", SOURCE_AUTO_POSITION_SYM},
+		{"INSENSITIVE", INSENSITIVE_SYM},
+		{"JOIN_INDEX", JOIN_INDEX_HINT},
+		{"IDENTIFIED", IDENTIFIED_SYM},
+		{"SUSPEND", SUSPEND_SYM},
+		{"PARALLEL", PARALLEL_SYM},
+		{"MANUAL", MANUAL_SYM},
+		{"SQL_SMALL_RESULT", SQL_SMALL_RESULT},
+		{"EXCLUDE", EXCLUDE_SYM},
+		{"SECONDARY_LOAD", SECONDARY_LOAD_SYM},
+		{"NO_JOIN_INDEX", NO_JOIN_INDEX_HINT},
+		{"OR", OR_SYM},
+		{"LINEAR", LINEAR_SYM},
+		{"ADMIN", ADMIN_SYM},
+		{"COMPACT", COMPACT_SYM},
+		{"SWITCHES", SWITCHES_SYM},
+		{"BLOCK", BLOCK_SYM},
+		{"STDDEV_SAMP", STDDEV_SAMP_SYM},
+		{"OUTER", OUTER_SYM},
+		{"PACK_KEYS", PACK_KEYS_SYM},
+		{"BETWEEN", BETWEEN_SYM},
+		{"SHUTDOWN", SHUTDOWN},
+		{"DAY_HOUR", DAY_HOUR_SYM},
+		{"MULTIPOINT", MULTIPOINT_SYM},
+		{"MATERIALIZATION", MATERIALIZATION_HINT},
+		{"INSTALL", INSTALL_SYM},
+		{"COUNT", COUNT_SYM},
+		{"JSON_OBJECTAGG", JSON_OBJECTAGG},
+		{"DUMPFILE", DUMPFILE},
+		{"RETURN", RETURN_SYM},
+		{"HASH_JOIN", HASH_JOIN_HINT},
+		{"VARYING", VARYING},
+		{"TINYBLOB", TINYBLOB_SYM},
+		{"MATCH", MATCH},
+		{"WHILE", WHILE_SYM},
+		{"SKIP", SKIP_SYM},
+		{"STARTS", STARTS_SYM},
+		{"OPEN", OPEN_SYM},
+		{"SERIALIZABLE", SERIALIZABLE_SYM},
+		{"END", END},
+		{"INDEX", INDEX_SYM},
+		{"AUTOEXTEND_SIZE", AUTOEXTEND_SIZE_SYM},
+		{"STATS_SAMPLE_PAGES", STATS_SAMPLE_PAGES_SYM},
+		{"SUBPARTITION", SUBPARTITION_SYM},
+		{"CURSOR", CURSOR_SYM},
+		{"EXTENDED", EXTENDED_SYM},
+		{"LINESTRING", LINESTRING_SYM},
+		{"FINISH", FINISH_SYM},
+		{"JSON_VALUE", JSON_VALUE_SYM},
+		{"DAY_SECOND", DAY_SECOND_SYM},
+		{"SLAVE", SLAVE},
+		{"SQL_TSI_QUARTER", QUARTER_SYM},
+		{"CONSTRAINT", CONSTRAINT},
+		{"LOOSESCAN", LOOSESCAN_HINT},
+		{"REMOVE", REMOVE_SYM},
+		{"STDDEV_POP", STD_SYM},
+		{"TO", TO_SYM},
+		{"GROUP_INDEX", GROUP_INDEX_HINT},
+		{"FIXED", FIXED_SYM},
+		{"TABLES", TABLES},
+		{"NUMBER", NUMBER_SYM},
+		{"EXPLAIN", DESCRIBE},
+		{"NO_MERGE", NO_DERIVED_MERGE_HINT},
+		{"NTILE", NTILE_SYM},
+		{"REQUIRE_ROW_FORMAT", REQUIRE_ROW_FORMAT_SYM},
+		{"BULK", BULK_SYM},
+		{"NATURAL", NATURAL},
+		{"VALIDATION", VALIDATION_SYM},
+		{"||", OR_OR_SYM},
+		{"UNCOMMITTED", UNCOMMITTED_SYM},
+		{"SNAPSHOT", SNAPSHOT_SYM},
+		{"DOUBLE", DOUBLE_SYM},
+		{"CODE", CODE_SYM},
+		{"SOURCE_PORT", SOURCE_PORT_SYM},
+		{"PARTITIONING", PARTITIONING_SYM},
+		{"SOURCE_SSL_CIPHER", SOURCE_SSL_CIPHER_SYM},
+		{"XOR", XOR},
+		{"SOURCE_COMPRESSION_ALGORITHMS", SOURCE_COMPRESSION_ALGORITHM_SYM},
+		{"S3", S3_SYM},
+		{"FAULTS", FAULTS_SYM},
+		{"NEVER", NEVER_SYM},
+		{"REDO_BUFFER_SIZE", REDO_BUFFER_SIZE_SYM},
+		{"ITERATE", ITERATE_SYM},
+		{"QUARTER", QUARTER_SYM},
+		{"CLASS_ORIGIN", CLASS_ORIGIN_SYM},
+		{"SECONDARY", SECONDARY_SYM},
+		{"POSITION", POSITION_SYM},
+		{"URL", URL_SYM},
+		{"SOUNDS", SOUNDS_SYM},
+		{"UTC_TIME", UTC_TIME_SYM},
+		{"ASCII", ASCII_SYM},
+		{"NULL", NULL_SYM},
+		{"WEIGHT_STRING", WEIGHT_STRING_SYM},
+		{"FLOAT4", FLOAT_SYM},
+		{"HOUR", HOUR_SYM},
+		{"PASSWORD_LOCK_TIME", PASSWORD_LOCK_TIME_SYM},
+		{"SUBDATE", SUBDATE_SYM},
+		{"OF", OF_SYM},
+		{"LONGTEXT", LONGTEXT_SYM},
+		{"TLS", TLS_SYM},
+		{"ROLLUP", ROLLUP_SYM},
+		{"JOIN_SUFFIX", JOIN_SUFFIX_HINT},
+		{"INT", INT_SYM},
+		{"STORED", STORED_SYM},
+		{"TIES", TIES_SYM},
+		{"TEMPORARY", TEMPORARY},
+		{"BIT_XOR", BIT_XOR_SYM},
+		{"TRIM", TRIM},
+		{"PRIVILEGE_CHECKS_USER", PRIVILEGE_CHECKS_USER_SYM},
+		{"MEMBER", MEMBER_SYM},
+		{"NO_BKA", NO_BKA_HINT},
+		{"MRR", MRR_HINT},
+		{"ONE", ONE_SYM},
+		{"RESOURCE", RESOURCE_SYM},
+		{"ENUM", ENUM_SYM},
+		{"DISK", DISK_SYM},
+		{"DECIMAL", DECIMAL_SYM},
+		{"PHASE", PHASE_SYM},
+		{"UNSIGNED", UNSIGNED_SYM},
+		{"ROW", ROW_SYM},
+		{"NO_SEMIJOIN", NO_SEMIJOIN_HINT},
+		{"DECLARE", DECLARE_SYM},
+		{"VARCHAR", VARCHAR_SYM},
+		{"ST_COLLECT", ST_COLLECT_SYM},
+		{"NDB", NDBCLUSTER_SYM},
+		{"XML", XML_SYM},
+		{"=", EQ},
+		{"OPTIMIZER_COSTS", OPTIMIZER_COSTS_SYM},
+		{"EXISTS", EXISTS},
+		{"REORGANIZE", REORGANIZE_SYM},
+		{"GROUP", GROUP_SYM},
+		{"CURTIME", CURTIME},
+		{"SECOND_MICROSECOND", SECOND_MICROSECOND_SYM},
+	},
+}
+
+// keywordTable90 holds the MySQL 9.0 keywords.
+var keywordTable90 = &KeywordTable{
+	Seed:   0,
+	MaxLen: 38,
+	Displace: []uint32{
+		161, 78, 203, 33, 8, 82, 111, 3, 4, 55, 5, 45, 234, 1, 1, 1,
+		13, 1, 2, 177, 183, 4, 5, 104, 6, 93, 54, 7, 92, 4, 37, 54,
+		25, 6, 1, 9, 9, 24, 86, 35, 424, 202, 99, 14, 4, 1, 1, 6,
+		47, 18, 3, 27, 1, 48, 59, 5, 25, 34, 42, 183, 271, 1, 13, 3,
+		1, 26, 4, 105, 1, 5, 43, 73, 26, 2, 5, 230, 93, 2, 110, 8,
+		38, 113, 2, 189, 69, 89, 6, 3, 1, 14, 2, 8, 559, 161, 5, 20,
+		113, 196, 22, 15, 1, 2, 68, 154, 49, 35, 7, 44, 177, 185, 1, 5,
+		13, 890, 125, 3, 1, 73, 6, 10, 1, 82, 0, 7, 110, 522, 221, 21,
+		47, 139, 42, 110, 84, 16, 3, 333, 2, 29, 2, 792, 178, 284, 15, 8,
+		1, 168, 633, 267, 2, 2, 59, 116, 28, 961, 68, 361, 7, 69, 1, 122,
+		381, 59, 7, 40, 213, 116, 13, 659, 2, 16, 4, 114, 16, 16, 634, 401,
+		228, 130, 1, 92, 1422, 42, 5, 119, 1, 2, 625, 8, 734, 1, 195, 225,
+		205, 18, 105, 177, 381, 109, 1028, 187, 23, 148, 15, 967, 19, 2066, 691, 181,
+		64, 347, 2575, 149,
+	},
+	Entries: []KeywordEntry{
+		{"SUPER", SUPER_SYM},
+		{"ROW", ROW_SYM},
+		{"EVENTS", EVENTS_SYM},
+		{"MYSQL_ERRNO", MYSQL_ERRNO_SYM},
+		{"STARTS", STARTS_SYM},
+		{"NO_JOIN_INDEX", NO_JOIN_INDEX_HINT},
+		{"MEMBER", MEMBER_SYM},
+		{"JOIN_SUFFIX", JOIN_SUFFIX_HINT},
+		{"SUBDATE", SUBDATE_SYM},
+		{"INTERVAL", INTERVAL_SYM},
+		{"REPLICATE_DO_DB", REPLICATE_DO_DB},
+		{"DATE_ADD", DATE_ADD_INTERVAL},
+		{"DETERMINISTIC", DETERMINISTIC_SYM},
+		{"SKIP", SKIP_SYM},
+		{"DAY_MINUTE", DAY_MINUTE_SYM},
+		{"COALESCE", COALESCE},
+		{"ROTATE", ROTATE_SYM},
+		{"DESC", DESC},
+		{"SUBCLASS_ORIGIN", SUBCLASS_ORIGIN_SYM},
+		{"REVERSE", REVERSE_SYM},
+		{"RELEASE", RELEASE_SYM},
+		{"WRITE", WRITE_SYM},
+		{"FIRST", FIRST_SYM},
+		{"SQL_BIG_RESULT", SQL_BIG_RESULT},
+		{"INITIAL_SIZE", INITIAL_SIZE_SYM},
+		{"ABSENT", ABSENT_SYM},
+		{"ROUTINE", ROUTINE_SYM},
+		{"ASCII", ASCII_SYM},
+		{"ASC", ASC},
+		{"POLYGON", POLYGON_SYM},
+		{"LEVEL", LEVEL_SYM},
+		{"FORCE", FORCE_SYM},
+		{"VALUES", VALUES},
+		{"SECOND", SECOND_SYM},
+		{"MONTH", MONTH_SYM},
+		{"SQL_TSI_QUARTER", QUARTER_SYM},
+		{"MAX", MAX_SYM},
+		{"INT4", INT_SYM},
+		{"UNDO_BUFFER_SIZE", UNDO_BUFFER_SIZE_SYM},
+		{"QUALIFY", QUALIFY_SYM},
+		{"THAN", THAN_SYM},
+		{"BINARY", BINARY_SYM},
+		{"FILE_PREFIX", FILE_PREFIX_SYM},
+		{"RELAY", RELAY},
+		{"UNREGISTER", UNREGISTER_SYM},
+		{"TYPE", TYPE_SYM},
+		{"HIGH_PRIORITY", HIGH_PRIORITY},
+		{"BACKUP", BACKUP_SYM},
+		{"PROCEDURE", PROCEDURE_SYM},
+		{"COLUMNS", COLUMNS},
+		{"PARSE_TREE", PARSE_TREE_SYM},
+		{"PRESERVE", PRESERVE_SYM},
+		{"UNCOMMITTED", UNCOMMITTED_SYM},
+		{"TABLE", TABLE_SYM},
+		{"KEYRING", KEYRING_SYM},
+		{"RESOURCE_GROUP", RESOURCE_GROUP_HINT},
+		{"LOOSESCAN", LOOSESCAN_HINT},
+		{"LOCALTIME", NOW_SYM},
+		{"INITIATE", INITIATE_SYM},
+		{"MOD", MOD_SYM},
+		{"CURSOR", CURSOR_SYM},
+		{"FILE_FORMAT", FILE_FORMAT_SYM},
+		{"LONGTEXT", LONGTEXT_SYM},
+		{"ESCAPE", ESCAPE_SYM},
+		{"REPEATABLE", REPEATABLE_SYM},
+		{"DAY_HOUR", DAY_HOUR_SYM},
+		{"CASCADE", CASCADE},
+		{"TEMPORARY", TEMPORARY},
+		{"UNDEFINED", UNDEFINED_SYM},
+		{"IO_BEFORE_GTIDS", IO_BEFORE_GTIDS},
+		{"AVG_ROW_LENGTH", AVG_ROW_LENGTH},
+		{"RESET", RESET_SYM},
+		{"LINES", LINES},
+		{"NO_BKA", NO_BKA_HINT},
+		{"UNTIL", UNTIL_SYM},
+		{"SEPARATOR", SEPARATOR_SYM},
+		{"FOR", FOR_SYM},
+		{"RESTART", RESTART_SYM},
+		{"DISABLE", DISABLE_SYM},
+		{"ENGINES", ENGINES_SYM},
+		{"ZONE", ZONE_SYM},
+		{"THREAD_PRIORITY", THREAD_PRIORITY_SYM},
+		{"ENDS", ENDS_SYM},
+		{"NEVER", NEVER_SYM},
+		{"REQUIRE", REQUIRE_SYM},
+		{"NVARCHAR", NVARCHAR_SYM},
+		{"ALWAYS", ALWAYS_SYM},
+		{"NO_GROUP_INDEX", NO_GROUP_INDEX_HINT},
+		{"IO_THREAD", RELAY_THREAD},
+		{"AUTO_INCREMENT", AUTO_INC},
+		{"PATH", PATH_SYM},
+		{"MATERIALIZATION", MATERIALIZATION_HINT},
+		{"JOIN", JOIN_SYM},
+		{"OVER", OVER_SYM},
+		{"UNDOFILE", UNDOFILE_SYM},
+		{"EXTENT_SIZE", EXTENT_SIZE_SYM},
+		{"BERNOULLI", BERNOULLI_SYM},
+		{"HOSTS", HOSTS_SYM},
+		{"UTC_TIMESTAMP", UTC_TIMESTAMP_SYM},
+		{"UPGRADE", UPGRADE_SYM},
+		{"CASCADED", CASCADED},
+		{"PURGE", PURGE},
+		{"MODE", MODE_SYM},
+		{"VIRTUAL", VIRTUAL_SYM},
+		{"JSON_ARRAYAGG", JSON_ARRAYAGG},
+		{"COLLATE", COLLATE_SYM},
+		{"<>", NE},
+		{"AGAINST", AGAINST},
+		{"REPAIR", REPAIR},
+		{"SQL_TSI_YEAR", YEAR_SYM},
+		{"OF", OF_SYM},
+		{"NUMERIC", NUMERIC_SYM},
+		{"SOURCE_RETRY_COUNT", SOURCE_RETRY_COUNT_SYM},
+		{"PREPARE", PREPARE_SYM},
+		{"DECLARE", DECLARE_SYM},
+		{"FIRSTMATCH", FIRSTMATCH_HINT},
+		{"LAST_VALUE", LAST_VALUE_SYM},
+		{"INDEX_MERGE", INDEX_MERGE_HINT},
+		{"GROUP_INDEX", GROUP_INDEX_HINT},
+		{"FILE_NAME", FILE_NAME_SYM},
+		{"OPTIONALLY", OPTIONALLY},
+		{"STOP", STOP_SYM},
+		{"INT8", BIGINT_SYM},
+		{"NO_SEMIJOIN", NO_SEMIJOIN_HINT},
+		{"RTREE", RTREE_SYM},
+		{"PARTITION", PARTITION_SYM},
+		{"UNKNOWN", UNKNOWN_SYM},
+		{"OPTIMIZER_COSTS", OPTIMIZER_COSTS_SYM},
+		{"SQL_TSI_DAY", DAY_SYM},
+		{"ACCESSIBLE", ACCESSIBLE_SYM},
+		{"LOGS", LOGS_SYM},
+		{"REPLICATE_WILD_DO_TABLE", REPLICATE_WILD_DO_TABLE},
+		{"EXTRACT", EXTRACT_SYM},
+		{"COLUMN", COLUMN_SYM},
+		{"GENERATE", GENERATE_SYM},
+		{"CURSOR_NAME", CURSOR_NAME_SYM},
+		{"INTO", INTO},
+		{"SCHEMA", DATABASE},
+		{"LOAD", LOAD},
+		{"MICROSECOND", MICROSECOND_SYM},
+		{"UNBOUNDED", UNBOUNDED_SYM},
+		{"DEFINER", DEFINER_SYM},
+		{"SOURCE_HEARTBEAT_PERIOD", SOURCE_HEARTBEAT_PERIOD_SYM},
+		{"THEN", THEN_SYM},
+		{"PORT", PORT_SYM},
+		{"QUICK", QUICK},
+		{"EXTERNAL_FORMAT", EXTERNAL_FORMAT_SYM},
+		{"SOURCE_CONNECT_RETRY", SOURCE_CONNECT_RETRY_SYM},
+		{"VALIDATION", VALIDATION_SYM},
+		{"INT3", MEDIUMINT_SYM},
+		{"PREV", PREV_SYM},
+		{">=", GE},
+		{"INDEX", INDEX_SYM},
+		{"FILES", FILES_SYM},
+		{"CONSISTENT", CONSISTENT_SYM},
+		{"CURDATE", CURDATE},
+		{"ROW_NUMBER", ROW_NUMBER_SYM},
+		{"INACTIVE", INACTIVE_SYM},
+		{"AFTER", AFTER_SYM},
+		{"NO_INDEX", NO_INDEX_HINT},
+		{"SQL_TSI_HOUR", HOUR_SYM},
+		{"EXCLUDE", EXCLUDE_SYM},
+		{"CONTEXT", CONTEXT_SYM},
+		{"UNLOCK", UNLOCK_SYM},
+		{"NESTED", NESTED_SYM},
+		{"SOURCE_SSL_CA", SOURCE_SSL_CA_SYM},
+		{"DAY_MICROSECOND", DAY_MICROSECOND_SYM},
+		{"TYPES", TYPES_SYM},
+		{"BINLOG", BINLOG_SYM},
+		{"TIES", TIES_SYM},
+		{"SOURCE_SSL", SOURCE_SSL_SYM},
+		{"STATS_PERSISTENT", STATS_PERSISTENT_SYM},
+		{"RELOAD", RELOAD},
+		{"GEOMETRYCOLLECTION", GEOMETRYCOLLECTION_SYM},
+		{"DUPLICATE", DUPLICATE_SYM},
+		{"SWAPS", SWAPS_SYM},
+		{"DEALLOCATE", DEALLOCATE_SYM},
+		{"STARTING", STARTING},
+		{"SPECIFIC", SPECIFIC_SYM},
+		{"RETURN", RETURN_SYM},
+		{"MATERIALIZED", MATERIALIZED_SYM},
+		{"LOCKED", LOCKED_SYM},
+		{"NATURAL", NATURAL},
+		{"OPTION", OPTION},
+		{"URI", URI_SYM},
+		{"MAX_CONNECTIONS_PER_HOUR", MAX_CONNECTIONS_PER_HOUR},
+		{"INTEGER", INT_SYM},
+		{"TRIGGER", TRIGGER_SYM},
+		{"TRUNCATE", TRUNCATE_SYM},
+		{"FILE", FILE_SYM},
+		{"CHANNEL", CHANNEL_SYM},
+		{"PROFILES", PROFILES_SYM},
+		{"LIST", LIST_SYM},
+		{"STRAIGHT_JOIN", STRAIGHT_JOIN},
+		{"SYSTEM", SYSTEM_SYM},
+		{"WARNINGS", WARNINGS},
+		{"REORGANIZE", REORGANIZE_SYM},
+		{"INSENSITIVE", INSENSITIVE_SYM},
+		{"BIT_XOR", BIT_XOR_SYM},
+		{"VAR_SAMP", VAR_SAMP_SYM},
+		{"DAY", DAY_SYM},
+		{"DIV", DIV_SYM},
+		{"MUTEX", MUTEX_SYM},
+		{"AT", AT_SYM},
+		{"SQL_TSI_MINUTE", MINUTE_SYM},
+		{"ENGINE_ATTRIBUTE", ENGINE_ATTRIBUTE_SYM},
+		{"OR", OR_SYM},
+		{"PARTITIONS", PARTITIONS_SYM},
+		{"ESCAPED", ESCAPED},
+		{"ORDINALITY", ORDINALITY_SYM},
+		{"TERMINATED", TERMINATED},
+		{"INSTALL", INSTALL_SYM},
+		{"ERRORS", ERRORS},
+		{"SOURCE_PORT", SOURCE_PORT_SYM},
+		{"SQL_NO_CACHE", SQL_NO_CACHE_SYM},
+		{"IO_AFTER_GTIDS", IO_AFTER_GTIDS},
+		{"RECOVER", RECOVER_SYM},
+		{"DATA", DATA_SYM},
+		{"KEY", KEY_SYM},
+		{"REPLICATION", REPLICATION},
+		{"GUIDED", GUIDED_SYM},
+		{"OPTIONAL", OPTIONAL_SYM},
+		{"WHILE", WHILE_SYM},
+		{"TABLESAMPLE", TABLESAMPLE_SYM},
+		{"LOGFILE", LOGFILE_SYM},
+		{"STD", STD_SYM},
+		{"PASSWORD", PASSWORD},
+		{"DATETIME", DATETIME_SYM},
+		{"LEAVES", LEAVES},
+		{"CHARSET", CHARSET},
+		{"HELP", HELP_SYM},
+		{"SESSION", SESSION_SYM},
+		{"MINUTE", MINUTE_SYM},
+		{"GROUP_CONCAT", GROUP_CONCAT_SYM},
+		{"REPLICAS", REPLICAS_SYM},
+		{"CHANGE", CHANGE},
+		{"ELSE", ELSE},
+		{"SHOW", SHOW},
+		{"OFF", OFF_SYM},
+		{"CUME_DIST", CUME_DIST_SYM},
+		{"CLASS_ORIGIN", CLASS_ORIGIN_SYM},
+		{"ONLY", ONLY_SYM},
+		{"LONGBLOB", LONGBLOB_SYM},
+		{"STRICT_LOAD", STRICT_LOAD_SYM},
+		{"TINYBLOB", TINYBLOB_SYM},
+		{"<=", LE},
+		{"CLONE", CLONE_SYM},
+		{"DESCRIBE", DESCRIBE},
+		{"ENCLOSED", ENCLOSED},
+		{"CREATE", CREATE},
+		{"LEFT", LEFT},
+		{"NO_RANGE_OPTIMIZATION", NO_RANGE_OPTIMIZATION_HINT},
+		{"RIGHT", RIGHT},
+		{"GENERATED", GENERATED},
+		{"LOCALTIMESTAMP", NOW_SYM},
+		{"HEADER", HEADER_SYM},
+		{"GLOBAL", GLOBAL_SYM},
+		{"VISIBLE", VISIBLE_SYM},
+		{"SQL_TSI_SECOND", SECOND_SYM},
+		{"LINESTRING", LINESTRING_SYM},
+		{"REVOKE", REVOKE},
+		{"ADD", ADD},
+		{"ALLOW_MISSING_FILES", ALLOW_MISSING_FILES_SYM},
+		{"SOUNDS", SOUNDS_SYM},
+		{"CURRENT_TIME", CURTIME},
+		{"SET_VAR", SET_VAR_HINT},
+		{"NCHAR", NCHAR_SYM},
+		{"WITH", WITH},
+		{"DUALITY", DUALITY_SYM},
+		{"CUBE", CUBE_SYM},
+		{"INFILE", INFILE_SYM},
+		{"SOURCE_COMPRESSION_ALGORITHMS", SOURCE_COMPRESSION_ALGORITHM_SYM},
+		{"PARAMETERS", PARAMETERS_SYM},
+		{"PRECISION", PRECISION},
+		{"RLIKE", REGEXP},
+		{"ROW_FORMAT", ROW_FORMAT_SYM},
+		{"HOUR_MINUTE", HOUR_MINUTE_SYM},
+		{"URL", URL_SYM},
+		{"MATCH", MATCH},
+		{"COMPACT", COMPACT_SYM},
+		{"ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS", ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_SYM},
+		{"SIMPLE", SIMPLE_SYM},
+		{"SOURCE_SSL_CIPHER", SOURCE_SSL_CIPHER_SYM},
+		{"JOIN_ORDER", JOIN_ORDER_HINT},
+		{"GROUPS", GROUPS_SYM},
+		{"CHALLENGE_RESPONSE", CHALLENGE_RESPONSE_SYM},
+		{"PERSIST_ONLY", PERSIST_ONLY_SYM},
+		{"LOOP", LOOP_SYM},
+		{"SET", SET_SYM},
+		{"CAST", CAST_SYM},
+		{"GENERAL", GENERAL},
+		{"BKA", BKA_HINT},
+		{"WINDOW", WINDOW_SYM},
+		{"HASH", HASH_SYM},
+		{"TIMESTAMPADD", TIMESTAMP_ADD},
+		{"ROWS", ROWS_SYM},
+		{"FACTOR", FACTOR_SYM},
+		{"CLIENT", CLIENT_SYM},
+		{"SETS", SETS_SYM},
+		{"AUTO", AUTO_SYM},
+		{"TRIGGERS", TRIGGERS_SYM},
+		{"SYSDATE", SYSDATE},
+		{"ROW_COUNT", ROW_COUNT_SYM},
+		{"REDUNDANT", REDUNDANT_SYM},
+		{"DEFAULT", DEFAULT_SYM},
+		{"CHECKSUM", CHECKSUM_SYM},
+		{"EXPIRE", EXPIRE_SYM},
+		{"TIMESTAMP", TIMESTAMP_SYM},
+		{"ST_COLLECT", ST_COLLECT_SYM},
+		{"AUTHENTICATION", AUTHENTICATION_SYM},
+		{"SOURCE_DELAY", SOURCE_DELAY_SYM},
+		{"VAR_POP", VARIANCE_SYM},
+		{"BTREE", BTREE_SYM},
+		{"NDB", NDBCLUSTER_SYM},
+		{"UNSIGNED", UNSIGNED_SYM},
+		{"SQLWARNING", SQLWARNING_SYM},
+		{"REPEAT", REPEAT_SYM},
+		{"ENFORCED", ENFORCED_SYM},
+		{"CPU", CPU_SYM},
+		{"COMPONENT", COMPONENT_SYM},
+		{"SLAVE", SLAVE},
+		{"EXPORT", EXPORT_SYM},
+		{"AGGREGATE", AGGREGATE_SYM},
+		{"TABLE_CHECKSUM", TABLE_CHECKSUM_SYM},
+		{"VCPU", VCPU_SYM},
+		{"GTID_ONLY", GTID_ONLY_SYM},
+		{"MRR", MRR_HINT},
+		{"MIDDLEINT", MEDIUMINT_SYM},
+		{"CONTAINS", CONTAINS_SYM},
+		{"LIKE", LIKE},
+		{"EACH", EACH_SYM},
+		{"REUSE", REUSE_SYM},
+		{"EXIT", EXIT_SYM},
+		{"RETURNS", RETURNS_SYM},
+		{"REPLICATE_IGNORE_DB", REPLICATE_IGNORE_DB},
+		{"VARCHARACTER", VARCHAR_SYM},
+		{"OLD", OLD_SYM},
+		{"STORAGE", STORAGE_SYM},
+		{"JOIN_INDEX", JOIN_INDEX_HINT},
+		{"NULL", NULL_SYM},
+		{">>", SHIFT_RIGHT},
+		{"NONE", NONE_SYM},
+		{"STDDEV_POP", STD_SYM},
+		{"SOURCE_SSL_CERT", SOURCE_SSL_CERT_SYM},
+		{"INT1", TINYINT_SYM},
+		{"RELATIONAL", RELATIONAL_SYM},
+		{"NATIONAL", NATIONAL_SYM},
+		{"SOURCE_CONNECTION_AUTO_FAILOVER", SOURCE_CONNECTION_AUTO_FAILOVER_SYM},
+		{"DIAGNOSTICS", DIAGNOSTICS_SYM},
+		{"CACHE", CACHE_SYM},
+		{"IDENTIFIED", IDENTIFIED_SYM},
+		{"RENAME", RENAME},
+		{"BEFORE", BEFORE_SYM},
+		{"&&", AND_AND_SYM},
+		{"MAXVALUE", MAX_VALUE_SYM},
+		{"RESIGNAL", RESIGNAL_SYM},
+		{"ACTION", ACTION},
+		{"CASE", CASE_SYM},
+		{"COMMITTED", COMMITTED_SYM},
+		{"OPEN", OPEN_SYM},
+		{"SQLEXCEPTION", SQLEXCEPTION_SYM},
+		{"SHARE", SHARE_SYM},
+		{"VARYING", VARYING},
+		{"SQL_BUFFER_RESULT", SQL_BUFFER_RESULT},
+		{"DERIVED_CONDITION_PUSHDOWN", DERIVED_CONDITION_PUSHDOWN_HINT},
+		{"SUBPARTITION", SUBPARTITION_SYM},
+		{"OWNER", OWNER_SYM},
+		{"OTHERS", OTHERS_SYM},
+		{"STORED", STORED_SYM},
+		{"HISTOGRAM", HISTOGRAM_SYM},
+		{"INDEXES", INDEXES},
+		{"FLOAT8", DOUBLE_SYM},
+		{"REGISTRATION", REGISTRATION_SYM},
+		{"INVOKER", INVOKER_SYM},
+		{"EVERY", EVERY_SYM},
+		{"SIGNED", SIGNED_SYM},
+		{"SPATIAL", SPATIAL_SYM},
+		{"SENSITIVE", SENSITIVE_SYM},
+		{"UPDATE", UPDATE_SYM},
+		{"DATABASE", DATABASE},
+		{"ALGORITHM", ALGORITHM_SYM},
+		{"FLOAT4", FLOAT_SYM},
+		{"USE_FRM", USE_FRM},
+		{"REPLICATE_DO_TABLE", REPLICATE_DO_TABLE},
+		{"RANGE", RANGE_SYM},
+		{"FAULTS", FAULTS_SYM},
+		{"TABLE_NAME", TABLE_NAME_SYM},
+		{"FIRST_VALUE", FIRST_VALUE_SYM},
+		{"<<", SHIFT_LEFT},
+		{"READS", READS_SYM},
+		{"INSTANCE", INSTANCE_SYM},
+		{"COMMIT", COMMIT_SYM},
+		{"ORDER_INDEX", ORDER_INDEX_HINT},
+		{"AVG", AVG_SYM},
+		{"CODE", CODE_SYM},
+		{"XOR", XOR},
+		{"FORMAT", FORMAT_SYM},
+		{"ERROR", ERROR_SYM},
+		{"REFERENCE", REFERENCE_SYM},
+		{"IN", IN_SYM},
+		{"OUTFILE", OUTFILE},
+		{"REQUIRE_TABLE_PRIMARY_KEY_CHECK", REQUIRE_TABLE_PRIMARY_KEY_CHECK_SYM},
+		{"CONVERT", CONVERT_SYM},
+		{"STATS_AUTO_RECALC", STATS_AUTO_RECALC_SYM},
+		{"DEC", DECIMAL_SYM},
+		{"AS", AS},
+		{"FOREIGN", FOREIGN},
+		{"DIRECTORY", DIRECTORY_SYM},
+		{"MESSAGE_TEXT", MESSAGE_TEXT_SYM},
+		{"SOURCE_SSL_CRL", SOURCE_SSL_CRL_SYM},
+		{"SEMIJOIN", SEMIJOIN_HINT},
+		{"MIN", MIN_SYM},
+		{"DUAL", DUAL_SYM},
+		{"SOURCE_TLS_CIPHERSUITES", SOURCE_TLS_CIPHERSUITES_SYM},
+		{"SQL_AFTER_GTIDS", SQL_AFTER_GTIDS},
+		{"NO_WAIT", NO_WAIT_SYM},
+		{"ANALYZE", ANALYZE_SYM},
+		{"SONAME", SONAME_SYM},
+		{"REPLICATE_WILD_IGNORE_TABLE", REPLICATE_WILD_IGNORE_TABLE},
+		{"TEMPTABLE", TEMPTABLE_SYM},
+		{"ENABLE", ENABLE_SYM},
+		{"SIGNAL", SIGNAL_SYM},
+		{"ZEROFILL", ZEROFILL_SYM},
+		{"OJ", OJ_SYM},
+		{"CROSS", CROSS},
+		{"SECONDARY_ENGINE", SECONDARY_ENGINE_SYM},
+		{"LINEAR", LINEAR_SYM},
+		{"ARRAY", ARRAY_SYM},
+		{"CONTINUE", CONTINUE_SYM},
+		{"LATERAL", LATERAL_SYM},
+		{"NOW", NOW_SYM},
+		{"PROXY", PROXY_SYM},
+		{"<", LT},
+		{"NOWAIT", NOWAIT_SYM},
+		{"POINT", POINT_SYM},
+		{"NUMBER", NUMBER_SYM},
+		{"INVISIBLE", INVISIBLE_SYM},
+		{"TRIM", TRIM},
+		{"READ", READ_SYM},
+		{"OPTIMIZE", OPTIMIZE},
+		{"RETURNED_SQLSTATE", RETURNED_SQLSTATE_SYM},
+		{"RECURSIVE", RECURSIVE_SYM},
+		{"ALL", ALL},
+		{"CHAIN", CHAIN_SYM},
+		{"READ_WRITE", READ_WRITE_SYM},
+		{"NO_INDEX_MERGE", NO_INDEX_MERGE_HINT},
+		{"SOURCE_SSL_CRLPATH", SOURCE_SSL_CRLPATH_SYM},
+		{"SECURITY", SECURITY_SYM},
+		{"DUMPFILE", DUMPFILE},
+		{"AUTO_REFRESH", AUTO_REFRESH_SYM},
+		{"FAILED_LOGIN_ATTEMPTS", FAILED_LOGIN_ATTEMPTS_SYM},
+		{"JOIN_FIXED_ORDER", JOIN_FIXED_ORDER_HINT},
+		{"SQL_BEFORE_GTIDS", SQL_BEFORE_GTIDS},
+		{"LEAD", LEAD_SYM},
+		{"GROUP_REPLICATION", GROUP_REPLICATION},
+		{"MODIFY", MODIFY_SYM},
+		{"REDO_BUFFER_SIZE", REDO_BUFFER_SIZE_SYM},
+		{"END", END},
+		{"MEDIUMBLOB", MEDIUMBLOB_SYM},
+		{"BOTH", BOTH},
+		{"COMPRESSED", COMPRESSED_SYM},
+		{"SECOND_MICROSECOND", SECOND_MICROSECOND_SYM},
+		{"FILE_BLOCK_SIZE", FILE_BLOCK_SIZE_SYM},
+		{"SOURCE_HOST", SOURCE_HOST_SYM},
+		{"RESTORE", RESTORE_SYM},
+		{"YEAR", YEAR_SYM},
+		{"TIMESTAMPDIFF", TIMESTAMP_DIFF},
+		{"COMPLETION", COMPLETION_SYM},
+		{"LOCK", LOCK_SYM},
+		{"STACKED", STACKED_SYM},
+		{"HOUR_MICROSECOND", HOUR_MICROSECOND_SYM},
+		{"SWITCHES", SWITCHES_SYM},
+		{"FOLLOWING", FOLLOWING_SYM},
+		{"!=", NE},
+		{"IF", IF},
+		{"CURRENT_TIMESTAMP", NOW_SYM},
+		{"NAME", NAME_SYM},
+		{"VECTOR", VECTOR_SYM},
+		{"NAMES", NAMES_SYM},
+		{"DAY_SECOND", DAY_SECOND_SYM},
+		{"BIT", BIT_SYM},
+		{"X509", X509_SYM},
+		{"BOOLEAN", BOOLEAN_SYM},
+		{"SAVEPOINT", SAVEPOINT_SYM},
+		{"EMPTY", EMPTY_SYM},
+		{"RELAY_LOG_POS", RELAY_LOG_POS_SYM},
+		{"SUBSTRING", SUBSTRING},
+		{"DISCARD", DISCARD_SYM},
+		{"SUSPEND", SUSPEND_SYM},
+		{"INITIAL", INITIAL_SYM},
+		{"SYSTEM_USER", USER},
+		{"LIBRARY", LIBRARY_SYM},
+		{"HOST", HOST_SYM},
+		{"PAGE", PAGE_SYM},
+		{"PROFILE", PROFILE_SYM},
+		{"TABLESPACE", TABLESPACE_SYM},
+		{"LOW_PRIORITY", LOW_PRIORITY},
+		{"REPLICATE_REWRITE_DB", REPLICATE_REWRITE_DB},
+		{"SQL_TSI_WEEK", WEEK_SYM},
+		{"QUARTER", QUARTER_SYM},
+		{"NO_BNL", NO_BNL_HINT},
+		{"SOURCE", SOURCE_SYM},
+		{"SOURCE_ZSTD_COMPRESSION_LEVEL", SOURCE_ZSTD_COMPRESSION_LEVEL_SYM},
+		{"NEW", NEW_SYM},
+		{"JSON_OBJECTAGG", JSON_OBJECTAGG},
+		{"TRUE", TRUE_SYM},
+		{"LOCKS", LOCKS_SYM},
+		{"CIPHER", CIPHER_SYM},
+		{"SELECT", SELECT_SYM},
+		{"CURRENT_USER", CURRENT_USER},
+		{"MULTILINESTRING", MULTILINESTRING_SYM},
+		{"EXPLAIN", DESCRIBE},
+		{"RESPECT", RESPECT_SYM},
+		{"COMMENT", COMMENT_SYM},
+		{"NTH_VALUE", NTH_VALUE_SYM},
+		{"CURRENT", CURRENT_SYM},
+		{"IPC", IPC_SYM},
+		{"KEYS", KEYS},
+		{"BIT_AND", BIT_AND_SYM},
+		{"GET", GET_SYM},
+		{"JSON_DUALITY_OBJECT", JSON_DUALITY_OBJECT_SYM},
+		{"SQLSTATE", SQLSTATE_SYM},
+		{"RELAY_THREAD", RELAY_THREAD},
+		{"DATABASES", DATABASES},
+		{"PROCESSLIST", PROCESSLIST_SYM},
+		{"ROLLUP", ROLLUP_SYM},
+		{"SQL_CALC_FOUND_ROWS", SQL_CALC_FOUND_ROWS},
+		{"MODIFIES", MODIFIES_SYM},
+		{"UNINSTALL", UNINSTALL_SYM},
+		{"SECONDARY", SECONDARY_SYM},
+		{"QUERY", QUERY_SYM},
+		{"SRID", SRID_SYM},
+		{"MAX_USER_CONNECTIONS", MAX_USER_CONNECTIONS_SYM},
+		{"HAVING", HAVING},
+		{"SLOW", SLOW},
+		{"SUBSTR", SUBSTRING},
+		{"SQL_SMALL_RESULT", SQL_SMALL_RESULT},
+		{"PERSIST", PERSIST_SYM},
+		{"CALL", CALL_SYM},
+		{"S3", S3_SYM},
+		{"FLOAT", FLOAT_SYM},
+		{"BIT_OR", BIT_OR_SYM},
+		{"SOURCE_SSL_CAPATH", SOURCE_SSL_CAPATH_SYM},
+		{"TINYINT", TINYINT_SYM},
+		{"ORGANIZATION", ORGANIZATION_SYM},
+		{"VARBINARY", VARBINARY_SYM},
+		{"LONG", LONG_SYM},
+		{"SECONDARY_LOAD", SECONDARY_LOAD_SYM},
+		{"JSON", JSON_SYM},
+		{"ROLLBACK", ROLLBACK_SYM},
+		{"STATS_SAMPLE_PAGES", STATS_SAMPLE_PAGES_SYM},
+		{">", GT_SYM},
+		{"EXECUTE", EXECUTE_SYM},
+		{"DELAY_KEY_WRITE", DELAY_KEY_WRITE_SYM},
+		{"CURRENT_DATE", CURDATE},
+		{"FIELDS", COLUMNS},
+		{"PLUGIN_DIR", PLUGIN_DIR_SYM},
+		{"MAX_QUERIES_PER_HOUR", MAX_QUERIES_PER_HOUR},
+		{"MINUTE_MICROSECOND", MINUTE_MICROSECOND_SYM},
+		{"SSL", SSL_SYM},
+		{"EXTERNAL", EXTERNAL_SYM},
+		{"||", OR_OR_SYM},
+		{"PLUGINS", PLUGINS_SYM},
+		{"NDBCLUSTER", NDBCLUSTER_SYM},
+		{"SQL_THREAD", SQL_THREAD},
+		{"CHANGED", CHANGED},
+		{"PASSWORD_LOCK_TIME", PASSWORD_LOCK_TIME_SYM},
+		{"WORK", WORK_SYM},
+		{"COMPRESSION", COMPRESSION_SYM},
+		{"RELAYLOG", RELAYLOG_SYM},
+		{"SOURCE_SSL_VERIFY_SERVER_CERT", SOURCE_SSL_VERIFY_SERVER_CERT_SYM},
+		{"PACK_KEYS", PACK_KEYS_SYM},
+		{"WAIT", WAIT_SYM},
+		{"RANK", RANK_SYM},
+		{"ADMIN", ADMIN_SYM},
+		{"NO_ORDER_INDEX", NO_ORDER_INDEX_HINT},
+		{"RANDOM", RANDOM_SYM},
+		{"SOURCE_SSL_KEY", SOURCE_SSL_KEY_SYM},
+		{"COUNT", COUNT_SYM},
+		{"VARIANCE", VARIANCE_SYM},
+		{"MULTIPOLYGON", MULTIPOLYGON_SYM},
+		{"TLS", TLS_SYM},
+		{"CHAR", CHAR_SYM},
+		{"JOIN_PREFIX", JOIN_PREFIX_HINT},
+		{"AUTOEXTEND_SIZE", AUTOEXTEND_SIZE_SYM},
+		{"SECONDARY_UNLOAD", SECONDARY_UNLOAD_SYM},
+		{"CONNECTION", CONNECTION_SYM},
+		{"EXTENDED", EXTENDED_SYM},
+		{"REFERENCES", REFERENCES},
+		{"DROP", DROP},
+		{"SHUTDOWN", SHUTDOWN},
+		{"DESCRIPTION", DESCRIPTION_SYM},
+		{"VERIFY_KEY_CONSTRAINTS", VERIFY_KEY_CONSTRAINTS_SYM},
+		{"ENGINE", ENGINE_SYM},
+		{"ALTER", ALTER},
+		{"BYTE", BYTE_SYM},
+		{"MULTIPOINT", MULTIPOINT_SYM},
+		{"USER_RESOURCES", RESOURCES},
+		{"SOURCE_BIND", SOURCE_BIND_SYM},
+		{"MERGE", MERGE_SYM},
+		{"NOT", NOT_SYM},
+		{"NO_WRITE_TO_BINLOG", NO_WRITE_TO_BINLOG},
+		{"NO_DERIVED_CONDITION_PUSHDOWN", NO_DERIVED_CONDITION_PUSHDOWN_HINT},
+		{"=", EQ},
+		{"SOURCE_PUBLIC_KEY_PATH", SOURCE_PUBLIC_KEY_PATH_SYM},
+		{"GET_SOURCE_PUBLIC_KEY", GET_SOURCE_PUBLIC_KEY_SYM},
+		{"PRECEDES", PRECEDES_SYM},
+		{"SOURCE_PASSWORD", SOURCE_PASSWORD_SYM},
+		{"BLOB", BLOB_SYM},
+		{"INT", INT_SYM},
+		{"DISTINCT", DISTINCT},
+		{"FIXED", FIXED_SYM},
+		{"SUBPARTITIONS", SUBPARTITIONS_SYM},
+		{"ACTIVE", ACTIVE_SYM},
+		{"DISTINCTROW", DISTINCT},
+		{"PERCENT_RANK", PERCENT_RANK_SYM},
+		{"SOURCE_USER", SOURCE_USER_SYM},
+		{"PROCESS", PROCESS},
+		{"NODEGROUP", NODEGROUP_SYM},
+		{"DEFAULT_AUTH", DEFAULT_AUTH_SYM},
+		{"WEIGHT_STRING", WEIGHT_STRING_SYM},
+		{"RELAY_LOG_FILE", RELAY_LOG_FILE_SYM},
+		{"PRIMARY", PRIMARY_SYM},
+		{"CONCURRENT", CONCURRENT},
+		{"SECONDARY_ENGINE_ATTRIBUTE", SECONDARY_ENGINE_ATTRIBUTE_SYM},
+		{"NTILE", NTILE_SYM},
+		{"DATAFILE", DATAFILE_SYM},
+		{"CLOSE", CLOSE_SYM},
+		{"REPLACE", REPLACE_SYM},
+		{"INT2", SMALLINT_SYM},
+		{"HASH_JOIN", HASH_JOIN_HINT},
+		{"ASENSITIVE", ASENSITIVE_SYM},
+		{"REQUIRE_ROW_FORMAT", REQUIRE_ROW_FORMAT_SYM},
+		{"NO_ICP", NO_ICP_HINT},
+		{"INTERSECT", INTERSECT_SYM},
+		{"MIN_ROWS", MIN_ROWS},
+		{"SERVER", SERVER_SYM},
+		{"INTOEXISTS", INTOEXISTS_HINT},
+		{"EXPANSION", EXPANSION_SYM},
+		{"LOCAL", LOCAL_SYM},
+		{"ISOLATION", ISOLATION},
+		{"HOUR_SECOND", HOUR_SECOND_SYM},
+		{"SCHEMA_NAME", SCHEMA_NAME_SYM},
+		{"EXCEPT", EXCEPT_SYM},
+		{"FULL", FULL},
+		{"VALUE", VALUE_SYM},
+		{"SOURCE_LOG_POS", SOURCE_LOG_POS_SYM},
+		{"SOCKET", SOCKET_SYM},
+		{"VALIDATE", VALIDATE_SYM},
+		{"FINISH", FINISH_SYM},
+		{"KILL", KILL_SYM},
+		{"FILE_PATTERN", FILE_PATTERN_SYM},
+		{"DEFINITION", DEFINITION_SYM},
+		{"DATE_SUB", DATE_SUB_INTERVAL},
+		{"BIGINT", BIGINT_SYM},
+		{"HANDLER", HANDLER_SYM},
+		{"MEDIUMINT", MEDIUMINT_SYM},
+		{"INOUT", INOUT_SYM},
+		{"MAX_ROWS", MAX_ROWS},
+		{"RESTRICT", RESTRICT},
+		{"GROUP", GROUP_SYM},
+		{"RETAIN", RETAIN_SYM},
+		{"PRECEDING", PRECEDING_SYM},
+		{"COLLATION", COLLATION_SYM},
+		{"MAX_UPDATES_PER_HOUR", MAX_UPDATES_PER_HOUR},
+		{"KEY_BLOCK_SIZE", KEY_BLOCK_SIZE},
+		{"PLUGIN", PLUGIN_SYM},
+		{"GEOMETRY", GEOMETRY_SYM},
+		{"UTC_TIME", UTC_TIME_SYM},
+		{"MEDIUMTEXT", MEDIUMTEXT_SYM},
+		{"DENSE_RANK", DENSE_RANK_SYM},
+		{"SCHEMAS", DATABASES},
+		{"PHASE", PHASE_SYM},
+		{"IMPORT", IMPORT},
+		{"USER", USER},
+		{"ATTRIBUTE", ATTRIBUTE_SYM},
+		{"FOLLOWS", FOLLOWS_SYM},
+		{"FUNCTION", FUNCTION_SYM},
+		{"MINUTE_SECOND", MINUTE_SECOND_SYM},
+		{"JSON_VALUE", JSON_VALUE_SYM},
+		{"BLOCK", BLOCK_SYM},
+		{"FLUSH", FLUSH_SYM},
+		{"UNIQUE", UNIQUE_SYM},
+		{"ROLE", ROLE_SYM},
+		{"USAGE", USAGE},
+		{"PARTIAL", PARTIAL},
+		{"INNER", INNER_SYM},
+		{"LEAVE", LEAVE_SYM},
+		{"REMOVE", REMOVE_SYM},
+		{"MANUAL", MANUAL_SYM},
+		{"PARSER", PARSER_SYM},
+		{"HOUR", HOUR_SYM},
+		{"DISK", DISK_SYM},
+		{"PRIVILEGE_CHECKS_USER", PRIVILEGE_CHECKS_USER_SYM},
+		{"CURTIME", CURTIME},
+		{"OUT", OUT_SYM},
+		{"USING", USING},
+		{"EXISTS", EXISTS},
+		{"SKIP_SCAN", SKIP_SCAN_HINT},
+		{"UNDO", UNDO_SYM},
+		{"ORDER", ORDER_SYM},
+		{"ACCOUNT", ACCOUNT_SYM},
+		{"START", START_SYM},
+		{"GRANTS", GRANTS},
+		{"NULLS", NULLS_SYM},
+		{"DATE", DATE_SYM},
+		{"CONSTRAINT", CONSTRAINT},
+		{"WEEK", WEEK_SYM},
+		{"SOURCE_LOG_FILE", SOURCE_LOG_FILE_SYM},
+		{"WHERE", WHERE},
+		{"BNL", BNL_HINT},
+		{"DELETE", DELETE_SYM},
+		{"FILTER", FILTER_SYM},
+		{"SESSION_USER", USER},
+		{"SOURCE_TLS_VERSION", SOURCE_TLS_VERSION_SYM},
+		{"POSITION", POSITION_SYM},
+		{"NO_HASH_JOIN", NO_HASH_JOIN_HINT},
+		{"SCHEDULE", SCHEDULE_SYM},
+		{"NEXT", NEXT_SYM},
+		{"CHARACTER", CHAR_SYM},
+		{"BOOL", BOOL_SYM},
+		{"CONSTRAINT_CATALOG", CONSTRAINT_CATALOG_SYM},
+		{"HISTORY", HISTORY_SYM},
+		{"LEADING", LEADING},
+		{"IGNORE_SERVER_IDS", IGNORE_SERVER_IDS_SYM},
+		{"UNICODE", UNICODE_SYM},
+		{"FAST", FAST_SYM},
+		{"TO", TO_SYM},
+		{"GTIDS", GTIDS_SYM},
+		{"SNAPSHOT", SNAPSHOT_SYM},
+		{"RETURNING", RETURNING_SYM},
+		{"TEXT", TEXT_SYM},
+		{"COLUMN_NAME", COLUMN_NAME_SYM},
+		{"MEMORY", MEMORY_SYM},
+		{"COLUMN_FORMAT", COLUMN_FORMAT_SYM},
+		{"SOME", ANY_SYM},
+		{"STRING", STRING_SYM},
+		{"CATALOG_NAME", CATALOG_NAME_SYM},
+		{"MIGRATE", MIGRATE_SYM},
+		{"UTC_DATE", UTC_DATE_SYM},
+		{"DELAYED", DELAYED_SYM},
+		{"<=>", EQUAL_SYM},
+		{"LAG", LAG_SYM},
+		{"EVENT", EVENT_SYM},
+		{"FULLTEXT", FULLTEXT_SYM},
+		{"BEGIN", BEGIN_SYM},
+		{"BUCKETS", BUCKETS_SYM},
+		{"BULK", BULK_SYM},
+		{"EXCHANGE", EXCHANGE_SYM},
+		{"ENCRYPTION", ENCRYPTION_SYM},
+		{"WRAPPER", WRAPPER_SYM},
+		{"SERIAL", SERIAL_SYM},
+		{"QB_NAME", QB_NAME_HINT},
+		{"BY", BY},
+		{"XA", XA_SYM},
+		{"WITHOUT", WITHOUT_SYM},
+		{"PARALLEL", PARALLEL_SYM},
+		{"ENUM", ENUM_SYM},
+		{"SQL_TSI_MONTH", MONTH_SYM},
+		{"ONE", ONE_SYM},
+		{"REGEXP", REGEXP},
+		{"SUBQUERY", SUBQUERY_HINT},
+		{"STATUS", STATUS_SYM},
+		{"PRIVILEGES", PRIVILEGES},
+		{"XID", XID_SYM},
+		{"MAX_EXECUTION_TIME", MAX_EXECUTION_TIME_HINT},
+		{"DO", DO_SYM},
+		{"CONDITION", CONDITION_SYM},
+		{"OUTER", OUTER_SYM},
+		{"MASTER", MASTER_SYM},
+		{"LIMIT", LIMIT},
+		{"PARTITIONING", PARTITIONING_SYM},
+		{"ADDDATE", ADDDATE_SYM},
+		{"LESS", LESS_SYM},
+		{"WHEN", WHEN_SYM},
+		{"JSON_TABLE", JSON_TABLE_SYM},
+		{"CHECK", CHECK_SYM},
+		{"SMALLINT", SMALLINT_SYM},
+		{"TINYTEXT", TINYTEXT_SYN},
+		{"TRANSACTION", TRANSACTION_SYM},
+		{"REBUILD", REBUILD_SYM},
+		{"MEDIUM", MEDIUM_SYM},
+		{"GEOMCOLLECTION", GEOMETRYCOLLECTION_SYM},
+		{"YEAR_MONTH", YEAR_MONTH_SYM},
+		{"MAX_SIZE", MAX_SIZE_SYM},
+		{"ANY", ANY_SYM},
+		{"LANGUAGE", LANGUAGE_SYM},
+		{"TIME", TIME_SYM},
+		{"FROM", FROM},
+		{"VARIABLES", VARIABLES},
+		{"OFFSET", OFFSET_SYM},
+		{"CONSTRAINT_SCHEMA", CONSTRAINT_SCHEMA_SYM},
+		{"DOUBLE", DOUBLE_SYM},
+		{"REPLICA", REPLICA_SYM},
+		{"STREAM", STREAM_SYM},
+		{"USE", USE_SYM},
+		{"AUTO_REFRESH_SOURCE", AUTO_REFRESH_SOURCE_SYM},
+		{"VIEW", VIEW_SYM},
+		{"INSERT", INSERT_SYM},
+		{"TRAILING", TRAILING},
+		{"RESOURCE", RESOURCE_SYM},
+		{"BETWEEN", BETWEEN_SYM},
+		{"REPLICATE_IGNORE_TABLE", REPLICATE_IGNORE_TABLE},
+		{"ELSEIF", ELSEIF_SYM},
+		{"IS", IS},
+		{"NO_MRR", NO_MRR_HINT},
+		{"GRANT", GRANT},
+		{"REAL", REAL_SYM},
+		{"SQL_AFTER_MTS_GAPS", SQL_AFTER_MTS_GAPS},
+		{"FETCH", FETCH_SYM},
+		{"AND", AND_SYM},
+		{"STDDEV_SAMP", STDDEV_SAMP_SYM},
+		{"SUM", SUM_SYM},
+		{"READ_ONLY", READ_ONLY_SYM},
+		{"NO_MERGE", NO_DERIVED_MERGE_HINT},
+		{"DYNAMIC", DYNAMIC_SYM},
+		{"TABLES", TABLES},
+		{"ON", ON_SYM},
+		{"DUPSWEEDOUT", DUPSWEEDOUT_HINT},
+		{"CONSTRAINT_NAME", CONSTRAINT_NAME_SYM},
+		{"NO_SKIP_SCAN", NO_SKIP_SCAN_HINT},
+		{"DECIMAL", DECIMAL_SYM},
+		{"ITERATE", ITERATE_SYM},
+		{"ISSUER", ISSUER_SYM},
+		{"NO", NO_SYM},
+		{"UNION", UNION_SYM},
+		{"GET_FORMAT", GET_FORMAT},
+		{"FOUND", FOUND_SYM},
+		{"MID", SUBSTRING},
+		{"SOURCE_AUTO_POSITION", SOURCE_AUTO_POSITION_SYM},
+		{"SQL", SQL_SYM},
+		{"XML", XML_SYM},
+		{"GROUPING", GROUPING_SYM},
+		{"SERIALIZABLE", SERIALIZABLE_SYM},
+		{"LAST", LAST_SYM},
+		{"FALSE", FALSE_SYM},
+		{"OPTIONS", OPTIONS_SYM},
+		{"STDDEV", STD_SYM},
+		{"IGNORE", IGNORE_SYM},
+		{"LOG", LOG_SYM},
+		{"RESUME", RESUME_SYM},
+		{"SUBJECT", SUBJECT_SYM},
+		{"INSERT_METHOD", INSERT_METHOD},
+		{"NETWORK_NAMESPACE", NETWORK_NAMESPACE_SYM},
+		{"VARCHAR", VARCHAR_SYM},
+		{"IO", IO_SYM},
+	},
+}
+
+// keywordTable57 holds the MySQL 5.7 keywords.
+var keywordTable57 = &KeywordTable{
+	Seed:   0,
+	MaxLen: 29,
+	Displace: []uint32{
+		76, 1, 23, 22, 1, 34, 73, 131, 1, 133, 104, 33, 92, 22, 44, 373,
+		60, 1, 5, 4, 11, 259, 13, 4, 58, 1, 1, 9, 116, 138, 12, 7,
+		7, 10, 113, 2, 1, 6, 50, 74, 0, 143, 17, 128, 21, 5, 6, 0,
+		24, 0, 11, 70, 242, 2, 11, 97, 0, 104, 124, 2, 10, 164, 3, 83,
+		72, 97, 63, 404, 286, 1, 7, 2, 46, 84, 120, 124, 573, 32, 17, 61,
+		20, 50, 11, 10, 140, 452, 70, 1, 170, 39, 23, 27, 365, 88, 28, 1,
+		7, 464, 241, 1, 174, 417, 802, 54, 2, 146, 0, 262, 31, 30, 233, 61,
+		255, 26, 78, 599, 1, 13, 71, 171, 2, 1, 3, 16, 7, 9, 0, 16,
+		85, 1, 33, 2088, 1249, 536, 7, 129, 418, 53, 1, 303, 339, 31, 132, 11,
+		0, 165, 50, 862, 1, 1341, 25, 193, 98, 831, 3, 2335, 209, 265, 1, 2640,
+		12, 72, 662, 17, 16, 1, 243, 1193, 603, 26, 5464,
+	},
+	Entries: []KeywordEntry{
+		{"DYNAMIC", DYNAMIC_SYM},
+		{"WHEN", WHEN_SYM},
+		{"MASTER_SSL_CAPATH", OBSOLETE_TOKEN_564},
+		{"GROUP_CONCAT", GROUP_CONCAT_SYM},
+		{"BLOCK", BLOCK_SYM},
+		{"AGAINST", AGAINST},
+		{"YEAR", YEAR_SYM},
+		{"FUNCTION", FUNCTION_SYM},
+		{"ADDDATE", ADDDATE_SYM},
+		{"CONSTRAINT_CATALOG", CONSTRAINT_CATALOG_SYM},
+		{"NDB", NDBCLUSTER_SYM},
+		{"ROLLUP", ROLLUP_SYM},
+		{"OUTER", OUTER_SYM},
+		{"SONAME", SONAME_SYM},
+		{"VALIDATION", VALIDATION_SYM},
+		{"MERGE", MERGE_SYM},
+		{"ANY", ANY_SYM},
+		{"WITHOUT", WITHOUT_SYM},
+		{"LONG", LONG_SYM},
+		{"USE", USE_SYM},
+		{"NONE", NONE_SYM},
+		{"CACHE", CACHE_SYM},
+		{"PARSE_GCOL_EXPR", OBSOLETE_TOKEN_654},
+		{"WAIT", WAIT_SYM},
+		{"FETCH", FETCH_SYM},
+		{"MYSQL_ERRNO", MYSQL_ERRNO_SYM},
+		{"STORED", STORED_SYM},
+		{"SQLSTATE", SQLSTATE_SYM},
+		{"CURRENT", CURRENT_SYM},
+		{"SQL_THREAD", SQL_THREAD},
+		{"SUBQUERY", SUBQUERY_HINT},
+		{"<", LT},
+		{"SET", SET_SYM},
+		{"CONSTRAINT", CONSTRAINT},
+		{"DESCRIBE", DESCRIBE},
+		{"REPLICATE_WILD_DO_TABLE", REPLICATE_WILD_DO_TABLE},
+		{"COLUMNS", COLUMNS},
+		{"HOUR", HOUR_SYM},
+		{"MASTER_SSL", OBSOLETE_TOKEN_562},
+		{"ENGINES", ENGINES_SYM},
+		{"LOW_PRIORITY", LOW_PRIORITY},
+		{"BIT_AND", BIT_AND_SYM},
+		{"LONGBLOB", LONGBLOB_SYM},
+		{"CHANGED", CHANGED},
+		{"LOAD", LOAD},
+		{"DUPLICATE", DUPLICATE_SYM},
+		{"HOUR_MICROSECOND", HOUR_MICROSECOND_SYM},
+		{"FIXED", FIXED_SYM},
+		{"=", EQ},
+		{"KILL", KILL_SYM},
+		{"SELECT", SELECT_SYM},
+		{"CURDATE", CURDATE},
+		{"FILTER", FILTER_SYM},
+		{"IN", IN_SYM},
+		{">>", SHIFT_RIGHT},
+		{"POLYGON", POLYGON_SYM},
+		{"BEFORE", BEFORE_SYM},
+		{"REPLICATE_DO_DB", REPLICATE_DO_DB},
+		{"REDO_BUFFER_SIZE", REDO_BUFFER_SIZE_SYM},
+		{"CHANNEL", CHANNEL_SYM},
+		{"AUTOEXTEND_SIZE", AUTOEXTEND_SIZE_SYM},
+		{"UNDO", UNDO_SYM},
+		{"PARTITIONING", PARTITIONING_SYM},
+		{"LINES", LINES},
+		{"ISSUER", ISSUER_SYM},
+		{"UPGRADE", UPGRADE_SYM},
+		{"GROUP_REPLICATION", GROUP_REPLICATION},
+		{"DATE_SUB", DATE_SUB_INTERVAL},
+		{"SQL_TSI_QUARTER", QUARTER_SYM},
+		{"STRAIGHT_JOIN", STRAIGHT_JOIN},
+		{"COMMENT", COMMENT_SYM},
+		{"DIAGNOSTICS", DIAGNOSTICS_SYM},
+		{"PLUGIN_DIR", PLUGIN_DIR_SYM},
+		{"DES_KEY_FILE", OBSOLETE_TOKEN_388},
+		{"JOIN", JOIN_SYM},
+		{"RECOVER", RECOVER_SYM},
+		{"INTOEXISTS", INTOEXISTS_HINT},
+		{"BACKUP", BACKUP_SYM},
+		{"SENSITIVE", SENSITIVE_SYM},
+		{"MODIFIES", MODIFIES_SYM},
+		{"TINYINT", TINYINT_SYM},
+		{"HOSTS", HOSTS_SYM},
+		{"LINESTRING", LINESTRING_SYM},
+		{"MAXVALUE", MAX_VALUE_SYM},
+		{"WEEK", WEEK_SYM},
+		{"||", OR_OR_SYM},
+		{"ENGINE", ENGINE_SYM},
+		{"NO", NO_SYM},
+		{"FIRSTMATCH", FIRSTMATCH_HINT},
+		{"USING", USING},
+		{"GENERAL", GENERAL},
+		{"RETURNED_SQLSTATE", RETURNED_SQLSTATE_SYM},
+		{"SECOND", SECOND_SYM},
+		{"ASCII", ASCII_SYM},
+		{"TIME", TIME_SYM},
+		{"UNTIL", UNTIL_SYM},
+		{"TEXT", TEXT_SYM},
+		{"DISTINCT", DISTINCT},
+		{"SCHEMAS", DATABASES},
+		{"SQL_SMALL_RESULT", SQL_SMALL_RESULT},
+		{"SQL_TSI_DAY", DAY_SYM},
+		{"MESSAGE_TEXT", MESSAGE_TEXT_SYM},
+		{"MASTER_AUTO_POSITION", OBSOLETE_TOKEN_550},
+		{"<=", LE},
+		{"SERVER", SERVER_SYM},
+		{"RELEASE", RELEASE_SYM},
+		{"GET_FORMAT", GET_FORMAT},
+		{"IF", IF},
+		{"INNER", INNER_SYM},
+		{"KEYS", KEYS},
+		{"INT8", BIGINT_SYM},
+		{"REQUIRE", REQUIRE_SYM},
+		{"UNDOFILE", UNDOFILE_SYM},
+		{"TIMESTAMPADD", TIMESTAMP_ADD},
+		{"SHOW", SHOW},
+		{"ELSEIF", ELSEIF_SYM},
+		{"RELAY_THREAD", RELAY_THREAD},
+		{"BY", BY},
+		{"STATUS", STATUS_SYM},
+		{"MODE", MODE_SYM},
+		{"DATETIME", DATETIME_SYM},
+		{"MRR", MRR_HINT},
+		{"IGNORE_SERVER_IDS", IGNORE_SERVER_IDS_SYM},
+		{"LOCK", LOCK_SYM},
+		{"CONSTRAINT_SCHEMA", CONSTRAINT_SCHEMA_SYM},
+		{"STRING", STRING_SYM},
+		{"FLOAT4", FLOAT_SYM},
+		{"CONTINUE", CONTINUE_SYM},
+		{"REVERSE", REVERSE_SYM},
+		{"BETWEEN", BETWEEN_SYM},
+		{"RELOAD", RELOAD},
+		{"UNLOCK", UNLOCK_SYM},
+		{"SQL_AFTER_GTIDS", SQL_AFTER_GTIDS},
+		{"LOCATOR", OBSOLETE_TOKEN_538},
+		{"LOOP", LOOP_SYM},
+		{"ENCLOSED", ENCLOSED},
+		{"SERVER_OPTIONS", OBSOLETE_TOKEN_755},
+		{"NUMBER", NUMBER_SYM},
+		{"COALESCE", COALESCE},
+		{"RETURN", RETURN_SYM},
+		{"HAVING", HAVING},
+		{"NO_MRR", NO_MRR_HINT},
+		{"LOGS", LOGS_SYM},
+		{"SUM", SUM_SYM},
+		{"REPLICATE_IGNORE_TABLE", REPLICATE_IGNORE_TABLE},
+		{"EXPIRE", EXPIRE_SYM},
+		{"CONCURRENT", CONCURRENT},
+		{"PRESERVE", PRESERVE_SYM},
+		{"MIN", MIN_SYM},
+		{"TIMESTAMP", TIMESTAMP_SYM},
+		{"ROW_COUNT", ROW_COUNT_SYM},
+		{"SIMPLE", SIMPLE_SYM},
+		{"DROP", DROP},
+		{"INSERT_METHOD", INSERT_METHOD},
+		{"STDDEV", STD_SYM},
+		{"QUERY", QUERY_SYM},
+		{"SQL_TSI_HOUR", HOUR_SYM},
+		{"OUTFILE", OUTFILE},
+		{"ESCAPE", ESCAPE_SYM},
+		{"COMMITTED", COMMITTED_SYM},
+		{"TRUNCATE", TRUNCATE_SYM},
+		{"CONTAINS", CONTAINS_SYM},
+		{"TRIGGERS", TRIGGERS_SYM},
+		{"SQL_TSI_WEEK", WEEK_SYM},
+		{"IO_BEFORE_GTIDS", IO_BEFORE_GTIDS},
+		{"JSON", JSON_SYM},
+		{"DOUBLE", DOUBLE_SYM},
+		{"MODIFY", MODIFY_SYM},
+		{"CLASS_ORIGIN", CLASS_ORIGIN_SYM},
+		{"SQL_AFTER_MTS_GAPS", SQL_AFTER_MTS_GAPS},
+		{"READS", READS_SYM},
+		{"ROLLBACK", ROLLBACK_SYM},
+		{"SQL_TSI_MONTH", MONTH_SYM},
+		{"LAST", LAST_SYM},
+		{"UNDEFINED", UNDEFINED_SYM},
+		{"RESUME", RESUME_SYM},
+		{"ORDER", ORDER_SYM},
+		{"SNAPSHOT", SNAPSHOT_SYM},
+		{"DATE_ADD", DATE_ADD_INTERVAL},
+		{"CUBE", CUBE_SYM},
+		{"PROFILES", PROFILES_SYM},
+		{"ASENSITIVE", ASENSITIVE_SYM},
+		{"CHAIN", CHAIN_SYM},
+		{"ANALYZE", ANALYZE_SYM},
+		{"FALSE", FALSE_SYM},
+		{"DEFINER", DEFINER_SYM},
+		{"ON", ON_SYM},
+		{"MASTER_SSL_KEY", OBSOLETE_TOKEN_569},
+		{"EXIT", EXIT_SYM},
+		{"DISK", DISK_SYM},
+		{"MASTER_DELAY", OBSOLETE_TOKEN_553},
+		{"REPLACE", REPLACE_SYM},
+		{"AT", AT_SYM},
+		{"GRANTS", GRANTS},
+		{"NO_BKA", NO_BKA_HINT},
+		{"NCHAR", NCHAR_SYM},
+		{"CALL", CALL_SYM},
+		{"MAX_CONNECTIONS_PER_HOUR", MAX_CONNECTIONS_PER_HOUR},
+		{"WEIGHT_STRING", WEIGHT_STRING_SYM},
+		{"ALTER", ALTER},
+		{"MASTER_SSL_CRL", OBSOLETE_TOKEN_567},
+		{"TYPE", TYPE_SYM},
+		{"PHASE", PHASE_SYM},
+		{"MAX_UPDATES_PER_HOUR", MAX_UPDATES_PER_HOUR},
+		{"CONNECTION", CONNECTION_SYM},
+		{"SUBJECT", SUBJECT_SYM},
+		{"IDENTIFIED", IDENTIFIED_SYM},
+		{"NULL", NULL_SYM},
+		{"FOUND", FOUND_SYM},
+		{"VARBINARY", VARBINARY_SYM},
+		{"HASH", HASH_SYM},
+		{"ASC", ASC},
+		{"SWITCHES", SWITCHES_SYM},
+		{"<=>", EQUAL_SYM},
+		{"MASTER_LOG_POS", OBSOLETE_TOKEN_556},
+		{"UTC_TIMESTAMP", UTC_TIMESTAMP_SYM},
+		{"POINT", POINT_SYM},
+		{"NVARCHAR", NVARCHAR_SYM},
+		{"SESSION_USER", USER},
+		{"VIEW", VIEW_SYM},
+		{"NEW", NEW_SYM},
+		{"UNCOMMITTED", UNCOMMITTED_SYM},
+		{"EVERY", EVERY_SYM},
+		{"MATCH", MATCH},
+		{"CHECKSUM", CHECKSUM_SYM},
+		{"NO_SEMIJOIN", NO_SEMIJOIN_HINT},
+		{"CROSS", CROSS},
+		{"AVG", AVG_SYM},
+		{"MINUTE_MICROSECOND", MINUTE_MICROSECOND_SYM},
+		{"STDDEV_SAMP", STDDEV_SAMP_SYM},
+		{"NO_WAIT", NO_WAIT_SYM},
+		{"DECIMAL", DECIMAL_SYM},
+		{"IS", IS},
+		{"WORK", WORK_SYM},
+		{"SOCKET", SOCKET_SYM},
+		{"INFILE", INFILE_SYM},
+		{"STDDEV_POP", STD_SYM},
+		{"RESTORE", RESTORE_SYM},
+		{"DISTINCTROW", DISTINCT},
+		{"LIKE", LIKE},
+		{"CAST", CAST_SYM},
+		{"MASTER_USER", OBSOLETE_TOKEN_573},
+		{"HANDLER", HANDLER_SYM},
+		{"ALWAYS", ALWAYS_SYM},
+		{"LIMIT", LIMIT},
+		{"GEOMETRY", GEOMETRY_SYM},
+		{"NAME", NAME_SYM},
+		{"SECOND_MICROSECOND", SECOND_MICROSECOND_SYM},
+		{"REPLICATE_REWRITE_DB", REPLICATE_REWRITE_DB},
+		{"VALUE", VALUE_SYM},
+		{"XA", XA_SYM},
+		{"TERMINATED", TERMINATED},
+		{"MINUTE_SECOND", MINUTE_SECOND_SYM},
+		{"CHARACTER", CHAR_SYM},
+		{"START", START_SYM},
+		{"SOUNDS", SOUNDS_SYM},
+		{"MUTEX", MUTEX_SYM},
+		{"FULLTEXT", FULLTEXT_SYM},
+		{"OPTIMIZER_COSTS", OPTIMIZER_COSTS_SYM},
+		{"VARIABLES", VARIABLES},
+		{"EXTENT_SIZE", EXTENT_SIZE_SYM},
+		{"MID", SUBSTRING},
+		{"ROTATE", ROTATE_SYM},
+		{"MEDIUM", MEDIUM_SYM},
+		{"INTEGER", INT_SYM},
+		{"MIGRATE", MIGRATE_SYM},
+		{"DAY_SECOND", DAY_SECOND_SYM},
+		{"OUT", OUT_SYM},
+		{">", GT_SYM},
+		{"STORAGE", STORAGE_SYM},
+		{"SOME", ANY_SYM},
+		{"GET", GET_SYM},
+		{"ALL", ALL},
+		{"SIGNAL", SIGNAL_SYM},
+		{"DISABLE", DISABLE_SYM},
+		{"VARYING", VARYING},
+		{"RLIKE", REGEXP},
+		{"SCHEDULE", SCHEDULE_SYM},
+		{"ONE", ONE_SYM},
+		{"TABLE_REF_PRIORITY", OBSOLETE_TOKEN_820},
+		{"EXTRACT", EXTRACT_SYM},
+		{"ISOLATION", ISOLATION},
+		{"IGNORE", IGNORE_SYM},
+		{"UNDO_BUFFER_SIZE", UNDO_BUFFER_SIZE_SYM},
+		{"ROUTINE", ROUTINE_SYM},
+		{"SQL_CACHE", OBSOLETE_TOKEN_784},
+		{"MASTER_PORT", OBSOLETE_TOKEN_558},
+		{"LOCAL", LOCAL_SYM},
+		{"ENABLE", ENABLE_SYM},
+		{"CLIENT", CLIENT_SYM},
+		{"SHUTDOWN", SHUTDOWN},
+		{"THAN", THAN_SYM},
+		{"SESSION", SESSION_SYM},
+		{"OR", OR_SYM},
+		{"SQL_TSI_SECOND", SECOND_SYM},
+		{"OPTIMIZE", OPTIMIZE},
+		{"COLUMN", COLUMN_SYM},
+		{"PROXY", PROXY_SYM},
+		{"SMALLINT", SMALLINT_SYM},
+		{"AFTER", AFTER_SYM},
+		{"FILE", FILE_SYM},
+		{"EXPLAIN", DESCRIBE},
+		{"STARTING", STARTING},
+		{"FLOAT", FLOAT_SYM},
+		{"FULL", FULL},
+		{"DETERMINISTIC", DETERMINISTIC_SYM},
+		{"FOREIGN", FOREIGN},
+		{"SHARE", SHARE_SYM},
+		{"CATALOG_NAME", CATALOG_NAME_SYM},
+		{"ANALYSE", OBSOLETE_TOKEN_271},
+		{"LOCALTIME", NOW_SYM},
+		{"HOUR_SECOND", HOUR_SECOND_SYM},
+		{"HOST", HOST_SYM},
+		{"COMPRESSION", COMPRESSION_SYM},
+		{"LESS", LESS_SYM},
+		{"CREATE", CREATE},
+		{"WARNINGS", WARNINGS},
+		{"RIGHT", RIGHT},
+		{"REPEATABLE", REPEATABLE_SYM},
+		{"REPLICATE_IGNORE_DB", REPLICATE_IGNORE_DB},
+		{"TINYTEXT", TINYTEXT_SYN},
+		{"BKA", BKA_HINT},
+		{"SQL_NO_CACHE", SQL_NO_CACHE_SYM},
+		{"TO", TO_SYM},
+		{"MATERIALIZATION", MATERIALIZATION_HINT},
+		{"SUBSTR", SUBSTRING},
+		{"UNKNOWN", UNKNOWN_SYM},
+		{"COUNT", COUNT_SYM},
+		{"PAGE", PAGE_SYM},
+		{"NDBCLUSTER", NDBCLUSTER_SYM},
+		{"NEVER", NEVER_SYM},
+		{"USER", USER},
+		{"CIPHER", CIPHER_SYM},
+		{"SEMIJOIN", SEMIJOIN_HINT},
+		{"MASTER_SERVER_ID", OBSOLETE_TOKEN_561},
+		{"STATS_AUTO_RECALC", STATS_AUTO_RECALC_SYM},
+		{"EXPORT", EXPORT_SYM},
+		{"NO_WRITE_TO_BINLOG", NO_WRITE_TO_BINLOG},
+		{"BOOL", BOOL_SYM},
+		{"PURGE", PURGE},
+		{"DIRECTORY", DIRECTORY_SYM},
+		{"REAL", REAL_SYM},
+		{"LOOSESCAN", LOOSESCAN_HINT},
+		{"UDF_RETURNS", OBSOLETE_TOKEN_848},
+		{"MASTER_SSL_VERIFY_SERVER_CERT", OBSOLETE_TOKEN_570},
+		{"FLUSH", FLUSH_SYM},
+		{"TRANSACTION", TRANSACTION_SYM},
+		{"INITIAL_SIZE", INITIAL_SIZE_SYM},
+		{"REPAIR", REPAIR},
+		{"SYSDATE", SYSDATE},
+		{"DO", DO_SYM},
+		{"NODEGROUP", NODEGROUP_SYM},
+		{"INDEXES", INDEXES},
+		{"NOW", NOW_SYM},
+		{"NEXT", NEXT_SYM},
+		{"AVG_ROW_LENGTH", AVG_ROW_LENGTH},
+		{"ADD", ADD},
+		{"PREV", PREV_SYM},
+		{"MAX_QUERIES_PER_HOUR", MAX_QUERIES_PER_HOUR},
+		{"IO", IO_SYM},
+		{"DEC", DECIMAL_SYM},
+		{"DELAYED", DELAYED_SYM},
+		{"FROM", FROM},
+		{"OFFSET", OFFSET_SYM},
+		{"SQL_BUFFER_RESULT", SQL_BUFFER_RESULT},
+		{"DAY_MINUTE", DAY_MINUTE_SYM},
+		{"ZEROFILL", ZEROFILL_SYM},
+		{"HIGH_PRIORITY", HIGH_PRIORITY},
+		{"LONGTEXT", LONGTEXT_SYM},
+		{"REMOVE", REMOVE_SYM},
+		{"QUARTER", QUARTER_SYM},
+		{"RESIGNAL", RESIGNAL_SYM},
+		{"AGGREGATE", AGGREGATE_SYM},
+		{"REPLICATE_DO_TABLE", REPLICATE_DO_TABLE},
+		{"INDEX", INDEX_SYM},
+		{"NUMERIC", NUMERIC_SYM},
+		{"MASTER_SSL_CERT", OBSOLETE_TOKEN_565},
+		{"INTERVAL", INTERVAL_SYM},
+		{"FLOAT8", DOUBLE_SYM},
+		{"LEADING", LEADING},
+		{"WITH", WITH},
+		{"ACCOUNT", ACCOUNT_SYM},
+		{"PASSWORD", PASSWORD},
+		{"ENCRYPTION", ENCRYPTION_SYM},
+		{"OPTIONS", OPTIONS_SYM},
+		{"IO_AFTER_GTIDS", IO_AFTER_GTIDS},
+		{"DIV", DIV_SYM},
+		{"WITH_CUBE", OBSOLETE_TOKEN_893},
+		{"DAY_MICROSECOND", DAY_MICROSECOND_SYM},
+		{"NO_BNL", NO_BNL_HINT},
+		{"INSENSITIVE", INSENSITIVE_SYM},
+		{"TEMPORARY", TEMPORARY},
+		{"XID", XID_SYM},
+		{"SSL", SSL_SYM},
+		{"VIRTUAL", VIRTUAL_SYM},
+		{"RTREE", RTREE_SYM},
+		{"READ_ONLY", READ_ONLY_SYM},
+		{"EVENT", EVENT_SYM},
+		{"EXTENDED", EXTENDED_SYM},
+		{"SERIAL", SERIAL_SYM},
+		{"PACK_KEYS", PACK_KEYS_SYM},
+		{"PLUGINS", PLUGINS_SYM},
+		{"DATABASES", DATABASES},
+		{"MASTER_RETRY_COUNT", OBSOLETE_TOKEN_559},
+		{"SUPER", SUPER_SYM},
+		{"BTREE", BTREE_SYM},
+		{"LEFT", LEFT},
+		{"AUTO_INCREMENT", AUTO_INC},
+		{"BOTH", BOTH},
+		{"COLUMN_NAME", COLUMN_NAME_SYM},
+		{"UNICODE", UNICODE_SYM},
+		{"ROW", ROW_SYM},
+		{"DATA", DATA_SYM},
+		{"COLLATE", COLLATE_SYM},
+		{"SCHEMA_NAME", SCHEMA_NAME_SYM},
+		{"STARTS", STARTS_SYM},
+		{"AND", AND_SYM},
+		{"MICROSECOND", MICROSECOND_SYM},
+		{"&&", AND_AND_SYM},
+		{"UTC_TIME", UTC_TIME_SYM},
+		{"WHILE", WHILE_SYM},
+		{"LIST", LIST_SYM},
+		{"COMMIT", COMMIT_SYM},
+		{"PRECISION", PRECISION},
+		{"RETURNS", RETURNS_SYM},
+		{"CURSOR_NAME", CURSOR_NAME_SYM},
+		{"CONTEXT", CONTEXT_SYM},
+		{"PROCESSLIST", PROCESSLIST_SYM},
+		{"PLUGIN", PLUGIN_SYM},
+		{"MASTER_SSL_CIPHER", OBSOLETE_TOKEN_566},
+		{"MASTER_PASSWORD", OBSOLETE_TOKEN_557},
+		{"WRAPPER", WRAPPER_SYM},
+		{"LEAVE", LEAVE_SYM},
+		{"EXCHANGE", EXCHANGE_SYM},
+		{"SECURITY", SECURITY_SYM},
+		{"VALUES", VALUES},
+		{"FAST", FAST_SYM},
+		{"DECLARE", DECLARE_SYM},
+		{"BNL", BNL_HINT},
+		{"ACCESSIBLE", ACCESSIBLE_SYM},
+		{"MASTER", MASTER_SYM},
+		{"!=", NE},
+		{"FORCE", FORCE_SYM},
+		{"FORMAT", FORMAT_SYM},
+		{"MINUTE", MINUTE_SYM},
+		{"CODE", CODE_SYM},
+		{"POSITION", POSITION_SYM},
+		{"TRUE", TRUE_SYM},
+		{"UNIQUE", UNIQUE_SYM},
+		{"DAY", DAY_SYM},
+		{"CASCADE", CASCADE},
+		{"WRITE", WRITE_SYM},
+		{"PARSER", PARSER_SYM},
+		{"CURRENT_DATE", CURDATE},
+		{"DEFAULT", DEFAULT_SYM},
+		{"SLOW", SLOW},
+		{"CASCADED", CASCADED},
+		{"SQL_CALC_FOUND_ROWS", SQL_CALC_FOUND_ROWS},
+		{"RELAY_LOG_POS", RELAY_LOG_POS_SYM},
+		{"GROUP", GROUP_SYM},
+		{"INSERT", INSERT_SYM},
+		{"EVENTS", EVENTS_SYM},
+		{"PRECEDES", PRECEDES_SYM},
+		{"SUBPARTITION", SUBPARTITION_SYM},
+		{"BIT_XOR", BIT_XOR_SYM},
+		{"DELETE", DELETE_SYM},
+		{"ESCAPED", ESCAPED},
+		{"PROCESS", PROCESS},
+		{"ENDS", ENDS_SYM},
+		{"JSON_OBJECTAGG", JSON_OBJECTAGG},
+		{"READ", READ_SYM},
+		{"KEY", KEY_SYM},
+		{"ELSE", ELSE},
+		{"USAGE", USAGE},
+		{"INT2", SMALLINT_SYM},
+		{"WHERE", WHERE},
+		{"TABLES", TABLES},
+		{"BEGIN", BEGIN_SYM},
+		{"STD", STD_SYM},
+		{"HOUR_MINUTE", HOUR_MINUTE_SYM},
+		{"EXISTS", EXISTS},
+		{"MOD", MOD_SYM},
+		{"MAX_SIZE", MAX_SIZE_SYM},
+		{"GEOMCOLLECTION", GEOMETRYCOLLECTION_SYM},
+		{"UNSIGNED", UNSIGNED_SYM},
+		{"ROW_FORMAT", ROW_FORMAT_SYM},
+		{"COLLATION", COLLATION_SYM},
+		{"UNINSTALL", UNINSTALL_SYM},
+		{"SPATIAL", SPATIAL_SYM},
+		{"BIGINT", BIGINT_SYM},
+		{"STATS_SAMPLE_PAGES", STATS_SAMPLE_PAGES_SYM},
+		{"UTC_DATE", UTC_DATE_SYM},
+		{"DATABASE", DATABASE},
+		{"TRAILING", TRAILING},
+		{"REPLICATION", REPLICATION},
+		{"QB_NAME", QB_NAME_HINT},
+		{"PORT", PORT_SYM},
+		{"KEY_BLOCK_SIZE", KEY_BLOCK_SIZE},
+		{"USER_RESOURCES", RESOURCES},
+		{"FIRST", FIRST_SYM},
+		{"AS", AS},
+		{"SQL_BEFORE_GTIDS", SQL_BEFORE_GTIDS},
+		{"USE_FRM", USE_FRM},
+		{"SUSPEND", SUSPEND_SYM},
+		{"NOT", NOT_SYM},
+		{"RELAY", RELAY},
+		{"SQL", SQL_SYM},
+		{"COMPRESSED", COMPRESSED_SYM},
+		{"SUBPARTITIONS", SUBPARTITIONS_SYM},
+		{"DEFAULT_AUTH", DEFAULT_AUTH_SYM},
+		{"INT1", TINYINT_SYM},
+		{"NATIONAL", NATIONAL_SYM},
+		{"BYTE", BYTE_SYM},
+		{"MASTER_LOG_FILE", OBSOLETE_TOKEN_555},
+		{"FOR", FOR_SYM},
+		{">=", GE},
+		{"DATAFILE", DATAFILE_SYM},
+		{"SYSTEM_USER", USER},
+		{"REBUILD", REBUILD_SYM},
+		{"OPEN", OPEN_SYM},
+		{"MIN_ROWS", MIN_ROWS},
+		{"SWAPS", SWAPS_SYM},
+		{"DEALLOCATE", DEALLOCATE_SYM},
+		{"REDOFILE", OBSOLETE_TOKEN_693},
+		{"NO_RANGE_OPTIMIZATION", NO_RANGE_OPTIMIZATION_HINT},
+		{"ONLY", ONLY_SYM},
+		{"TABLESPACE", TABLESPACE_SYM},
+		{"INSTANCE", INSTANCE_SYM},
+		{"LEAVES", LEAVES},
+		{"FILE_BLOCK_SIZE", FILE_BLOCK_SIZE_SYM},
+		{"VAR_POP", VARIANCE_SYM},
+		{"NATURAL", NATURAL},
+		{"STATS_PERSISTENT", STATS_PERSISTENT_SYM},
+		{"XOR", XOR},
+		{"CHANGE", CHANGE},
+		{"COMPLETION", COMPLETION_SYM},
+		{"SQL_TSI_YEAR", YEAR_SYM},
+		{"GEOMETRYCOLLECTION", GEOMETRYCOLLECTION_SYM},
+		{"CURRENT_TIME", CURTIME},
+		{"COMPACT", COMPACT_SYM},
+		{"CONSTRAINT_NAME", CONSTRAINT_NAME_SYM},
+		{"REFERENCES", REFERENCES},
+		{"SUBCLASS_ORIGIN", SUBCLASS_ORIGIN_SYM},
+		{"OPTIONALLY", OPTIONALLY},
+		{"INTO", INTO},
+		{"END", END},
+		{"SOURCE", SOURCE_SYM},
+		{"SAVEPOINT", SAVEPOINT_SYM},
+		{"GRANT", GRANT},
+		{"MAX_USER_CONNECTIONS", MAX_USER_CONNECTIONS_SYM},
+		{"MONTH", MONTH_SYM},
+		{"RELAY_LOG_FILE", RELAY_LOG_FILE_SYM},
+		{"REVOKE", REVOKE},
+		{"SERIALIZABLE", SERIALIZABLE_SYM},
+		{"VARCHAR", VARCHAR_SYM},
+		{"TABLE", TABLE_SYM},
+		{"SQL_BIG_RESULT", SQL_BIG_RESULT},
+		{"PARTITION", PARTITION_SYM},
+		{"INVOKER", INVOKER_SYM},
+		{"CURTIME", CURTIME},
+		{"COLUMN_FORMAT", COLUMN_FORMAT_SYM},
+		{"DAY_HOUR", DAY_HOUR_SYM},
+		{"CURRENT_USER", CURRENT_USER},
+		{"PRIVILEGES", PRIVILEGES},
+		{"XML", XML_SYM},
+		{"TABLE_CHECKSUM", TABLE_CHECKSUM_SYM},
+		{"BIT_OR", BIT_OR_SYM},
+		{"REORGANIZE", REORGANIZE_SYM},
+		{"VAR_SAMP", VAR_SAMP_SYM},
+		{"BLOB", BLOB_SYM},
+		{"SQL_TSI_MINUTE", MINUTE_SYM},
+		{"MASTER_TLS_VERSION", OBSOLETE_TOKEN_572},
+		{"PROCEDURE", PROCEDURE_SYM},
+		{"X509", X509_SYM},
+		{"<<", SHIFT_LEFT},
+		{"CHECK", CHECK_SYM},
+		{"LOCKS", LOCKS_SYM},
+		{"SIGNED", SIGNED_SYM},
+		{"DISCARD", DISCARD_SYM},
+		{"TYPES", TYPES_SYM},
+		{"DUAL", DUAL_SYM},
+		{"CURSOR", CURSOR_SYM},
+		{"RANGE", RANGE_SYM},
+		{"EXECUTE", EXECUTE_SYM},
+		{"TABLE_NAME", TABLE_NAME_SYM},
+		{"CONSISTENT", CONSISTENT_SYM},
+		{"CURRENT_TIMESTAMP", NOW_SYM},
+		{"PREPARE", PREPARE_SYM},
+		{"BOOLEAN", BOOLEAN_SYM},
+		{"OPTION", OPTION},
+		{"LEVEL", LEVEL_SYM},
+		{"CLOSE", CLOSE_SYM},
+		{"SQLWARNING", SQLWARNING_SYM},
+		{"INT4", INT_SYM},
+		{"CONDITION", CONDITION_SYM},
+		{"CHAR", CHAR_SYM},
+		{"ENUM", ENUM_SYM},
+		{"ALGORITHM", ALGORITHM_SYM},
+		{"EXPANSION", EXPANSION_SYM},
+		{"IO_THREAD", RELAY_THREAD},
+		{"FIELDS", COLUMNS},
+		{"MAX_EXECUTION_TIME", MAX_EXECUTION_TIME_HINT},
+		{"DESC", DESC},
+		{"NO_ICP", NO_ICP_HINT},
+		{"SUBDATE", SUBDATE_SYM},
+		{"REPEAT", REPEAT_SYM},
+		{"HELP", HELP_SYM},
+		{"<>", NE},
+		{"SLAVE", SLAVE},
+		{"RESET", RESET_SYM},
+		{"STOP", STOP_SYM},
+		{"READ_WRITE", READ_WRITE_SYM},
+		{"IMPORT", IMPORT},
+		{"VARIANCE", VARIANCE_SYM},
+		{"SUBSTRING", SUBSTRING},
+		{"MAX", MAX_SYM},
+		{"REDUNDANT", REDUNDANT_SYM},
+		{"CASE", CASE_SYM},
+		{"VARCHARACTER", VARCHAR_SYM},
+		{"THEN", THEN_SYM},
+		{"LOCALTIMESTAMP", NOW_SYM},
+		{"MEMORY", MEMORY_SYM},
+		{"GLOBAL", GLOBAL_SYM},
+		{"IPC", IPC_SYM},
+		{"MAX_ROWS", MAX_ROWS},
+		{"MASTER_HOST", OBSOLETE_TOKEN_554},
+		{"GENERATED", GENERATED},
+		{"RESTRICT", RESTRICT},
+		{"SPECIFIC", SPECIFIC_SYM},
+		{"BIT", BIT_SYM},
+		{"INOUT", INOUT_SYM},
+		{"OWNER", OWNER_SYM},
+		{"DUPSWEEDOUT", DUPSWEEDOUT_HINT},
+		{"TRIGGER", TRIGGER_SYM},
+		{"UPDATE", UPDATE_SYM},
+		{"BINLOG", BINLOG_SYM},
+		{"PARTIAL", PARTIAL},
+		{"ERRORS", ERRORS},
+		{"LANGUAGE", LANGUAGE_SYM},
+		{"PROFILE", PROFILE_SYM},
+		{"TINYBLOB", TINYBLOB_SYM},
+		{"DUMPFILE", DUMPFILE},
+		{"NAMES", NAMES_SYM},
+		{"MASTER_SSL_CRLPATH", OBSOLETE_TOKEN_568},
+		{"ROWS", ROWS_SYM},
+		{"INT", INT_SYM},
+		{"TEMPTABLE", TEMPTABLE_SYM},
+		{"CONVERT", CONVERT_SYM},
+		{"RENAME", RENAME},
+		{"STACKED", STACKED_SYM},
+		{"DELAY_KEY_WRITE", DELAY_KEY_WRITE_SYM},
+		{"PRIMARY", PRIMARY_SYM},
+		{"RELAYLOG", RELAYLOG_SYM},
+		{"LINEAR", LINEAR_SYM},
+		{"MASTER_CONNECT_RETRY", OBSOLETE_TOKEN_552},
+		{"DATE", DATE_SYM},
+		{"BINARY", BINARY_SYM},
+		{"EACH", EACH_SYM},
+		{"ERROR", ERROR_SYM},
+		{"MASTER_BIND", OBSOLETE_TOKEN_551},
+		{"REGEXP", REGEXP},
+		{"ITERATE", ITERATE_SYM},
+		{"QUICK", QUICK},
+		{"MASTER_SSL_CA", OBSOLETE_TOKEN_563},
+		{"INSTALL", INSTALL_SYM},
+		{"TIMESTAMPDIFF", TIMESTAMP_DIFF},
+		{"CPU", CPU_SYM},
+		{"FOLLOWS", FOLLOWS_SYM},
+		{"TRIM", TRIM},
+		{"ACTION", ACTION},
+		{"SCHEMA", DATABASE},
+		{"FAULTS", FAULTS_SYM},
+		{"SQLEXCEPTION", SQLEXCEPTION_SYM},
+		{"YEAR_MONTH", YEAR_MONTH_SYM},
+		{"JSON_ARRAYAGG", JSON_ARRAYAGG},
+		{"LOGFILE", LOGFILE_SYM},
+		{"CHARSET", CHARSET},
+		{"REPLICATE_WILD_IGNORE_TABLE", REPLICATE_WILD_IGNORE_TABLE},
+		{"UNION", UNION_SYM},
+		{"PARTITIONS", PARTITIONS_SYM},
+		{"SEPARATOR", SEPARATOR_SYM},
+	},
+}
+
+// versionedKeywordTable holds the words that do not lex to the same token in every version.
+var versionedKeywordTable = &KeywordTable{
+	Seed:   0,
+	MaxLen: 38,
+	Displace: []uint32{
+		53, 73, 120, 7, 4, 3, 8, 99, 2, 5, 268, 31, 13, 7, 12, 144,
+		10, 131, 132, 10, 2, 163, 25, 6, 47, 164, 42, 8, 8, 20, 192, 325,
+		211, 83, 8, 1, 80, 85, 3, 168, 1, 105, 37, 1, 1020, 564, 3, 52,
+		378, 1, 174, 5, 0, 584, 8671, 11, 2, 15,
+	},
+	Entries: []KeywordEntry{
+		{"HEADER", HEADER_SYM},
+		{"EMPTY", EMPTY_SYM},
+		{"LOCKED", LOCKED_SYM},
+		{"AUTO_REFRESH_SOURCE", AUTO_REFRESH_SOURCE_SYM},
+		{"BERNOULLI", BERNOULLI_SYM},
+		{"RECURSIVE", RECURSIVE_SYM},
+		{"SECONDARY_UNLOAD", SECONDARY_UNLOAD_SYM},
+		{"SOURCE_PUBLIC_KEY_PATH", SOURCE_PUBLIC_KEY_PATH_SYM},
+		{"RESOURCE_GROUP", RESOURCE_GROUP_HINT},
+		{"TIES", TIES_SYM},
+		{"GENERATE", GENERATE_SYM},
+		{"SOURCE_COMPRESSION_ALGORITHMS", SOURCE_COMPRESSION_ALGORITHM_SYM},
+		{"INITIAL", INITIAL_SYM},
+		{"OFF", OFF_SYM},
+		{"SECONDARY", SECONDARY_SYM},
+		{"SOURCE_HEARTBEAT_PERIOD", SOURCE_HEARTBEAT_PERIOD_SYM},
+		{"FINISH", FINISH_SYM},
+		{"MASTER_LOG_FILE", OBSOLETE_TOKEN_555},
+		{"MEMBER", MEMBER_SYM},
+		{"PARSE_TREE", PARSE_TREE_SYM},
+		{"GUIDED", GUIDED_SYM},
+		{"SOURCE_BIND", SOURCE_BIND_SYM},
+		{"VISIBLE", VISIBLE_SYM},
+		{"COMPONENT", COMPONENT_SYM},
+		{"MASTER_SSL_KEY", OBSOLETE_TOKEN_569},
+		{"INTERSECT", INTERSECT_SYM},
+		{"REQUIRE_ROW_FORMAT", REQUIRE_ROW_FORMAT_SYM},
+		{"SQL_CACHE", OBSOLETE_TOKEN_784},
+		{"SOURCE_HOST", SOURCE_HOST_SYM},
+		{"ANALYSE", OBSOLETE_TOKEN_271},
+		{"SRID", SRID_SYM},
+		{"SOURCE_PORT", SOURCE_PORT_SYM},
+		{"REPLICA", REPLICA_SYM},
+		{"LIBRARY", LIBRARY_SYM},
+		{"ADMIN", ADMIN_SYM},
+		{"HISTORY", HISTORY_SYM},
+		{"FIRST_VALUE", FIRST_VALUE_SYM},
+		{"RESTART", RESTART_SYM},
+		{"UNREGISTER", UNREGISTER_SYM},
+		{"SOURCE_USER", SOURCE_USER_SYM},
+		{"FACTOR", FACTOR_SYM},
+		{"PERSIST", PERSIST_SYM},
+		{"SET_VAR", SET_VAR_HINT},
+		{"INT3", MEDIUMINT_SYM},
+		{"MULTIPOLYGON", MULTIPOLYGON_SYM},
+		{"LOG", LOG_SYM},
+		{"NO_GROUP_INDEX", NO_GROUP_INDEX_HINT},
+		{"REUSE", REUSE_SYM},
+		{"SOURCE_AUTO_POSITION", SOURCE_AUTO_POSITION_SYM},
+		{"SOURCE_RETRY_COUNT", SOURCE_RETRY_COUNT_SYM},
+		{"MASTER_SSL_CIPHER", OBSOLETE_TOKEN_566},
+		{"EXTERNAL_FORMAT", EXTERNAL_FORMAT_SYM},
+		{"TABLESAMPLE", TABLESAMPLE_SYM},
+		{"SKIP", SKIP_SYM},
+		{"SETS", SETS_SYM},
+		{"ORDER_INDEX", ORDER_INDEX_HINT},
+		{"OPTIONAL", OPTIONAL_SYM},
+		{"MASTER_SSL_CA", OBSOLETE_TOKEN_563},
+		{"JSON_DUALITY_OBJECT", JSON_DUALITY_OBJECT_SYM},
+		{"JOIN_INDEX", JOIN_INDEX_HINT},
+		{"KEYRING", KEYRING_SYM},
+		{"NESTED", NESTED_SYM},
+		{"SYSTEM", SYSTEM_SYM},
+		{"RELATIONAL", RELATIONAL_SYM},
+		{"RESOURCE", RESOURCE_SYM},
+		{"ARRAY", ARRAY_SYM},
+		{"MASTER_DELAY", OBSOLETE_TOKEN_553},
+		{"DEFINITION", DEFINITION_SYM},
+		{"FILE_PATTERN", FILE_PATTERN_SYM},
+		{"DERIVED_CONDITION_PUSHDOWN", DERIVED_CONDITION_PUSHDOWN_HINT},
+		{"QUALIFY", QUALIFY_SYM},
+		{"EXCLUDE", EXCLUDE_SYM},
+		{"MASTER_LOG_POS", OBSOLETE_TOKEN_556},
+		{"HISTOGRAM", HISTOGRAM_SYM},
+		{"NTH_VALUE", NTH_VALUE_SYM},
+		{"ROW_NUMBER", ROW_NUMBER_SYM},
+		{"GTID_ONLY", GTID_ONLY_SYM},
+		{"ST_COLLECT", ST_COLLECT_SYM},
+		{"MASTER_BIND", OBSOLETE_TOKEN_551},
+		{"TLS", TLS_SYM},
+		{"ALLOW_MISSING_FILES", ALLOW_MISSING_FILES_SYM},
+		{"JOIN_SUFFIX", JOIN_SUFFIX_HINT},
+		{"GTIDS", GTIDS_SYM},
+		{"FILES", FILES_SYM},
+		{"VCPU", VCPU_SYM},
+		{"PERCENT_RANK", PERCENT_RANK_SYM},
+		{"ENFORCED", ENFORCED_SYM},
+		{"UDF_RETURNS", OBSOLETE_TOKEN_848},
+		{"OJ", OJ_SYM},
+		{"SOURCE_SSL_KEY", SOURCE_SSL_KEY_SYM},
+		{"MULTILINESTRING", MULTILINESTRING_SYM},
+		{"MASTER_SSL_CRL", OBSOLETE_TOKEN_567},
+		{"REFERENCE", REFERENCE_SYM},
+		{"SOURCE_ZSTD_COMPRESSION_LEVEL", SOURCE_ZSTD_COMPRESSION_LEVEL_SYM},
+		{"OTHERS", OTHERS_SYM},
+		{"NO_SKIP_SCAN", NO_SKIP_SCAN_HINT},
+		{"MASTER_HOST", OBSOLETE_TOKEN_554},
+		{"INDEX_MERGE", INDEX_MERGE_HINT},
+		{"PARAMETERS", PARAMETERS_SYM},
+		{"REGISTRATION", REGISTRATION_SYM},
+		{"OVER", OVER_SYM},
+		{"MIDDLEINT", MEDIUMINT_SYM},
+		{"SKIP_SCAN", SKIP_SCAN_HINT},
+		{"PASSWORD_LOCK_TIME", PASSWORD_LOCK_TIME_SYM},
+		{"ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS", ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_SYM},
+		{"MULTIPOINT", MULTIPOINT_SYM},
+		{"SOURCE_SSL_CA", SOURCE_SSL_CA_SYM},
+		{"NO_INDEX_MERGE", NO_INDEX_MERGE_HINT},
+		{"CLONE", CLONE_SYM},
+		{"PRECEDING", PRECEDING_SYM},
+		{"FILE_NAME", FILE_NAME_SYM},
+		{"MASTER_AUTO_POSITION", OBSOLETE_TOKEN_550},
+		{"VECTOR", VECTOR_SYM},
+		{"SOURCE_LOG_FILE", SOURCE_LOG_FILE_SYM},
+		{"FOLLOWING", FOLLOWING_SYM},
+		{"MEDIUMTEXT", MEDIUMTEXT_SYM},
+		{"NULLS", NULLS_SYM},
+		{"SOURCE_TLS_CIPHERSUITES", SOURCE_TLS_CIPHERSUITES_SYM},
+		{"DENSE_RANK", DENSE_RANK_SYM},
+		{"SECONDARY_LOAD", SECONDARY_LOAD_SYM},
+		{"SOURCE_CONNECT_RETRY", SOURCE_CONNECT_RETRY_SYM},
+		{"JSON_VALUE", JSON_VALUE_SYM},
+		{"NOWAIT", NOWAIT_SYM},
+		{"MEDIUMINT", MEDIUMINT_SYM},
+		{"NTILE", NTILE_SYM},
+		{"LEAD", LEAD_SYM},
+		{"SERVER_OPTIONS", OBSOLETE_TOKEN_755},
+		{"S3", S3_SYM},
+		{"TABLE_REF_PRIORITY", OBSOLETE_TOKEN_820},
+		{"OLD", OLD_SYM},
+		{"SOURCE_SSL", SOURCE_SSL_SYM},
+		{"ZONE", ZONE_SYM},
+		{"MASTER_RETRY_COUNT", OBSOLETE_TOKEN_559},
+		{"MASTER_SSL_CERT", OBSOLETE_TOKEN_565},
+		{"URI", URI_SYM},
+		{"VALIDATE", VALIDATE_SYM},
+		{"PATH", PATH_SYM},
+		{"SOURCE_SSL_CAPATH", SOURCE_SSL_CAPATH_SYM},
+		{"REDOFILE", OBSOLETE_TOKEN_693},
+		{"AUTO", AUTO_SYM},
+		{"UNBOUNDED", UNBOUNDED_SYM},
+		{"SOURCE_TLS_VERSION", SOURCE_TLS_VERSION_SYM},
+		{"INITIATE", INITIATE_SYM},
+		{"JSON_TABLE", JSON_TABLE_SYM},
+		{"INVISIBLE", INVISIBLE_SYM},
+		{"CHALLENGE_RESPONSE", CHALLENGE_RESPONSE_SYM},
+		{"URL", URL_SYM},
+		{"SOURCE_SSL_CRLPATH", SOURCE_SSL_CRLPATH_SYM},
+		{"ENGINE_ATTRIBUTE", ENGINE_ATTRIBUTE_SYM},
+		{"ROLE", ROLE_SYM},
+		{"FILE_PREFIX", FILE_PREFIX_SYM},
+		{"LATERAL", LATERAL_SYM},
+		{"REPLICAS", REPLICAS_SYM},
+		{"MEDIUMBLOB", MEDIUMBLOB_SYM},
+		{"AUTHENTICATION", AUTHENTICATION_SYM},
+		{"LOCATOR", OBSOLETE_TOKEN_538},
+		{"EXTERNAL", EXTERNAL_SYM},
+		{"CUME_DIST", CUME_DIST_SYM},
+		{"RETURNING", RETURNING_SYM},
+		{"ORDINALITY", ORDINALITY_SYM},
+		{"INACTIVE", INACTIVE_SYM},
+		{"GET_SOURCE_PUBLIC_KEY", GET_SOURCE_PUBLIC_KEY_SYM},
+		{"STRICT_LOAD", STRICT_LOAD_SYM},
+		{"MASTER_CONNECT_RETRY", OBSOLETE_TOKEN_552},
+		{"NO_ORDER_INDEX", NO_ORDER_INDEX_HINT},
+		{"NO_INDEX", NO_INDEX_HINT},
+		{"SOURCE_SSL_CIPHER", SOURCE_SSL_CIPHER_SYM},
+		{"OF", OF_SYM},
+		{"SOURCE_LOG_POS", SOURCE_LOG_POS_SYM},
+		{"SOURCE_SSL_VERIFY_SERVER_CERT", SOURCE_SSL_VERIFY_SERVER_CERT_SYM},
+		{"EXCEPT", EXCEPT_SYM},
+		{"ABSENT", ABSENT_SYM},
+		{"MASTER_PASSWORD", OBSOLETE_TOKEN_557},
+		{"RESPECT", RESPECT_SYM},
+		{"MASTER_PORT", OBSOLETE_TOKEN_558},
+		{"RANK", RANK_SYM},
+		{"MASTER_SSL_CAPATH", OBSOLETE_TOKEN_564},
+		{"SOURCE_CONNECTION_AUTO_FAILOVER", SOURCE_CONNECTION_AUTO_FAILOVER_SYM},
+		{"FAILED_LOGIN_ATTEMPTS", FAILED_LOGIN_ATTEMPTS_SYM},
+		{"NO_MERGE", NO_DERIVED_MERGE_HINT},
+		{"HASH_JOIN", HASH_JOIN_HINT},
+		{"MASTER_USER", OBSOLETE_TOKEN_573},
+		{"SOURCE_SSL_CERT", SOURCE_SSL_CERT_SYM},
+		{"SECONDARY_ENGINE", SECONDARY_ENGINE_SYM},
+		{"DESCRIPTION", DESCRIPTION_SYM},
+		{"BULK", BULK_SYM},
+		{"VERIFY_KEY_CONSTRAINTS", VERIFY_KEY_CONSTRAINTS_SYM},
+		{"NO_HASH_JOIN", NO_HASH_JOIN_HINT},
+		{"SOURCE_DELAY", SOURCE_DELAY_SYM},
+		{"GROUPS", GROUPS_SYM},
+		{"DES_KEY_FILE", OBSOLETE_TOKEN_388},
+		{"ACTIVE", ACTIVE_SYM},
+		{"NO_JOIN_INDEX", NO_JOIN_INDEX_HINT},
+		{"PARALLEL", PARALLEL_SYM},
+		{"ORGANIZATION", ORGANIZATION_SYM},
+		{"AUTO_REFRESH", AUTO_REFRESH_SYM},
+		{"JOIN_FIXED_ORDER", JOIN_FIXED_ORDER_HINT},
+		{"MATERIALIZED", MATERIALIZED_SYM},
+		{"PERSIST_ONLY", PERSIST_ONLY_SYM},
+		{"MASTER_TLS_VERSION", OBSOLETE_TOKEN_572},
+		{"LAST_VALUE", LAST_VALUE_SYM},
+		{"MASTER_SERVER_ID", OBSOLETE_TOKEN_561},
+		{"FILE_FORMAT", FILE_FORMAT_SYM},
+		{"MANUAL", MANUAL_SYM},
+		{"WINDOW", WINDOW_SYM},
+		{"GET_MASTER_PUBLIC_KEY", OBSOLETE_TOKEN_967},
+		{"SOURCE_PASSWORD", SOURCE_PASSWORD_SYM},
+		{"GROUPING", GROUPING_SYM},
+		{"MASTER_SSL_VERIFY_SERVER_CERT", OBSOLETE_TOKEN_570},
+		{"MASTER_SSL_CRLPATH", OBSOLETE_TOKEN_568},
+		{"JOIN_PREFIX", JOIN_PREFIX_HINT},
+		{"SECONDARY_ENGINE_ATTRIBUTE", SECONDARY_ENGINE_ATTRIBUTE_SYM},
+		{"THREAD_PRIORITY", THREAD_PRIORITY_SYM},
+		{"NETWORK_NAMESPACE", NETWORK_NAMESPACE_SYM},
+		{"PRIVILEGE_CHECKS_USER", PRIVILEGE_CHECKS_USER_SYM},
+		{"RETAIN", RETAIN_SYM},
+		{"STREAM", STREAM_SYM},
+		{"ATTRIBUTE", ATTRIBUTE_SYM},
+		{"RANDOM", RANDOM_SYM},
+		{"REQUIRE_TABLE_PRIMARY_KEY_CHECK", REQUIRE_TABLE_PRIMARY_KEY_CHECK_SYM},
+		{"JOIN_ORDER", JOIN_ORDER_HINT},
+		{"DUALITY", DUALITY_SYM},
+		{"LAG", LAG_SYM},
+		{"MASTER_SSL", OBSOLETE_TOKEN_562},
+		{"SOURCE_SSL_CRL", SOURCE_SSL_CRL_SYM},
+		{"WITH_CUBE", OBSOLETE_TOKEN_893},
+		{"BUCKETS", BUCKETS_SYM},
+		{"PARSE_GCOL_EXPR", OBSOLETE_TOKEN_654},
+		{"NO_DERIVED_CONDITION_PUSHDOWN", NO_DERIVED_CONDITION_PUSHDOWN_HINT},
+		{"GROUP_INDEX", GROUP_INDEX_HINT},
+	},
+}
+
+// hintKeywordTable holds the optimizer hint names.
+var hintKeywordTable = &KeywordTable{
+	Seed:   0,
+	MaxLen: 29,
+	Displace: []uint32{
+		45, 2, 1, 76, 37, 20, 18, 31, 5, 28, 3,
+	},
+	Entries: []KeywordEntry{
+		{"NO_JOIN_INDEX", NO_JOIN_INDEX_HINT},
+		{"NO_SEMIJOIN", NO_SEMIJOIN_HINT},
+		{"NO_MERGE", NO_DERIVED_MERGE_HINT},
+		{"DUPSWEEDOUT", DUPSWEEDOUT_HINT},
+		{"BNL", BNL_HINT},
+		{"MRR", MRR_HINT},
+		{"INTOEXISTS", INTOEXISTS_HINT},
+		{"SEMIJOIN", SEMIJOIN_HINT},
+		{"MERGE", DERIVED_MERGE_HINT},
+		{"JOIN_ORDER", JOIN_ORDER_HINT},
+		{"NO_GROUP_INDEX", NO_GROUP_INDEX_HINT},
+		{"JOIN_PREFIX", JOIN_PREFIX_HINT},
+		{"SUBQUERY", SUBQUERY_HINT},
+		{"HASH_JOIN", HASH_JOIN_HINT},
+		{"SET_VAR", SET_VAR_HINT},
+		{"JOIN_INDEX", JOIN_INDEX_HINT},
+		{"INDEX_MERGE", INDEX_MERGE_HINT},
+		{"MAX_EXECUTION_TIME", MAX_EXECUTION_TIME_HINT},
+		{"NO_SKIP_SCAN", NO_SKIP_SCAN_HINT},
+		{"RESOURCE_GROUP", RESOURCE_GROUP_HINT},
+		{"INDEX", INDEX_HINT},
+		{"NO_ORDER_INDEX", NO_ORDER_INDEX_HINT},
+		{"NO_INDEX", NO_INDEX_HINT},
+		{"JOIN_FIXED_ORDER", JOIN_FIXED_ORDER_HINT},
+		{"NO_MRR", NO_MRR_HINT},
+		{"GROUP_INDEX", GROUP_INDEX_HINT},
+		{"NO_BNL", NO_BNL_HINT},
+		{"LOOSESCAN", LOOSESCAN_HINT},
+		{"ORDER_INDEX", ORDER_INDEX_HINT},
+		{"SKIP_SCAN", SKIP_SCAN_HINT},
+		{"NO_BKA", NO_BKA_HINT},
+		{"BKA", BKA_HINT},
+		{"DERIVED_CONDITION_PUSHDOWN", DERIVED_CONDITION_PUSHDOWN_HINT},
+		{"QB_NAME", QB_NAME_HINT},
+		{"NO_RANGE_OPTIMIZATION", NO_RANGE_OPTIMIZATION_HINT},
+		{"NO_INDEX_MERGE", NO_INDEX_MERGE_HINT},
+		{"NO_DERIVED_CONDITION_PUSHDOWN", NO_DERIVED_CONDITION_PUSHDOWN_HINT},
+		{"JOIN_SUFFIX", JOIN_SUFFIX_HINT},
+		{"MATERIALIZATION", MATERIALIZATION_HINT},
+		{"NO_HASH_JOIN", NO_HASH_JOIN_HINT},
+		{"NO_ICP", NO_ICP_HINT},
+		{"FIRSTMATCH", FIRSTMATCH_HINT},
+	},
+}
@@ -1,936 +1,946 @@
+// Code generated by tokengen from MySQL sources. DO NOT EDIT.
+
 package internal
 
-// TokenKeywords Keyword map derived from sql/lex.h
-var TokenKeywords = map[string]int{
-	"&&":                                     AND_AND_SYM,
-	"<":                                      LT,
-	"<=":                                     LE,
-	"<>":                                     NE,
-	"!=":                                     NE,
-	"=":                                      EQ,
-	">":                                      GT_SYM,
-	">=":                                     GE,
-	"<<":                                     SHIFT_LEFT,
-	">>":                                     SHIFT_RIGHT,
-	"<=>":                                    EQUAL_SYM,
-	"ABSENT":                                 ABSENT_SYM,
-	"ACCESSIBLE":                             ACCESSIBLE_SYM,
-	"ACCOUNT":                                ACCOUNT_SYM,
-	"ACTION":                                 ACTION,
-	"ACTIVE":                                 ACTIVE_SYM,
-	"ADD":                                    ADD,
-	"ADMIN":                                  ADMIN_SYM,
-	"AFTER":                                  AFTER_SYM,
-	"AGAINST":                                AGAINST,
-	"AGGREGATE":                              AGGREGATE_SYM,
-	"ALL":                                    ALL,
-	"ALLOW_MISSING_FILES":                    ALLOW_MISSING_FILES_SYM,
-	"ALGORITHM":                              ALGORITHM_SYM,
-	"ALTER":                                  ALTER,
-	"ALWAYS":                                 ALWAYS_SYM,
-	"ANALYZE":                                ANALYZE_SYM,
-	"AND":                                    AND_SYM,
-	"ANY":                                    ANY_SYM,
-	"ARRAY":                                  ARRAY_SYM,
-	"AS":                                     AS,
-	"ASC":                                    ASC,
-	"ASCII":                                  ASCII_SYM,
-	"ASENSITIVE":                             ASENSITIVE_SYM,
-	"AT":                                     AT_SYM,
-	"ATTRIBUTE":                              ATTRIBUTE_SYM,
-	"AUTHENTICATION":                         AUTHENTICATION_SYM,
-	"AUTO":                                   AUTO_SYM,
-	"AUTO_INCREMENT":                         AUTO_INC,
-	"AUTO_REFRESH":                           AUTO_REFRESH_SYM,
-	"AUTO_REFRESH_SOURCE":                    AUTO_REFRESH_SOURCE_SYM,
-	"AUTOEXTEND_SIZE":                        AUTOEXTEND_SIZE_SYM,
-	"AVG":                                    AVG_SYM,
-	"AVG_ROW_LENGTH":                         AVG_ROW_LENGTH,
-	"BACKUP":                                 BACKUP_SYM,
-	"BEFORE":                                 BEFORE_SYM,
-	"BEGIN":                                  BEGIN_SYM,
-	"BERNOULLI":                              BERNOULLI_SYM,
-	"BETWEEN":                                BETWEEN_SYM,
-	"BIGINT":                                 BIGINT_SYM,
-	"BINARY":                                 BINARY_SYM,
-	"BINLOG":                                 BINLOG_SYM,
-	"BIT":                                    BIT_SYM,
-	"BLOB":                                   BLOB_SYM,
-	"BLOCK":                                  BLOCK_SYM,
-	"BOOL":                                   BOOL_SYM,
-	"BOOLEAN":                                BOOLEAN_SYM,
-	"BOTH":                                   BOTH,
-	"BTREE":                                  BTREE_SYM,
-	"BUCKETS":                                BUCKETS_SYM,
-	"BULK":                                   BULK_SYM,
-	"BY":                                     BY,
-	"BYTE":                                   BYTE_SYM,
-	"CACHE":                                  CACHE_SYM,
-	"CALL":                                   CALL_SYM,
-	"CASCADE":                                CASCADE,
-	"CASCADED":                               CASCADED,
-	"CASE":                                   CASE_SYM,
-	"CATALOG_NAME":                           CATALOG_NAME_SYM,
-	"CHAIN":                                  CHAIN_SYM,
-	"CHALLENGE_RESPONSE":                     CHALLENGE_RESPONSE_SYM,
-	"CHANGE":                                 CHANGE,
-	"CHANGED":                                CHANGED,
-	"CHANNEL":                                CHANNEL_SYM,
-	"CHAR":                                   CHAR_SYM,
-	"CHARACTER":                              CHAR_SYM,
-	"CHARSET":                                CHARSET,
-	"CHECK":                                  CHECK_SYM,
-	"CHECKSUM":                               CHECKSUM_SYM,
-	"CIPHER":                                 CIPHER_SYM,
-	"CLASS_ORIGIN":                           CLASS_ORIGIN_SYM,
-	"CLIENT":                                 CLIENT_SYM,
-	"CLONE":                                  CLONE_SYM,
-	"CLOSE":                                  CLOSE_SYM,
-	"COALESCE":                               COALESCE,
-	"CODE":                                   CODE_SYM,
-	"COLLATE":                                COLLATE_SYM,
-	"COLLATION":                              COLLATION_SYM,
-	"COLUMN":                                 COLUMN_SYM,
-	"COLUMN_FORMAT":                          COLUMN_FORMAT_SYM,
-	"COLUMN_NAME":                            COLUMN_NAME_SYM,
-	"COLUMNS":                                COLUMNS,
-	"COMMENT":                                COMMENT_SYM,
-	"COMMIT":                                 COMMIT_SYM,
-	"COMMITTED":                              COMMITTED_SYM,
-	"COMPACT":                                COMPACT_SYM,
-	"COMPLETION":                             COMPLETION_SYM,
-	"COMPONENT":                              COMPONENT_SYM,
-	"COMPRESSION":                            COMPRESSION_SYM,
-	"COMPRESSED":                             COMPRESSED_SYM,
-	"ENCRYPTION":                             ENCRYPTION_SYM,
-	"CONCURRENT":                             CONCURRENT,
-	"CONDITION":                              CONDITION_SYM,
-	"CONNECTION":                             CONNECTION_SYM,
-	"CONSISTENT":                             CONSISTENT_SYM,
-	"CONSTRAINT":                             CONSTRAINT,
-	"CONSTRAINT_CATALOG":                     CONSTRAINT_CATALOG_SYM,
-	"CONSTRAINT_NAME":                        CONSTRAINT_NAME_SYM,
-	"CONSTRAINT_SCHEMA":                      CONSTRAINT_SCHEMA_SYM,
-	"CONTAINS":                               CONTAINS_SYM,
-	"CONTEXT":                                CONTEXT_SYM,
-	"CONTINUE":                               CONTINUE_SYM,
-	"CONVERT":                                CONVERT_SYM,
-	"CPU":                                    CPU_SYM,
-	"CREATE":                                 CREATE,
-	"CROSS":                                  CROSS,
-	"CUBE":                                   CUBE_SYM,
-	"CUME_DIST":                              CUME_DIST_SYM,
-	"CURRENT":                                CURRENT_SYM,
-	"CURRENT_DATE":                           CURDATE,
-	"CURRENT_TIME":                           CURTIME,
-	"CURRENT_TIMESTAMP":                      NOW_SYM,
-	"CURRENT_USER":                           CURRENT_USER,
-	"CURSOR":                                 CURSOR_SYM,
-	"CURSOR_NAME":                            CURSOR_NAME_SYM,
-	"DATA":                                   DATA_SYM,
-	"DATABASE":                               DATABASE,
-	"DATABASES":                              DATABASES,
-	"DATAFILE":                               DATAFILE_SYM,
-	"DATE":                                   DATE_SYM,
-	"DATETIME":                               DATETIME_SYM,
-	"DAY":                                    DAY_SYM,
-	"DAY_HOUR":                               DAY_HOUR_SYM,
-	"DAY_MICROSECOND":                        DAY_MICROSECOND_SYM,
-	"DAY_MINUTE":                             DAY_MINUTE_SYM,
-	"DAY_SECOND":                             DAY_SECOND_SYM,
-	"DEALLOCATE":                             DEALLOCATE_SYM,
-	"DEC":                                    DECIMAL_SYM,
-	"DECIMAL":                                DECIMAL_SYM,
-	"DECLARE":                                DECLARE_SYM,
-	"DEFAULT":                                DEFAULT_SYM,
-	"DEFAULT_AUTH":                           DEFAULT_AUTH_SYM,
-	"DEFINER":                                DEFINER_SYM,
-	"DEFINITION":                             DEFINITION_SYM,
-	"DELAYED":                                DELAYED_SYM,
-	"DELAY_KEY_WRITE":                        DELAY_KEY_WRITE_SYM,
-	"DENSE_RANK":                             DENSE_RANK_SYM,
-	"DESC":                                   DESC,
-	"DESCRIBE":                               DESCRIBE,
-	"DESCRIPTION":                            DESCRIPTION_SYM,
-	"DETERMINISTIC":                          DETERMINISTIC_SYM,
-	"DIAGNOSTICS":                            DIAGNOSTICS_SYM,
-	"DIRECTORY":                              DIRECTORY_SYM,
-	"DISABLE":                                DISABLE_SYM,
-	"DISCARD":                                DISCARD_SYM,
-	"DISK":                                   DISK_SYM,
-	"DISTINCT":                               DISTINCT,
-	"DISTINCTROW":                            DISTINCT,
-	"DIV":                                    DIV_SYM,
-	"DO":                                     DO_SYM,
-	"DOUBLE":                                 DOUBLE_SYM,
-	"DROP":                                   DROP,
-	"DUAL":                                   DUAL_SYM,
-	"DUALITY":                                DUALITY_SYM,
-	"DUMPFILE":                               DUMPFILE,
-	"DUPLICATE":                              DUPLICATE_SYM,
-	"DYNAMIC":                                DYNAMIC_SYM,
-	"EACH":                                   EACH_SYM,
-	"ELSE":                                   ELSE,
-	"ELSEIF":                                 ELSEIF_SYM,
-	"EMPTY":                                  EMPTY_SYM,
-	"ENABLE":                                 ENABLE_SYM,
-	"ENCLOSED":                               ENCLOSED,
-	"END":                                    END,
-	"ENDS":                                   ENDS_SYM,
-	"ENFORCED":                               ENFORCED_SYM,
-	"ENGINE":                                 ENGINE_SYM,
-	"ENGINE_ATTRIBUTE":                       ENGINE_ATTRIBUTE_SYM,
-	"ENGINES":                                ENGINES_SYM,
-	"ENUM":                                   ENUM_SYM,
-	"ERROR":                                  ERROR_SYM,
-	"ERRORS":                                 ERRORS,
-	"ESCAPE":                                 ESCAPE_SYM,
-	"ESCAPED":                                ESCAPED,
-	"EVENT":                                  EVENT_SYM,
-	"EVENTS":                                 EVENTS_SYM,
-	"EVERY":                                  EVERY_SYM,
-	"EXCEPT":                                 EXCEPT_SYM,
-	"EXCHANGE":                               EXCHANGE_SYM,
-	"EXCLUDE":                                EXCLUDE_SYM,
-	"EXECUTE":                                EXECUTE_SYM,
-	"EXISTS":                                 EXISTS,
-	"EXIT":                                   EXIT_SYM,
-	"EXPANSION":                              EXPANSION_SYM,
-	"EXPORT":                                 EXPORT_SYM,
-	"EXPIRE":                                 EXPIRE_SYM,
-	"EXPLAIN":                                DESCRIBE,
-	"EXTENDED":                               EXTENDED_SYM,
-	"EXTENT_SIZE":                            EXTENT_SIZE_SYM,
-	"EXTERNAL":                               EXTERNAL_SYM,
-	"EXTERNAL_FORMAT":                        EXTERNAL_FORMAT_SYM,
-	"FACTOR":                                 FACTOR_SYM,
-	"FAILED_LOGIN_ATTEMPTS":                  FAILED_LOGIN_ATTEMPTS_SYM,
-	"FALSE":                                  FALSE_SYM,
-	"FAST":                                   FAST_SYM,
-	"FAULTS":                                 FAULTS_SYM,
-	"FETCH":                                  FETCH_SYM,
-	"FIELDS":                                 COLUMNS,
-	"FILE":                                   FILE_SYM,
-	"FILES":                                  FILES_SYM,
-	"FILE_BLOCK_SIZE":                        FILE_BLOCK_SIZE_SYM,
-	"FILE_FORMAT":                            FILE_FORMAT_SYM,
-	"FILE_NAME":                              FILE_NAME_SYM,
-	"FILE_PATTERN":                           FILE_PATTERN_SYM,
-	"FILE_PREFIX":                            FILE_PREFIX_SYM,
-	"FILTER":                                 FILTER_SYM,
-	"FINISH":                                 FINISH_SYM,
-	"FIRST":                                  FIRST_SYM,
-	"FIRST_VALUE":                            FIRST_VALUE_SYM,
-	"FIXED":                                  FIXED_SYM,
-	"FLOAT":                                  FLOAT_SYM,
-	"FLOAT4":                                 FLOAT_SYM,
-	"FLOAT8":                                 DOUBLE_SYM,
-	"FLUSH":                                  FLUSH_SYM,
-	"FOLLOWS":                                FOLLOWS_SYM,
-	"FOLLOWING":                              FOLLOWING_SYM,
-	"FOR":                                    FOR_SYM,
-	"FORCE":                                  FORCE_SYM,
-	"FOREIGN":                                FOREIGN,
-	"FORMAT":                                 FORMAT_SYM,
-	"FOUND":                                  FOUND_SYM,
-	"FROM":                                   FROM,
-	"FULL":                                   FULL,
-	"FULLTEXT":                               FULLTEXT_SYM,
-	"FUNCTION":                               FUNCTION_SYM,
-	"GENERAL":                                GENERAL,
-	"GROUP_REPLICATION":                      GROUP_REPLICATION,
-	"GEOMCOLLECTION":                         GEOMETRYCOLLECTION_SYM,
-	"GEOMETRY":                               GEOMETRY_SYM,
-	"GEOMETRYCOLLECTION":                     GEOMETRYCOLLECTION_SYM,
-	"GET_FORMAT":                             GET_FORMAT,
-	"GET_MASTER_PUBLIC_KEY":                  OBSOLETE_TOKEN_967,
-	"GET_SOURCE_PUBLIC_KEY":                  GET_SOURCE_PUBLIC_KEY_SYM,
-	"GET":                                    GET_SYM,
-	"GENERATE":                               GENERATE_SYM,
-	"GENERATED":                              GENERATED,
-	"GLOBAL":                                 GLOBAL_SYM,
-	"GRANT":                                  GRANT,
-	"GRANTS":                                 GRANTS,
-	"GROUP":                                  GROUP_SYM,
-	"GROUPING":                               GROUPING_SYM,
-	"GROUPS":                                 GROUPS_SYM,
-	"GTIDS":                                  GTIDS_SYM,
-	"GTID_ONLY":                              GTID_ONLY_SYM,
-	"GUIDED":                                 GUIDED_SYM,
-	"HANDLER":                                HANDLER_SYM,
-	"HASH":                                   HASH_SYM,
-	"HAVING":                                 HAVING,
-	"HEADER":                                 HEADER_SYM,
-	"HELP":                                   HELP_SYM,
-	"HIGH_PRIORITY":                          HIGH_PRIORITY,
-	"HISTOGRAM":                              HISTOGRAM_SYM,
-	"HISTORY":                                HISTORY_SYM,
-	"HOST":                                   HOST_SYM,
-	"HOSTS":                                  HOSTS_SYM,
-	"HOUR":                                   HOUR_SYM,
-	"HOUR_MICROSECOND":                       HOUR_MICROSECOND_SYM,
-	"HOUR_MINUTE":                            HOUR_MINUTE_SYM,
-	"HOUR_SECOND":                            HOUR_SECOND_SYM,
-	"IDENTIFIED":                             IDENTIFIED_SYM,
-	"IF":                                     IF,
-	"IGNORE":                                 IGNORE_SYM,
-	"IGNORE_SERVER_IDS":                      IGNORE_SERVER_IDS_SYM,
-	"IMPORT":                                 IMPORT,
-	"IN":                                     IN_SYM,
-	"INACTIVE":                               INACTIVE_SYM,
-	"INDEX":                                  INDEX_SYM,
-	"INDEXES":                                INDEXES,
-	"INFILE":                                 INFILE_SYM,
-	"INITIAL":                                INITIAL_SYM,
-	"INITIAL_SIZE":                           INITIAL_SIZE_SYM,
-	"INITIATE":                               INITIATE_SYM,
-	"INNER":                                  INNER_SYM,
-	"INOUT":                                  INOUT_SYM,
-	"INSENSITIVE":                            INSENSITIVE_SYM,
-	"INSERT_METHOD":                          INSERT_METHOD,
-	"INSTALL":                                INSTALL_SYM,
-	"INSTANCE":                               INSTANCE_SYM,
-	"INT":                                    INT_SYM,
-	"INT1":                                   TINYINT_SYM,
-	"INT2":                                   SMALLINT_SYM,
-	"INT3":                                   MEDIUMINT_SYM,
-	"INT4":                                   INT_SYM,
-	"INT8":                                   BIGINT_SYM,
-	"INTEGER":                                INT_SYM,
-	"INTERSECT":                              INTERSECT_SYM,
-	"INTERVAL":                               INTERVAL_SYM,
-	"INTO":                                   INTO,
-	"IO":                                     IO_SYM,
-	"IO_AFTER_GTIDS":                         IO_AFTER_GTIDS,
-	"IO_BEFORE_GTIDS":                        IO_BEFORE_GTIDS,
-	"IO_THREAD":                              RELAY_THREAD,
-	"IPC":                                    IPC_SYM,
-	"IS":                                     IS,
-	"ISOLATION":                              ISOLATION,
-	"ISSUER":                                 ISSUER_SYM,
-	"ITERATE":                                ITERATE_SYM,
-	"INVISIBLE":                              INVISIBLE_SYM,
-	"INVOKER":                                INVOKER_SYM,
-	"JOIN":                                   JOIN_SYM,
-	"JSON":                                   JSON_SYM,
-	"JSON_TABLE":                             JSON_TABLE_SYM,
-	"JSON_VALUE":                             JSON_VALUE_SYM,
-	"KEY":                                    KEY_SYM,
-	"KEYRING":                                KEYRING_SYM,
-	"KEYS":                                   KEYS,
-	"KEY_BLOCK_SIZE":                         KEY_BLOCK_SIZE,
-	"KILL":                                   KILL_SYM,
-	"LAG":                                    LAG_SYM,
-	"LANGUAGE":                               LANGUAGE_SYM,
-	"LAST":                                   LAST_SYM,
-	"LAST_VALUE":                             LAST_VALUE_SYM,
-	"LATERAL":                                LATERAL_SYM,
-	"LEAD":                                   LEAD_SYM,
-	"LEADING":                                LEADING,
-	"LEAVE":                                  LEAVE_SYM,
-	"LEAVES":                                 LEAVES,
-	"LEFT":                                   LEFT,
-	"LESS":                                   LESS_SYM,
-	"LEVEL":                                  LEVEL_SYM,
-	"LIBRARY":                                LIBRARY_SYM,
-	"LIKE":                                   LIKE,
-	"LIMIT":                                  LIMIT,
-	"LINEAR":                                 LINEAR_SYM,
-	"LINES":                                  LINES,
-	"LINESTRING":                             LINESTRING_SYM,
-	"LIST":                                   LIST_SYM,
-	"LOAD":                                   LOAD,
-	"LOCAL":                                  LOCAL_SYM,
-	"LOCALTIME":                              NOW_SYM,
-	"LOCALTIMESTAMP":                         NOW_SYM,
-	"LOCK":                                   LOCK_SYM,
-	"LOCKED":                                 LOCKED_SYM,
-	"LOCKS":                                  LOCKS_SYM,
-	"LOGFILE":                                LOGFILE_SYM,
-	"LOGS":                                   LOGS_SYM,
-	"LOG":                                    LOG_SYM,
-	"LONG":                                   LONG_SYM,
-	"LONGBLOB":                               LONGBLOB_SYM,
-	"LONGTEXT":                               LONGTEXT_SYM,
-	"LOOP":                                   LOOP_SYM,
-	"LOW_PRIORITY":                           LOW_PRIORITY,
-	"MANUAL":                                 MANUAL_SYM,
-	"MASTER":                                 MASTER_SYM,
-	"MATCH":                                  MATCH,
-	"MATERIALIZED":                           MATERIALIZED_SYM,
-	"MAX_CONNECTIONS_PER_HOUR":               MAX_CONNECTIONS_PER_HOUR,
-	"MAX_QUERIES_PER_HOUR":                   MAX_QUERIES_PER_HOUR,
-	"MAX_ROWS":                               MAX_ROWS,
-	"MAX_SIZE":                               MAX_SIZE_SYM,
-	"MAX_UPDATES_PER_HOUR":                   MAX_UPDATES_PER_HOUR,
-	"MAX_USER_CONNECTIONS":                   MAX_USER_CONNECTIONS_SYM,
-	"MAXVALUE":                               MAX_VALUE_SYM,
-	"MEDIUM":                                 MEDIUM_SYM,
-	"MEDIUMBLOB":                             MEDIUMBLOB_SYM,
-	"MEDIUMINT":                              MEDIUMINT_SYM,
-	"MEDIUMTEXT":                             MEDIUMTEXT_SYM,
-	"MEMBER":                                 MEMBER_SYM,
-	"MEMORY":                                 MEMORY_SYM,
-	"MERGE":                                  MERGE_SYM,
-	"MESSAGE_TEXT":                           MESSAGE_TEXT_SYM,
-	"MICROSECOND":                            MICROSECOND_SYM,
-	"MIDDLEINT":                              MEDIUMINT_SYM,
-	"MIGRATE":                                MIGRATE_SYM,
-	"MINUTE":                                 MINUTE_SYM,
-	"MINUTE_MICROSECOND":                     MINUTE_MICROSECOND_SYM,
-	"MINUTE_SECOND":                          MINUTE_SECOND_SYM,
-	"MIN_ROWS":                               MIN_ROWS,
-	"MOD":                                    MOD_SYM,
-	"MODE":                                   MODE_SYM,
-	"MODIFIES":                               MODIFIES_SYM,
-	"MODIFY":                                 MODIFY_SYM,
-	"MONTH":                                  MONTH_SYM,
-	"MULTILINESTRING":                        MULTILINESTRING_SYM,
-	"MULTIPOINT":                             MULTIPOINT_SYM,
-	"MULTIPOLYGON":                           MULTIPOLYGON_SYM,
-	"MUTEX":                                  MUTEX_SYM,
-	"MYSQL_ERRNO":                            MYSQL_ERRNO_SYM,
-	"NAME":                                   NAME_SYM,
-	"NAMES":                                  NAMES_SYM,
-	"NATIONAL":                               NATIONAL_SYM,
-	"NATURAL":                                NATURAL,
-	"NDB":                                    NDBCLUSTER_SYM,
-	"NDBCLUSTER":                             NDBCLUSTER_SYM,
-	"NCHAR":                                  NCHAR_SYM,
-	"NESTED":                                 NESTED_SYM,
-	"NETWORK_NAMESPACE":                      NETWORK_NAMESPACE_SYM,
-	"NEVER":                                  NEVER_SYM,
-	"NEW":                                    NEW_SYM,
-	"NEXT":                                   NEXT_SYM,
-	"NO":                                     NO_SYM,
-	"NO_WAIT":                                NO_WAIT_SYM,
-	"NOWAIT":                                 NOWAIT_SYM,
-	"NODEGROUP":                              NODEGROUP_SYM,
-	"NONE":                                   NONE_SYM,
-	"NOT":                                    NOT_SYM,
-	"NO_WRITE_TO_BINLOG":                     NO_WRITE_TO_BINLOG,
-	"NTH_VALUE":                              NTH_VALUE_SYM,
-	"NTILE":                                  NTILE_SYM,
-	"NULL":                                   NULL_SYM,
-	"NULLS":                                  NULLS_SYM,
-	"NUMBER":                                 NUMBER_SYM,
-	"NUMERIC":                                NUMERIC_SYM,
-	"NVARCHAR":                               NVARCHAR_SYM,
-	"OF":                                     OF_SYM,
-	"OFF":                                    OFF_SYM,
-	"OFFSET":                                 OFFSET_SYM,
-	"OJ":                                     OJ_SYM,
-	"OLD":                                    OLD_SYM,
-	"ON":                                     ON_SYM,
-	"ONE":                                    ONE_SYM,
-	"ONLY":                                   ONLY_SYM,
-	"OPEN":                                   OPEN_SYM,
-	"OPTIMIZE":                               OPTIMIZE,
-	"OPTIMIZER_COSTS":                        OPTIMIZER_COSTS_SYM,
-	"OPTIONS":                                OPTIONS_SYM,
-	"OPTION":                                 OPTION,
-	"OPTIONAL":                               OPTIONAL_SYM,
-	"OPTIONALLY":                             OPTIONALLY,
-	"OR":                                     OR_SYM,
-	"ORGANIZATION":                           ORGANIZATION_SYM,
-	"OTHERS":                                 OTHERS_SYM,
-	"ORDER":                                  ORDER_SYM,
-	"ORDINALITY":                             ORDINALITY_SYM,
-	"OUT":                                    OUT_SYM,
-	"OUTER":                                  OUTER_SYM,
-	"OUTFILE":                                OUTFILE,
-	"OVER":                                   OVER_SYM,
-	"OWNER":                                  OWNER_SYM,
-	"PACK_KEYS":                              PACK_KEYS_SYM,
-	"PATH":                                   PATH_SYM,
-	"PARAMETERS":                             PARAMETERS_SYM,
-	"PARSE_TREE":                             PARSE_TREE_SYM,
-	"PARSER":                                 PARSER_SYM,
-	"PAGE":                                   PAGE_SYM,
-	"PARALLEL":                               PARALLEL_SYM,
-	"PARTIAL":                                PARTIAL,
-	"PARTITION":                              PARTITION_SYM,
-	"PARTITIONING":                           PARTITIONING_SYM,
-	"PARTITIONS":                             PARTITIONS_SYM,
-	"PASSWORD":                               PASSWORD,
-	"PASSWORD_LOCK_TIME":                     PASSWORD_LOCK_TIME_SYM,
-	"PERCENT_RANK":                           PERCENT_RANK_SYM,
-	"PERSIST":                                PERSIST_SYM,
-	"PERSIST_ONLY":                           PERSIST_ONLY_SYM,
-	"PHASE":                                  PHASE_SYM,
-	"PLUGIN":                                 PLUGIN_SYM,
-	"PLUGINS":                                PLUGINS_SYM,
-	"PLUGIN_DIR":                             PLUGIN_DIR_SYM,
-	"POINT":                                  POINT_SYM,
-	"POLYGON":                                POLYGON_SYM,
-	"PORT":                                   PORT_SYM,
-	"PRECEDES":                               PRECEDES_SYM,
-	"PRECEDING":                              PRECEDING_SYM,
-	"PRECISION":                              PRECISION,
-	"PREPARE":                                PREPARE_SYM,
-	"PRESERVE":                               PRESERVE_SYM,
-	"PREV":                                   PREV_SYM,
-	"PRIMARY":                                PRIMARY_SYM,
-	"PRIVILEGES":                             PRIVILEGES,
-	"PRIVILEGE_CHECKS_USER":                  PRIVILEGE_CHECKS_USER_SYM,
-	"PROCEDURE":                              PROCEDURE_SYM,
-	"PROCESS":                                PROCESS,
-	"PROCESSLIST":                            PROCESSLIST_SYM,
-	"PROFILE":                                PROFILE_SYM,
-	"PROFILES":                               PROFILES_SYM,
-	"PROXY":                                  PROXY_SYM,
-	"PURGE":                                  PURGE,
-	"QUALIFY":                                QUALIFY_SYM,
-	"QUARTER":                                QUARTER_SYM,
-	"QUERY":                                  QUERY_SYM,
-	"QUICK":                                  QUICK,
-	"RANDOM":                                 RANDOM_SYM,
-	"RANK":                                   RANK_SYM,
-	"RANGE":                                  RANGE_SYM,
-	"READ":                                   READ_SYM,
-	"READ_ONLY":                              READ_ONLY_SYM,
-	"READ_WRITE":                             READ_WRITE_SYM,
-	"READS":                                  READS_SYM,
-	"REAL":                                   REAL_SYM,
-	"REBUILD":                                REBUILD_SYM,
-	"RECOVER":                                RECOVER_SYM,
-	"RECURSIVE":                              RECURSIVE_SYM,
-	"REDO_BUFFER_SIZE":                       REDO_BUFFER_SIZE_SYM,
-	"REDUNDANT":                              REDUNDANT_SYM,
-	"REFERENCE":                              REFERENCE_SYM,
-	"REFERENCES":                             REFERENCES,
-	"REGEXP":                                 REGEXP,
-	"REGISTRATION":                           REGISTRATION_SYM,
-	"RELATIONAL":                             RELATIONAL_SYM,
-	"RELAY":                                  RELAY,
-	"RELAYLOG":                               RELAYLOG_SYM,
-	"RELAY_LOG_FILE":                         RELAY_LOG_FILE_SYM,
-	"RELAY_LOG_POS":                          RELAY_LOG_POS_SYM,
-	"RELAY_THREAD":                           RELAY_THREAD,
-	"RELEASE":                                RELEASE_SYM,
-	"RELOAD":                                 RELOAD,
-	"REMOVE":                                 REMOVE_SYM,
-	"RENAME":                                 RENAME,
-	"ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS": ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_SYM,
-	"REORGANIZE":                             REORGANIZE_SYM,
-	"REPAIR":                                 REPAIR,
-	"REPEATABLE":                             REPEATABLE_SYM,
-	"REPLICA":                                REPLICA_SYM,
-	"REPLICAS":                               REPLICAS_SYM,
-	"REPLICATION":                            REPLICATION,
-	"REPLICATE_DO_DB":                        REPLICATE_DO_DB,
-	"REPLICATE_IGNORE_DB":                    REPLICATE_IGNORE_DB,
-	"REPLICATE_DO_TABLE":                     REPLICATE_DO_TABLE,
-	"REPLICATE_IGNORE_TABLE":                 REPLICATE_IGNORE_TABLE,
-	"REPLICATE_WILD_DO_TABLE":                REPLICATE_WILD_DO_TABLE,
-	"REPLICATE_WILD_IGNORE_TABLE":            REPLICATE_WILD_IGNORE_TABLE,
-	"REPLICATE_REWRITE_DB":                   REPLICATE_REWRITE_DB,
-	"REPEAT":                                 REPEAT_SYM,
-	"REQUIRE":                                REQUIRE_SYM,
-	"REQUIRE_ROW_FORMAT":                     REQUIRE_ROW_FORMAT_SYM,
-	"REQUIRE_TABLE_PRIMARY_KEY_CHECK":        REQUIRE_TABLE_PRIMARY_KEY_CHECK_SYM,
-	"RESET":                                  RESET_SYM,
-	"RESPECT":                                RESPECT_SYM,
-	"RESIGNAL":                               RESIGNAL_SYM,
-	"RESOURCE":                               RESOURCE_SYM,
-	"RESTART":                                RESTART_SYM,
-	"RESTORE":                                RESTORE_SYM,
-	"RESTRICT":                               RESTRICT,
-	"RESUME":                                 RESUME_SYM,
-	"RETAIN":                                 RETAIN_SYM,
-	"RETURNED_SQLSTATE":                      RETURNED_SQLSTATE_SYM,
-	"RETURN":                                 RETURN_SYM,
-	"RETURNING":                              RETURNING_SYM,
-	"RETURNS":                                RETURNS_SYM,
-	"REUSE":                                  REUSE_SYM,
-	"REVERSE":                                REVERSE_SYM,
-	"REVOKE":                                 REVOKE,
-	"RIGHT":                                  RIGHT,
-	"RLIKE":                                  REGEXP,
-	"ROLE":                                   ROLE_SYM,
-	"ROLLBACK":                               ROLLBACK_SYM,
-	"ROLLUP":                                 ROLLUP_SYM,
-	"ROUTINE":                                ROUTINE_SYM,
-	"ROTATE":                                 ROTATE_SYM,
-	"ROW":                                    ROW_SYM,
-	"ROW_COUNT":                              ROW_COUNT_SYM,
-	"ROW_NUMBER":                             ROW_NUMBER_SYM,
-	"ROWS":                                   ROWS_SYM,
-	"ROW_FORMAT":                             ROW_FORMAT_SYM,
-	"RTREE":                                  RTREE_SYM,
-	"S3":                                     S3_SYM,
-	"SAVEPOINT":                              SAVEPOINT_SYM,
-	"SCHEDULE":                               SCHEDULE_SYM,
-	"SCHEMA":                                 DATABASE,
-	"SCHEMA_NAME":                            SCHEMA_NAME_SYM,
-	"SCHEMAS":                                DATABASES,
-	"SECOND":                                 SECOND_SYM,
-	"SECOND_MICROSECOND":                     SECOND_MICROSECOND_SYM,
-	"SECONDARY":                              SECONDARY_SYM,
-	"SECONDARY_ENGINE":                       SECONDARY_ENGINE_SYM,
-	"SECONDARY_ENGINE_ATTRIBUTE":             SECONDARY_ENGINE_ATTRIBUTE_SYM,
-	"SECONDARY_LOAD":                         SECONDARY_LOAD_SYM,
-	"SECONDARY_UNLOAD":                       SECONDARY_UNLOAD_SYM,
-	"SECURITY":                               SECURITY_SYM,
-	"SENSITIVE":                              SENSITIVE_SYM,
-	"SEPARATOR":                              SEPARATOR_SYM,
-	"SERIAL":                                 SERIAL_SYM,
-	"SERIALIZABLE":                           SERIALIZABLE_SYM,
-	"SESSION":                                SESSION_SYM,
-	"SERVER":                                 SERVER_SYM,
-	"SET":                                    SET_SYM,
-	"SETS":                                   SETS_SYM,
-	"SHARE":                                  SHARE_SYM,
-	"SHOW":                                   SHOW,
-	"SHUTDOWN":                               SHUTDOWN,
-	"SIGNAL":                                 SIGNAL_SYM,
-	"SIGNED":                                 SIGNED_SYM,
-	"SIMPLE":                                 SIMPLE_SYM,
-	"SKIP":                                   SKIP_SYM,
-	"SLAVE":                                  SLAVE,
-	"SLOW":                                   SLOW,
-	"SNAPSHOT":                               SNAPSHOT_SYM,
-	"SMALLINT":                               SMALLINT_SYM,
-	"SOCKET":                                 SOCKET_SYM,
-	"SOME":                                   ANY_SYM,
-	"SONAME":                                 SONAME_SYM,
-	"SOUNDS":                                 SOUNDS_SYM,
-	"SOURCE":                                 SOURCE_SYM,
-	"SOURCE_AUTO_POSITION":                   SOURCE_AUTO_POSITION_SYM,
-	"SOURCE_BIND":                            SOURCE_BIND_SYM,
-	"SOURCE_COMPRESSION_ALGORITHMS":          SOURCE_COMPRESSION_ALGORITHM_SYM,
-	"SOURCE_CONNECT_RETRY":                   SOURCE_CONNECT_RETRY_SYM,
-	"SOURCE_CONNECTION_AUTO_FAILOVER":        SOURCE_CONNECTION_AUTO_FAILOVER_SYM,
-	"SOURCE_DELAY":                           SOURCE_DELAY_SYM,
-	"SOURCE_HEARTBEAT_PERIOD":                SOURCE_HEARTBEAT_PERIOD_SYM,
-	"SOURCE_HOST":                            SOURCE_HOST_SYM,
-	"SOURCE_LOG_FILE":                        SOURCE_LOG_FILE_SYM,
-	"SOURCE_LOG_POS":                         SOURCE_LOG_POS_SYM,
-	"SOURCE_PASSWORD":                        SOURCE_PASSWORD_SYM,
-	"SOURCE_PORT":                            SOURCE_PORT_SYM,
-	"SOURCE_PUBLIC_KEY_PATH":                 SOURCE_PUBLIC_KEY_PATH_SYM,
-	"SOURCE_RETRY_COUNT":                     SOURCE_RETRY_COUNT_SYM,
-	"SOURCE_SSL_CAPATH":                      SOURCE_SSL_CAPATH_SYM,
-	"SOURCE_SSL_CA":                          SOURCE_SSL_CA_SYM,
-	"SOURCE_SSL_CERT":                        SOURCE_SSL_CERT_SYM,
-	"SOURCE_SSL_CIPHER":                      SOURCE_SSL_CIPHER_SYM,
-	"SOURCE_SSL_CRL":                         SOURCE_SSL_CRL_SYM,
-	"SOURCE_SSL_CRLPATH":                     SOURCE_SSL_CRLPATH_SYM,
-	"SOURCE_SSL_KEY":                         SOURCE_SSL_KEY_SYM,
-	"SOURCE_SSL":                             SOURCE_SSL_SYM,
-	"SOURCE_SSL_VERIFY_SERVER_CERT":          SOURCE_SSL_VERIFY_SERVER_CERT_SYM,
-	"SOURCE_TLS_CIPHERSUITES":                SOURCE_TLS_CIPHERSUITES_SYM,
-	"SOURCE_TLS_VERSION":                     SOURCE_TLS_VERSION_SYM,
-	"SOURCE_USER":                            SOURCE_USER_SYM,
-	"SOURCE_ZSTD_COMPRESSION_LEVEL":          SOURCE_ZSTD_COMPRESSION_LEVEL_SYM,
-	"SPATIAL":                                SPATIAL_SYM,
-	"SPECIFIC":                               SPECIFIC_SYM,
-	"SQL":                                    SQL_SYM,
-	"SQLEXCEPTION":                           SQLEXCEPTION_SYM,
-	"SQLSTATE":                               SQLSTATE_SYM,
-	"SQLWARNING":                             SQLWARNING_SYM,
-	"SQL_AFTER_GTIDS":                        SQL_AFTER_GTIDS,
-	"SQL_AFTER_MTS_GAPS":                     SQL_AFTER_MTS_GAPS,
-	"SQL_BEFORE_GTIDS":                       SQL_BEFORE_GTIDS,
-	"SQL_BIG_RESULT":                         SQL_BIG_RESULT,
-	"SQL_BUFFER_RESULT":                      SQL_BUFFER_RESULT,
-	"SQL_CALC_FOUND_ROWS":                    SQL_CALC_FOUND_ROWS,
-	"SQL_NO_CACHE":                           SQL_NO_CACHE_SYM,
-	"SQL_SMALL_RESULT":                       SQL_SMALL_RESULT,
-	"SQL_THREAD":                             SQL_THREAD,
-	"SQL_TSI_SECOND":                         SECOND_SYM,
-	"SQL_TSI_MINUTE":                         MINUTE_SYM,
-	"SQL_TSI_HOUR":                           HOUR_SYM,
-	"SQL_TSI_DAY":                            DAY_SYM,
-	"SQL_TSI_WEEK":                           WEEK_SYM,
-	"SQL_TSI_MONTH":                          MONTH_SYM,
-	"SQL_TSI_QUARTER":                        QUARTER_SYM,
-	"SQL_TSI_YEAR":                           YEAR_SYM,
-	"SRID":                                   SRID_SYM,
-	"SSL":                                    SSL_SYM,
-	"STACKED":                                STACKED_SYM,
-	"START":                                  START_SYM,
-	"STARTING":                               STARTING,
-	"STARTS":                                 STARTS_SYM,
-	"STATS_AUTO_RECALC":                      STATS_AUTO_RECALC_SYM,
-	"STATS_PERSISTENT":                       STATS_PERSISTENT_SYM,
-	"STATS_SAMPLE_PAGES":                     STATS_SAMPLE_PAGES_SYM,
-	"STATUS":                                 STATUS_SYM,
-	"STOP":                                   STOP_SYM,
-	"STORAGE":                                STORAGE_SYM,
-	"STORED":                                 STORED_SYM,
-	"STRAIGHT_JOIN":                          STRAIGHT_JOIN,
-	"STREAM":                                 STREAM_SYM,
-	"STRICT_LOAD":                            STRICT_LOAD_SYM,
-	"STRING":                                 STRING_SYM,
-	"SUBCLASS_ORIGIN":                        SUBCLASS_ORIGIN_SYM,
-	"SUBJECT":                                SUBJECT_SYM,
-	"SUBPARTITION":                           SUBPARTITION_SYM,
-	"SUBPARTITIONS":                          SUBPARTITIONS_SYM,
-	"SUPER":                                  SUPER_SYM,
-	"SUSPEND":                                SUSPEND_SYM,
-	"SWAPS":                                  SWAPS_SYM,
-	"SWITCHES":                               SWITCHES_SYM,
-	"SYSTEM":                                 SYSTEM_SYM,
-	"TABLE":                                  TABLE_SYM,
-	"TABLE_NAME":                             TABLE_NAME_SYM,
-	"TABLES":                                 TABLES,
-	"TABLESAMPLE":                            TABLESAMPLE_SYM,
-	"TABLESPACE":                             TABLESPACE_SYM,
-	"TABLE_CHECKSUM":                         TABLE_CHECKSUM_SYM,
-	"TEMPORARY":                              TEMPORARY,
-	"TEMPTABLE":                              TEMPTABLE_SYM,
-	"TERMINATED":                             TERMINATED,
-	"TEXT":                                   TEXT_SYM,
-	"THAN":                                   THAN_SYM,
-	"THEN":                                   THEN_SYM,
-	"THREAD_PRIORITY":                        THREAD_PRIORITY_SYM,
-	"TIES":                                   TIES_SYM,
-	"TIME":                                   TIME_SYM,
-	"TIMESTAMP":                              TIMESTAMP_SYM,
-	"TIMESTAMPADD":                           TIMESTAMP_ADD,
-	"TIMESTAMPDIFF":                          TIMESTAMP_DIFF,
-	"TINYBLOB":                               TINYBLOB_SYM,
-	"TINYINT":                                TINYINT_SYM,
-	"TINYTEXT":                               TINYTEXT_SYN,
-	"TLS":                                    TLS_SYM,
-	"TO":                                     TO_SYM,
-	"TRAILING":                               TRAILING,
-	"TRANSACTION":                            TRANSACTION_SYM,
-	"TRIGGER":                                TRIGGER_SYM,
-	"TRIGGERS":                               TRIGGERS_SYM,
-	"TRUE":                                   TRUE_SYM,
-	"TRUNCATE":                               TRUNCATE_SYM,
-	"TYPE":                                   TYPE_SYM,
-	"TYPES":                                  TYPES_SYM,
-	"UNBOUNDED":                              UNBOUNDED_SYM,
-	"UNCOMMITTED":                            UNCOMMITTED_SYM,
-	"UNDEFINED":                              UNDEFINED_SYM,
-	"UNDO_BUFFER_SIZE":                       UNDO_BUFFER_SIZE_SYM,
-	"UNDOFILE":                               UNDOFILE_SYM,
-	"UNDO":                                   UNDO_SYM,
-	"UNICODE":                                UNICODE_SYM,
-	"UNION":                                  UNION_SYM,
-	"UNIQUE":                                 UNIQUE_SYM,
-	"UNKNOWN":                                UNKNOWN_SYM,
-	"UNLOCK":                                 UNLOCK_SYM,
-	"UNINSTALL":                              UNINSTALL_SYM,
-	"UNREGISTER":                             UNREGISTER_SYM,
-	"UNSIGNED":                               UNSIGNED_SYM,
-	"UNTIL":                                  UNTIL_SYM,
-	"UPGRADE":                                UPGRADE_SYM,
-	"URI":                                    URI_SYM,
-	"URL":                                    URL_SYM,
-	"USAGE":                                  USAGE,
-	"USE":                                    USE_SYM,
-	"USER":                                   USER,
-	"USER_RESOURCES":                         RESOURCES,
-	"USE_FRM":                                USE_FRM,
-	"USING":                                  USING,
-	"UTC_DATE":                               UTC_DATE_SYM,
-	"UTC_TIME":                               UTC_TIME_SYM,
-	"UTC_TIMESTAMP":                          UTC_TIMESTAMP_SYM,
-	"VALIDATE":                               VALIDATE_SYM,
-	"VALIDATION":                             VALIDATION_SYM,
-	"VALUE":                                  VALUE_SYM,
-	"VALUES":                                 VALUES,
-	"VARBINARY":                              VARBINARY_SYM,
-	"VARCHAR":                                VARCHAR_SYM,
-	"VARCHARACTER":                           VARCHAR_SYM,
-	"VARIABLES":                              VARIABLES,
-	"VARYING":                                VARYING,
-	"VECTOR":                                 VECTOR_SYM,
-	"VERIFY_KEY_CONSTRAINTS":                 VERIFY_KEY_CONSTRAINTS_SYM,
-	"WAIT":                                   WAIT_SYM,
-	"WARNINGS":                               WARNINGS,
-	"WEEK":                                   WEEK_SYM,
-	"WEIGHT_STRING":                          WEIGHT_STRING_SYM,
-	"WHEN":                                   WHEN_SYM,
-	"WHERE":                                  WHERE,
-	"WHILE":                                  WHILE_SYM,
-	"WINDOW":                                 WINDOW_SYM,
-	"VCPU":                                   VCPU_SYM,
-	"VIEW":                                   VIEW_SYM,
-	"VIRTUAL":                                VIRTUAL_SYM,
-	"VISIBLE":                                VISIBLE_SYM,
-	"WITH":                                   WITH,
-	"WITHOUT":                                WITHOUT_SYM,
-	"WORK":                                   WORK_SYM,
-	"WRAPPER":                                WRAPPER_SYM,
-	"WRITE":                                  WRITE_SYM,
-	"X509":                                   X509_SYM,
-	"XOR":                                    XOR,
-	"XA":                                     XA_SYM,
-	"XID":                                    XID_SYM,
-	"XML":                                    XML_SYM,
-	"YEAR":                                   YEAR_SYM,
-	"YEAR_MONTH":                             YEAR_MONTH_SYM,
-	"ZEROFILL":                               ZEROFILL_SYM,
-	"ZONE":                                   ZONE_SYM,
-	"||":                                     OR_OR_SYM,
-	"DELETE":                                 DELETE_SYM,
-	"INSERT":                                 INSERT_SYM,
-	"REPLACE":                                REPLACE_SYM,
-	"SELECT":                                 SELECT_SYM,
-	"UPDATE":                                 UPDATE_SYM,
-	"ADDDATE":                                ADDDATE_SYM,
-	"BIT_AND":                                BIT_AND_SYM,
-	"BIT_OR":                                 BIT_OR_SYM,
-	"BIT_XOR":                                BIT_XOR_SYM,
-	"CAST":                                   CAST_SYM,
-	"COUNT":                                  COUNT_SYM,
-	"CURDATE":                                CURDATE,
-	"CURTIME":                                CURTIME,
-	"DATE_ADD":                               DATE_ADD_INTERVAL,
-	"DATE_SUB":                               DATE_SUB_INTERVAL,
-	"EXTRACT":                                EXTRACT_SYM,
-	"GROUP_CONCAT":                           GROUP_CONCAT_SYM,
-	"JSON_DUALITY_OBJECT":                    JSON_DUALITY_OBJECT_SYM,
-	"JSON_OBJECTAGG":                         JSON_OBJECTAGG,
-	"JSON_ARRAYAGG":                          JSON_ARRAYAGG,
-	"MAX":                                    MAX_SYM,
-	"MID":                                    SUBSTRING,
-	"MIN":                                    MIN_SYM,
-	"NOW":                                    NOW_SYM,
-	"POSITION":                               POSITION_SYM,
-	"SESSION_USER":                           USER,
-	"STD":                                    STD_SYM,
-	"STDDEV":                                 STD_SYM,
-	"STDDEV_POP":                             STD_SYM,
-	"STDDEV_SAMP":                            STDDEV_SAMP_SYM,
-	"ST_COLLECT":                             ST_COLLECT_SYM,
-	"SUBDATE":                                SUBDATE_SYM,
-	"SUBSTR":                                 SUBSTRING,
-	"SUBSTRING":                              SUBSTRING,
-	"SUM":                                    SUM_SYM,
-	"SYSDATE":                                SYSDATE,
-	"SYSTEM_USER":                            USER,
-	"TRIM":                                   TRIM,
-	"VARIANCE":                               VARIANCE_SYM,
-	"VAR_POP":                                VARIANCE_SYM,
-	"VAR_SAMP":                               VAR_SAMP_SYM,
-	"BKA":                                    BKA_HINT,
-	"BNL":                                    BNL_HINT,
-	"DUPSWEEDOUT":                            DUPSWEEDOUT_HINT,
-	"FIRSTMATCH":                             FIRSTMATCH_HINT,
-	"INTOEXISTS":                             INTOEXISTS_HINT,
-	"LOOSESCAN":                              LOOSESCAN_HINT,
-	"MATERIALIZATION":                        MATERIALIZATION_HINT,
-	"MAX_EXECUTION_TIME":                     MAX_EXECUTION_TIME_HINT,
-	"NO_BKA":                                 NO_BKA_HINT,
-	"NO_BNL":                                 NO_BNL_HINT,
-	"NO_ICP":                                 NO_ICP_HINT,
-	"NO_MRR":                                 NO_MRR_HINT,
-	"NO_RANGE_OPTIMIZATION":                  NO_RANGE_OPTIMIZATION_HINT,
-	"NO_SEMIJOIN":                            NO_SEMIJOIN_HINT,
-	"MRR":                                    MRR_HINT,
-	"QB_NAME":                                QB_NAME_HINT,
-	"SEMIJOIN":                               SEMIJOIN_HINT,
-	"SET_VAR":                                SET_VAR_HINT,
-	"SUBQUERY":                               SUBQUERY_HINT,
-	"NO_MERGE":                               NO_DERIVED_MERGE_HINT,
-	"JOIN_PREFIX":                            JOIN_PREFIX_HINT,
-	"JOIN_SUFFIX":                            JOIN_SUFFIX_HINT,
-	"JOIN_ORDER":                             JOIN_ORDER_HINT,
-	"JOIN_FIXED_ORDER":                       JOIN_FIXED_ORDER_HINT,
-	"INDEX_MERGE":                            INDEX_MERGE_HINT,
-	"NO_INDEX_MERGE":                         NO_INDEX_MERGE_HINT,
-	"RESOURCE_GROUP":                         RESOURCE_GROUP_HINT,
-	"SKIP_SCAN":                              SKIP_SCAN_HINT,
-	"NO_SKIP_SCAN":                           NO_SKIP_SCAN_HINT,
-	"HASH_JOIN":                              HASH_JOIN_HINT,
-	"NO_HASH_JOIN":                           NO_HASH_JOIN_HINT,
-	"NO_INDEX":                               NO_INDEX_HINT,
-	"JOIN_INDEX":                             JOIN_INDEX_HINT,
-	"NO_JOIN_INDEX":                          NO_JOIN_INDEX_HINT,
-	"GROUP_INDEX":                            GROUP_INDEX_HINT,
-	"NO_GROUP_INDEX":                         NO_GROUP_INDEX_HINT,
-	"ORDER_INDEX":                            ORDER_INDEX_HINT,
-	"NO_ORDER_INDEX":                         NO_ORDER_INDEX_HINT,
-	"DERIVED_CONDITION_PUSHDOWN":             DERIVED_CONDITION_PUSHDOWN_HINT,
-	"NO_DERIVED_CONDITION_PUSHDOWN":          NO_DERIVED_CONDITION_PUSHDOWN_HINT,
+// TokenKeywords returns the keyword map derived from sql/lex.h. The lexer
+// looks words up in keyword_tables.go; the map is built on each call, for
+// gen/kwtable and the tests.
+func TokenKeywords() map[string]int {
+	return map[string]int{
+		"&&":                                     AND_AND_SYM,
+		"<":                                      LT,
+		"<=":                                     LE,
+		"<>":                                     NE,
+		"!=":                                     NE,
+		"=":                                      EQ,
+		">":                                      GT_SYM,
+		">=":                                     GE,
+		"<<":                                     SHIFT_LEFT,
+		">>":                                     SHIFT_RIGHT,
+		"<=>":                                    EQUAL_SYM,
+		"ABSENT":                                 ABSENT_SYM,
+		"ACCESSIBLE":                             ACCESSIBLE_SYM,
+		"ACCOUNT":                                ACCOUNT_SYM,
+		"ACTION":                                 ACTION,
+		"ACTIVE":                                 ACTIVE_SYM,
+		"ADD":                                    ADD,
+		"ADMIN":                                  ADMIN_SYM,
+		"AFTER":                                  AFTER_SYM,
+		"AGAINST":                                AGAINST,
+		"AGGREGATE":                              AGGREGATE_SYM,
+		"ALL":                                    ALL,
+		"ALLOW_MISSING_FILES":                    ALLOW_MISSING_FILES_SYM,
+		"ALGORITHM":                              ALGORITHM_SYM,
+		"ALTER":                                  ALTER,
+		"ALWAYS":                                 ALWAYS_SYM,
+		"ANALYZE":                                ANALYZE_SYM,
+		"AND":                                    AND_SYM,
+		"ANY":                                    ANY_SYM,
+		"ARRAY":                                  ARRAY_SYM,
+		"AS":                                     AS,
+		"ASC":                                    ASC,
+		"ASCII":                                  ASCII_SYM,
+		"ASENSITIVE":                             ASENSITIVE_SYM,
+		"AT":                                     AT_SYM,
+		"ATTRIBUTE":                              ATTRIBUTE_SYM,
+		"AUTHENTICATION":                         AUTHENTICATION_SYM,
+		"AUTO":                                   AUTO_SYM,
+		"AUTO_INCREMENT":                         AUTO_INC,
+		"AUTO_REFRESH":                           AUTO_REFRESH_SYM,
+		"AUTO_REFRESH_SOURCE":                    AUTO_REFRESH_SOURCE_SYM,
+		"AUTOEXTEND_SIZE":                        AUTOEXTEND_SIZE_SYM,
+		"AVG":                                    AVG_SYM,
+		"AVG_ROW_LENGTH":                         AVG_ROW_LENGTH,
+		"BACKUP":                                 BACKUP_SYM,
+		"BEFORE":                                 BEFORE_SYM,
+		"BEGIN":                                  BEGIN_SYM,
+		"BERNOULLI":                              BERNOULLI_SYM,
+		"BETWEEN":                                BETWEEN_SYM,
+		"BIGINT":                                 BIGINT_SYM,
+		"BINARY":                                 BINARY_SYM,
+		"BINLOG":                                 BINLOG_SYM,
+		"BIT":                                    BIT_SYM,
+		"BLOB":                                   BLOB_SYM,
+		"BLOCK":                                  BLOCK_SYM,
+		"BOOL":                                   BOOL_SYM,
+		"BOOLEAN":                                BOOLEAN_SYM,
+		"BOTH":                                   BOTH,
+		"BTREE":                                  BTREE_SYM,
+		"BUCKETS":                                BUCKETS_SYM,
+		"BULK":                                   BULK_SYM,
+		"BY":                                     BY,
+		"BYTE":                                   BYTE_SYM,
+		"CACHE":                                  CACHE_SYM,
+		"CALL":                                   CALL_SYM,
+		"CASCADE":                                CASCADE,
+		"CASCADED":                               CASCADED,
+		"CASE":                                   CASE_SYM,
+		"CATALOG_NAME":                           CATALOG_NAME_SYM,
+		"CHAIN":                                  CHAIN_SYM,
+		"CHALLENGE_RESPONSE":                     CHALLENGE_RESPONSE_SYM,
+		"CHANGE":                                 CHANGE,
+		"CHANGED":                                CHANGED,
+		"CHANNEL":                                CHANNEL_SYM,
+		"CHAR":                                   CHAR_SYM,
+		"CHARACTER":                              CHAR_SYM,
+		"CHARSET":                                CHARSET,
+		"CHECK":                                  CHECK_SYM,
+		"CHECKSUM":                               CHECKSUM_SYM,
+		"CIPHER":                                 CIPHER_SYM,
+		"CLASS_ORIGIN":                           CLASS_ORIGIN_SYM,
+		"CLIENT":                                 CLIENT_SYM,
+		"CLONE":                                  CLONE_SYM,
+		"CLOSE":                                  CLOSE_SYM,
+		"COALESCE":                               COALESCE,
+		"CODE":                                   CODE_SYM,
+		"COLLATE":                                COLLATE_SYM,
+		"COLLATION":                              COLLATION_SYM,
+		"COLUMN":                                 COLUMN_SYM,
+		"COLUMN_FORMAT":                          COLUMN_FORMAT_SYM,
+		"COLUMN_NAME":                            COLUMN_NAME_SYM,
+		"COLUMNS":                                COLUMNS,
+		"COMMENT":                                COMMENT_SYM,
+		"COMMIT":                                 COMMIT_SYM,
+		"COMMITTED":                              COMMITTED_SYM,
+		"COMPACT":                                COMPACT_SYM,
+		"COMPLETION":                             COMPLETION_SYM,
+		"COMPONENT":                              COMPONENT_SYM,
+		"COMPRESSION":                            COMPRESSION_SYM,
+		"COMPRESSED":                             COMPRESSED_SYM,
+		"ENCRYPTION":                             ENCRYPTION_SYM,
+		"CONCURRENT":                             CONCURRENT,
+		"CONDITION":                              CONDITION_SYM,
+		"CONNECTION":                             CONNECTION_SYM,
+		"CONSISTENT":                             CONSISTENT_SYM,
+		"CONSTRAINT":                             CONSTRAINT,
+		"CONSTRAINT_CATALOG":                     CONSTRAINT_CATALOG_SYM,
+		"CONSTRAINT_NAME":                        CONSTRAINT_NAME_SYM,
+		"CONSTRAINT_SCHEMA":                      CONSTRAINT_SCHEMA_SYM,
+		"CONTAINS":                               CONTAINS_SYM,
+		"CONTEXT":                                CONTEXT_SYM,
+		"CONTINUE":                               CONTINUE_SYM,
+		"CONVERT":                                CONVERT_SYM,
+		"CPU":                                    CPU_SYM,
+		"CREATE":                                 CREATE,
+		"CROSS":                                  CROSS,
+		"CUBE":                                   CUBE_SYM,
+		"CUME_DIST":                              CUME_DIST_SYM,
+		"CURRENT":                                CURRENT_SYM,
+		"CURRENT_DATE":                           CURDATE,
+		"CURRENT_TIME":                           CURTIME,
+		"CURRENT_TIMESTAMP":                      NOW_SYM,
+		"CURRENT_USER":                           CURRENT_USER,
+		"CURSOR":                                 CURSOR_SYM,
+		"CURSOR_NAME":                            CURSOR_NAME_SYM,
+		"DATA":                                   DATA_SYM,
+		"DATABASE":                               DATABASE,
+		"DATABASES":                              DATABASES,
+		"DATAFILE":                               DATAFILE_SYM,
+		"DATE":                                   DATE_SYM,
+		"DATETIME":                               DATETIME_SYM,
+		"DAY":                                    DAY_SYM,
+		"DAY_HOUR":                               DAY_HOUR_SYM,
+		"DAY_MICROSECOND":                        DAY_MICROSECOND_SYM,
+		"DAY_MINUTE":                             DAY_MINUTE_SYM,
+		"DAY_SECOND":                             DAY_SECOND_SYM,
+		"DEALLOCATE":                             DEALLOCATE_SYM,
+		"DEC":                                    DECIMAL_SYM,
+		"DECIMAL":                                DECIMAL_SYM,
+		"DECLARE":                                DECLARE_SYM,
+		"DEFAULT":                                DEFAULT_SYM,
+		"DEFAULT_AUTH":                           DEFAULT_AUTH_SYM,
+		"DEFINER":                                DEFINER_SYM,
+		"DEFINITION":                             DEFINITION_SYM,
+		"DELAYED":                                DELAYED_SYM,
+		"DELAY_KEY_WRITE":                        DELAY_KEY_WRITE_SYM,
+		"DENSE_RANK":                             DENSE_RANK_SYM,
+		"DESC":                                   DESC,
+		"DESCRIBE":                               DESCRIBE,
+		"DESCRIPTION":                            DESCRIPTION_SYM,
+		"DETERMINISTIC":                          DETERMINISTIC_SYM,
+		"DIAGNOSTICS":                            DIAGNOSTICS_SYM,
+		"DIRECTORY":                              DIRECTORY_SYM,
+		"DISABLE":                                DISABLE_SYM,
+		"DISCARD":                                DISCARD_SYM,
+		"DISK":                                   DISK_SYM,
+		"DISTINCT":                               DISTINCT,
+		"DISTINCTROW":                            DISTINCT,
+		"DIV":                                    DIV_SYM,
+		"DO":                                     DO_SYM,
+		"DOUBLE":                                 DOUBLE_SYM,
+		"DROP":                                   DROP,
+		"DUAL":                                   DUAL_SYM,
+		"DUALITY":                                DUALITY_SYM,
+		"DUMPFILE":                               DUMPFILE,
+		"DUPLICATE":                              DUPLICATE_SYM,
+		"DYNAMIC":                                DYNAMIC_SYM,
+		"EACH":                                   EACH_SYM,
+		"ELSE":                                   ELSE,
+		"ELSEIF":                                 ELSEIF_SYM,
+		"EMPTY":                                  EMPTY_SYM,
+		"ENABLE":                                 ENABLE_SYM,
+		"ENCLOSED":                               ENCLOSED,
+		"END":                                    END,
+		"ENDS":                                   ENDS_SYM,
+		"ENFORCED":                               ENFORCED_SYM,
+		"ENGINE":                                 ENGINE_SYM,
+		"ENGINE_ATTRIBUTE":                       ENGINE_ATTRIBUTE_SYM,
+		"ENGINES":                                ENGINES_SYM,
+		"ENUM":                                   ENUM_SYM,
+		"ERROR":                                  ERROR_SYM,
+		"ERRORS":                                 ERRORS,
+		"ESCAPE":                                 ESCAPE_SYM,
+		"ESCAPED":                                ESCAPED,
+		"EVENT":                                  EVENT_SYM,
+		"EVENTS":                                 EVENTS_SYM,
+		"EVERY":                                  EVERY_SYM,
+		"EXCEPT":                                 EXCEPT_SYM,
+		"EXCHANGE":                               EXCHANGE_SYM,
+		"EXCLUDE":                                EXCLUDE_SYM,
+		"EXECUTE":                                EXECUTE_SYM,
+		"EXISTS":                                 EXISTS,
+		"EXIT":                                   EXIT_SYM,
+		"EXPANSION":                              EXPANSION_SYM,
+		"EXPORT":                                 EXPORT_SYM,
+		"EXPIRE":                                 EXPIRE_SYM,
+		"EXPLAIN":                                DESCRIBE,
+		"EXTENDED":                               EXTENDED_SYM,
+		"EXTENT_SIZE":                            EXTENT_SIZE_SYM,
+		"EXTERNAL":                               EXTERNAL_SYM,
+		"EXTERNAL_FORMAT":                        EXTERNAL_FORMAT_SYM,
+		"FACTOR":                                 FACTOR_SYM,
+		"FAILED_LOGIN_ATTEMPTS":                  FAILED_LOGIN_ATTEMPTS_SYM,
+		"FALSE":                                  FALSE_SYM,
+		"FAST":                                   FAST_SYM,
+		"FAULTS":                                 FAULTS_SYM,
+		"FETCH":                                  FETCH_SYM,
+		"FIELDS":                                 COLUMNS,
+		"FILE":                                   FILE_SYM,
+		"FILES":                                  FILES_SYM,
+		"FILE_BLOCK_SIZE":                        FILE_BLOCK_SIZE_SYM,
+		"FILE_FORMAT":                            FILE_FORMAT_SYM,
+		"FILE_NAME":                              FILE_NAME_SYM,
+		"FILE_PATTERN":                           FILE_PATTERN_SYM,
+		"FILE_PREFIX":                            FILE_PREFIX_SYM,
+		"FILTER":                                 FILTER_SYM,
+		"FINISH":                                 FINISH_SYM,
+		"FIRST":                                  FIRST_SYM,
+		"FIRST_VALUE":                            FIRST_VALUE_SYM,
+		"FIXED":                                  FIXED_SYM,
+		"FLOAT":                                  FLOAT_SYM,
+		"FLOAT4":                                 FLOAT_SYM,
+		"FLOAT8":                                 DOUBLE_SYM,
+		"FLUSH":                                  FLUSH_SYM,
+		"FOLLOWS":                                FOLLOWS_SYM,
+		"FOLLOWING":                              FOLLOWING_SYM,
+		"FOR":                                    FOR_SYM,
+		"FORCE":                                  FORCE_SYM,
+		"FOREIGN":                                FOREIGN,
+		"FORMAT":                                 FORMAT_SYM,
+		"FOUND":                                  FOUND_SYM,
+		"FROM":                                   FROM,
+		"FULL":                                   FULL,
+		"FULLTEXT":                               FULLTEXT_SYM,
+		"FUNCTION":                               FUNCTION_SYM,
+		"GENERAL":                                GENERAL,
+		"GROUP_REPLICATION":                      GROUP_REPLICATION,
+		"GEOMCOLLECTION":                         GEOMETRYCOLLECTION_SYM,
+		"GEOMETRY":                               GEOMETRY_SYM,
+		"GEOMETRYCOLLECTION":                     GEOMETRYCOLLECTION_SYM,
+		"GET_FORMAT":                             GET_FORMAT,
+		"GET_MASTER_PUBLIC_KEY":                  OBSOLETE_TOKEN_967,
+		"GET_SOURCE_PUBLIC_KEY":                  GET_SOURCE_PUBLIC_KEY_SYM,
+		"GET":                                    GET_SYM,
+		"GENERATE":                               GENERATE_SYM,
+		"GENERATED":                              GENERATED,
+		"GLOBAL":                                 GLOBAL_SYM,
+		"GRANT":                                  GRANT,
+		"GRANTS":                                 GRANTS,
+		"GROUP":                                  GROUP_SYM,
+		"GROUPING":                               GROUPING_SYM,
+		"GROUPS":                                 GROUPS_SYM,
+		"GTIDS":                                  GTIDS_SYM,
+		"GTID_ONLY":                              GTID_ONLY_SYM,
+		"GUIDED":                                 GUIDED_SYM,
+		"HANDLER":                                HANDLER_SYM,
+		"HASH":                                   HASH_SYM,
+		"HAVING":                                 HAVING,
+		"HEADER":                                 HEADER_SYM,
+		"HELP":                                   HELP_SYM,
+		"HIGH_PRIORITY":                          HIGH_PRIORITY,
+		"HISTOGRAM":                              HISTOGRAM_SYM,
+		"HISTORY":                                HISTORY_SYM,
+		"HOST":                                   HOST_SYM,
+		"HOSTS":                                  HOSTS_SYM,
+		"HOUR":                                   HOUR_SYM,
+		"HOUR_MICROSECOND":                       HOUR_MICROSECOND_SYM,
+		"HOUR_MINUTE":                            HOUR_MINUTE_SYM,
+		"HOUR_SECOND":                            HOUR_SECOND_SYM,
+		"IDENTIFIED":                             IDENTIFIED_SYM,
+		"IF":                                     IF,
+		"IGNORE":                                 IGNORE_SYM,
+		"IGNORE_SERVER_IDS":                      IGNORE_SERVER_IDS_SYM,
+		"IMPORT":                                 IMPORT,
+		"IN":                                     IN_SYM,
+		"INACTIVE":                               INACTIVE_SYM,
+		"INDEX":                                  INDEX_SYM,
+		"INDEXES":                                INDEXES,
+		"INFILE":                                 INFILE_SYM,
+		"INITIAL":                                INITIAL_SYM,
+		"INITIAL_SIZE":                           INITIAL_SIZE_SYM,
+		"INITIATE":                               INITIATE_SYM,
+		"INNER":                                  INNER_SYM,
+		"INOUT":                                  INOUT_SYM,
+		"INSENSITIVE":                            INSENSITIVE_SYM,
+		"INSERT_METHOD":                          INSERT_METHOD,
+		"INSTALL":                                INSTALL_SYM,
+		"INSTANCE":                               INSTANCE_SYM,
+		"INT":                                    INT_SYM,
+		"INT1":                                   TINYINT_SYM,
+		"INT2":                                   SMALLINT_SYM,
+		"INT3":                                   MEDIUMINT_SYM,
+		"INT4":                                   INT_SYM,
+		"INT8":                                   BIGINT_SYM,
+		"INTEGER":                                INT_SYM,
+		"INTERSECT":                              INTERSECT_SYM,
+		"INTERVAL":                               INTERVAL_SYM,
+		"INTO":                                   INTO,
+		"IO":                                     IO_SYM,
+		"IO_AFTER_GTIDS":                         IO_AFTER_GTIDS,
+		"IO_BEFORE_GTIDS":                        IO_BEFORE_GTIDS,
+		"IO_THREAD":                              RELAY_THREAD,
+		"IPC":                                    IPC_SYM,
+		"IS":                                     IS,
+		"ISOLATION":                              ISOLATION,
+		"ISSUER":                                 ISSUER_SYM,
+		"ITERATE":                                ITERATE_SYM,
+		"INVISIBLE":                              INVISIBLE_SYM,
+		"INVOKER":                                INVOKER_SYM,
+		"JOIN":                                   JOIN_SYM,
+		"JSON":                                   JSON_SYM,
+		"JSON_TABLE":                             JSON_TABLE_SYM,
+		"JSON_VALUE":                             JSON_VALUE_SYM,
+		"KEY":                                    KEY_SYM,
+		"KEYRING":                                KEYRING_SYM,
+		"KEYS":                                   KEYS,
+		"KEY_BLOCK_SIZE":                         KEY_BLOCK_SIZE,
+		"KILL":                                   KILL_SYM,
+		"LAG":                                    LAG_SYM,
+		"LANGUAGE":                               LANGUAGE_SYM,
+		"LAST":                                   LAST_SYM,
+		"LAST_VALUE":                             LAST_VALUE_SYM,
+		"LATERAL":                                LATERAL_SYM,
+		"LEAD":                                   LEAD_SYM,
+		"LEADING":                                LEADING,
+		"LEAVE":                                  LEAVE_SYM,
+		"LEAVES":                                 LEAVES,
+		"LEFT":                                   LEFT,
+		"LESS":                                   LESS_SYM,
+		"LEVEL":                                  LEVEL_SYM,
+		"LIBRARY":                                LIBRARY_SYM,
+		"LIKE":                                   LIKE,
+		"LIMIT":                                  LIMIT,
+		"LINEAR":                                 LINEAR_SYM,
+		"LINES":                                  LINES,
+		"LINESTRING":                             LINESTRING_SYM,
+		"LIST":                                   LIST_SYM,
+		"LOAD":                                   LOAD,
+		"LOCAL":                                  LOCAL_SYM,
+		"LOCALTIME":                              NOW_SYM,
+		"LOCALTIMESTAMP":                         NOW_SYM,
+		"LOCK":                                   LOCK_SYM,
+		"LOCKED":                                 LOCKED_SYM,
+		"LOCKS":                                  LOCKS_SYM,
+		"LOGFILE":                                LOGFILE_SYM,
+		"LOGS":                                   LOGS_SYM,
+		"LOG":                                    LOG_SYM,
+		"LONG":                                   LONG_SYM,
+		"LONGBLOB":                               LONGBLOB_SYM,
+		"LONGTEXT":                               LONGTEXT_SYM,
+		"LOOP":                                   LOOP_SYM,
+		"LOW_PRIORITY":                           LOW_PRIORITY,
+		"MANUAL":                                 MANUAL_SYM,
+		"MASTER":                                 MASTER_SYM,
+		"MATCH":                                  MATCH,
+		"MATERIALIZED":                           MATERIALIZED_SYM,
+		"MAX_CONNECTIONS_PER_HOUR":               MAX_CONNECTIONS_PER_HOUR,
+		"MAX_QUERIES_PER_HOUR":                   MAX_QUERIES_PER_HOUR,
+		"MAX_ROWS":                               MAX_ROWS,
+		"MAX_SIZE":                               MAX_SIZE_SYM,
+		"MAX_UPDATES_PER_HOUR":                   MAX_UPDATES_PER_HOUR,
+		"MAX_USER_CONNECTIONS":                   MAX_USER_CONNECTIONS_SYM,
+		"MAXVALUE":                               MAX_VALUE_SYM,
+		"MEDIUM":                                 MEDIUM_SYM,
+		"MEDIUMBLOB":                             MEDIUMBLOB_SYM,
+		"MEDIUMINT":                              MEDIUMINT_SYM,
+		"MEDIUMTEXT":                             MEDIUMTEXT_SYM,
+		"MEMBER":                                 MEMBER_SYM,
+		"MEMORY":                                 MEMORY_SYM,
+		"MERGE":                                  MERGE_SYM,
+		"MESSAGE_TEXT":                           MESSAGE_TEXT_SYM,
+		"MICROSECOND":                            MICROSECOND_SYM,
+		"MIDDLEINT":                              MEDIUMINT_SYM,
+		"MIGRATE":                                MIGRATE_SYM,
+		"MINUTE":                                 MINUTE_SYM,
+		"MINUTE_MICROSECOND":                     MINUTE_MICROSECOND_SYM,
+		"MINUTE_SECOND":                          MINUTE_SECOND_SYM,
+		"MIN_ROWS":                               MIN_ROWS,
+		"MOD":                                    MOD_SYM,
+		"MODE":                                   MODE_SYM,
+		"MODIFIES":                               MODIFIES_SYM,
+		"MODIFY":                                 MODIFY_SYM,
+		"MONTH":                                  MONTH_SYM,
+		"MULTILINESTRING":                        MULTILINESTRING_SYM,
+		"MULTIPOINT":                             MULTIPOINT_SYM,
+		"MULTIPOLYGON":                           MULTIPOLYGON_SYM,
+		"MUTEX":                                  MUTEX_SYM,
+		"MYSQL_ERRNO":                            MYSQL_ERRNO_SYM,
+		"NAME":                                   NAME_SYM,
+		"NAMES":                                  NAMES_SYM,
+		"NATIONAL":                               NATIONAL_SYM,
+		"NATURAL":                                NATURAL,
+		"NDB":                                    NDBCLUSTER_SYM,
+		"NDBCLUSTER":                             NDBCLUSTER_SYM,
+		"NCHAR":                                  NCHAR_SYM,
+		"NESTED":                                 NESTED_SYM,
+		"NETWORK_NAMESPACE":                      NETWORK_NAMESPACE_SYM,
+		"NEVER":                                  NEVER_SYM,
+		"NEW":                                    NEW_SYM,
+		"NEXT":                                   NEXT_SYM,
+		"NO":                                     NO_SYM,
+		"NO_WAIT":                                NO_WAIT_SYM,
+		"NOWAIT":                                 NOWAIT_SYM,
+		"NODEGROUP":                              NODEGROUP_SYM,
+		"NONE":                                   NONE_SYM,
+		"NOT":                                    NOT_SYM,
+		"NO_WRITE_TO_BINLOG":                     NO_WRITE_TO_BINLOG,
+		"NTH_VALUE":                              NTH_VALUE_SYM,
+		"NTILE":                                  NTILE_SYM,
+		"NULL":                                   NULL_SYM,
+		"NULLS":                                  NULLS_SYM,
+		"NUMBER":                                 NUMBER_SYM,
+		"NUMERIC":                                NUMERIC_SYM,
+		"NVARCHAR":                               NVARCHAR_SYM,
+		"OF":                                     OF_SYM,
+		"OFF":                                    OFF_SYM,
+		"OFFSET":                                 OFFSET_SYM,
+		"OJ":                                     OJ_SYM,
+		"OLD":                                    OLD_SYM,
+		"ON":                                     ON_SYM,
+		"ONE":                                    ONE_SYM,
+		"ONLY":                                   ONLY_SYM,
+		"OPEN":                                   OPEN_SYM,
+		"OPTIMIZE":                               OPTIMIZE,
+		"OPTIMIZER_COSTS":                        OPTIMIZER_COSTS_SYM,
+		"OPTIONS":                                OPTIONS_SYM,
+		"OPTION":                                 OPTION,
+		"OPTIONAL":                               OPTIONAL_SYM,
+		"OPTIONALLY":                             OPTIONALLY,
+		"OR":                                     OR_SYM,
+		"ORGANIZATION":                           ORGANIZATION_SYM,
+		"OTHERS":                                 OTHERS_SYM,
+		"ORDER":                                  ORDER_SYM,
+		"ORDINALITY":                             ORDINALITY_SYM,
+		"OUT":                                    OUT_SYM,
+		"OUTER":                                  OUTER_SYM,
+		"OUTFILE":                                OUTFILE,
+		"OVER":                                   OVER_SYM,
+		"OWNER":                                  OWNER_SYM,
+		"PACK_KEYS":                              PACK_KEYS_SYM,
+		"PATH":                                   PATH_SYM,
+		"PARAMETERS":                             PARAMETERS_SYM,
+		"PARSE_TREE":                             PARSE_TREE_SYM,
+		"PARSER":                                 PARSER_SYM,
+		"PAGE":                                   PAGE_SYM,
+		"PARALLEL":                               PARALLEL_SYM,
+		"PARTIAL":                                PARTIAL,
+		"PARTITION":                              PARTITION_SYM,
+		"PARTITIONING":                           PARTITIONING_SYM,
+		"PARTITIONS":                             PARTITIONS_SYM,
+		"PASSWORD":                               PASSWORD,
+		"PASSWORD_LOCK_TIME":                     PASSWORD_LOCK_TIME_SYM,
+		"PERCENT_RANK":                           PERCENT_RANK_SYM,
+		"PERSIST":                                PERSIST_SYM,
+		"PERSIST_ONLY":                           PERSIST_ONLY_SYM,
+		"PHASE":                                  PHASE_SYM,
+		"PLUGIN":                                 PLUGIN_SYM,
+		"PLUGINS":                                PLUGINS_SYM,
+		"PLUGIN_DIR":                             PLUGIN_DIR_SYM,
+		"POINT":                                  POINT_SYM,
+		"POLYGON":                                POLYGON_SYM,
+		"PORT":                                   PORT_SYM,
+		"PRECEDES":                               PRECEDES_SYM,
+		"PRECEDING":                              PRECEDING_SYM,
+		"PRECISION":                              PRECISION,
+		"PREPARE":                                PREPARE_SYM,
+		"PRESERVE":                               PRESERVE_SYM,
+		"PREV":                                   PREV_SYM,
+		"PRIMARY":                                PRIMARY_SYM,
+		"PRIVILEGES":                             PRIVILEGES,
+		"PRIVILEGE_CHECKS_USER":                  PRIVILEGE_CHECKS_USER_SYM,
+		"PROCEDURE":                              PROCEDURE_SYM,
+		"PROCESS":                                PROCESS,
+		"PROCESSLIST":                            PROCESSLIST_SYM,
+		"PROFILE":                                PROFILE_SYM,
+		"PROFILES":                               PROFILES_SYM,
+		"PROXY":                                  PROXY_SYM,
+		"PURGE":                                  PURGE,
+		"QUALIFY":                                QUALIFY_SYM,
+		"QUARTER":                                QUARTER_SYM,
+		"QUERY":                                  QUERY_SYM,
+		"QUICK":                                  QUICK,
+		"RANDOM":                                 RANDOM_SYM,
+		"RANK":                                   RANK_SYM,
+		"RANGE":                                  RANGE_SYM,
+		"READ":                                   READ_SYM,
+		"READ_ONLY":                              READ_ONLY_SYM,
+		"READ_WRITE":                             READ_WRITE_SYM,
+		"READS":                                  READS_SYM,
+		"REAL":                                   REAL_SYM,
+		"REBUILD":                                REBUILD_SYM,
+		"RECOVER":                                RECOVER_SYM,
+		"RECURSIVE":                              RECURSIVE_SYM,
+		"REDO_BUFFER_SIZE":                       REDO_BUFFER_SIZE_SYM,
+		"REDUNDANT":                              REDUNDANT_SYM,
+		"REFERENCE":                              REFERENCE_SYM,
+		"REFERENCES":                             REFERENCES,
+		"REGEXP":                                 REGEXP,
+		"REGISTRATION":                           REGISTRATION_SYM,
+		"RELATIONAL":                             RELATIONAL_SYM,
+		"RELAY":                                  RELAY,
+		"RELAYLOG":                               RELAYLOG_SYM,
+		"RELAY_LOG_FILE":                         RELAY_LOG_FILE_SYM,
+		"RELAY_LOG_POS":                          RELAY_LOG_POS_SYM,
+		"RELAY_THREAD":                           RELAY_THREAD,
+		"RELEASE":                                RELEASE_SYM,
+		"RELOAD":                                 RELOAD,
+		"REMOVE":                                 REMOVE_SYM,
+		"RENAME":                                 RENAME,
+		"ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS": ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_SYM,
+		"REORGANIZE":                             REORGANIZE_SYM,
+		"REPAIR":                                 REPAIR,
+		"REPEATABLE":                             REPEATABLE_SYM,
+		"REPLICA":                                REPLICA_SYM,
+		"REPLICAS":                               REPLICAS_SYM,
+		"REPLICATION":                            REPLICATION,
+		"REPLICATE_DO_DB":                        REPLICATE_DO_DB,
+		"REPLICATE_IGNORE_DB":                    REPLICATE_IGNORE_DB,
+		"REPLICATE_DO_TABLE":                     REPLICATE_DO_TABLE,
+		"REPLICATE_IGNORE_TABLE":                 REPLICATE_IGNORE_TABLE,
+		"REPLICATE_WILD_DO_TABLE":                REPLICATE_WILD_DO_TABLE,
+		"REPLICATE_WILD_IGNORE_TABLE":            REPLICATE_WILD_IGNORE_TABLE,
+		"REPLICATE_REWRITE_DB":                   REPLICATE_REWRITE_DB,
+		"REPEAT":                                 REPEAT_SYM,
+		"REQUIRE":                                REQUIRE_SYM,
+		"REQUIRE_ROW_FORMAT":                     REQUIRE_ROW_FORMAT_SYM,
+		"REQUIRE_TABLE_PRIMARY_KEY_CHECK":        REQUIRE_TABLE_PRIMARY_KEY_CHECK_SYM,
+		"RESET":                                  RESET_SYM,
+		"RESPECT":                                RESPECT_SYM,
+		"RESIGNAL":                               RESIGNAL_SYM,
+		"RESOURCE":                               RESOURCE_SYM,
+		"RESTART":                                RESTART_SYM,
+		"RESTORE":                                RESTORE_SYM,
+		"RESTRICT":                               RESTRICT,
+		"RESUME":                                 RESUME_SYM,
+		"RETAIN":                                 RETAIN_SYM,
+		"RETURNED_SQLSTATE":                      RETURNED_SQLSTATE_SYM,
+		"RETURN":                                 RETURN_SYM,
+		"RETURNING":                              RETURNING_SYM,
+		"RETURNS":                                RETURNS_SYM,
+		"REUSE":                                  REUSE_SYM,
+		"REVERSE":                                REVERSE_SYM,
+		"REVOKE":                                 REVOKE,
+		"RIGHT":                                  RIGHT,
+		"RLIKE":                                  REGEXP,
+		"ROLE":                                   ROLE_SYM,
+		"ROLLBACK":                               ROLLBACK_SYM,
+		"ROLLUP":                                 ROLLUP_SYM,
+		"ROUTINE":                                ROUTINE_SYM,
+		"ROTATE":                                 ROTATE_SYM,
+		"ROW":                                    ROW_SYM,
+		"ROW_COUNT":                              ROW_COUNT_SYM,
+		"ROW_NUMBER":                             ROW_NUMBER_SYM,
+		"ROWS":                                   ROWS_SYM,
+		"ROW_FORMAT":                             ROW_FORMAT_SYM,
+		"RTREE":                                  RTREE_SYM,
+		"S3":                                     S3_SYM,
+		"SAVEPOINT":                              SAVEPOINT_SYM,
+		"SCHEDULE":                               SCHEDULE_SYM,
+		"SCHEMA":                                 DATABASE,
+		"SCHEMA_NAME":                            SCHEMA_NAME_SYM,
+		"SCHEMAS":                                DATABASES,
+		"SECOND":                                 SECOND_SYM,
+		"SECOND_MICROSECOND":                     SECOND_MICROSECOND_SYM,
+		"SECONDARY":                              SECONDARY_SYM,
+		"SECONDARY_ENGINE":                       SECONDARY_ENGINE_SYM,
+		"SECONDARY_ENGINE_ATTRIBUTE":             SECONDARY_ENGINE_ATTRIBUTE_SYM,
+		"SECONDARY_LOAD":                         SECONDARY_LOAD_SYM,
+		"SECONDARY_UNLOAD":                       SECONDARY_UNLOAD_SYM,
+		"SECURITY":                               SECURITY_SYM,
+		"SENSITIVE":                              SENSITIVE_SYM,
+		"SEPARATOR":                              SEPARATOR_SYM,
+		"SERIAL":                                 SERIAL_SYM,
+		"SERIALIZABLE":                           SERIALIZABLE_SYM,
+		"SESSION":                                SESSION_SYM,
+		"SERVER":                                 SERVER_SYM,
+		"SET":                                    SET_SYM,
+		"SETS":                                   SETS_SYM,
+		"SHARE":                                  SHARE_SYM,
+		"SHOW":                                   SHOW,
+		"SHUTDOWN":                               SHUTDOWN,
+		"SIGNAL":                                 SIGNAL_SYM,
+		"SIGNED":                                 SIGNED_SYM,
+		"SIMPLE":                                 SIMPLE_SYM,
+		"SKIP":                                   SKIP_SYM,
+		"SLAVE":                                  SLAVE,
+		"SLOW":                                   SLOW,
+		"SNAPSHOT":                               SNAPSHOT_SYM,
+		"SMALLINT":                               SMALLINT_SYM,
+		"SOCKET":                                 SOCKET_SYM,
+		"SOME":                                   ANY_SYM,
+		"SONAME":                                 SONAME_SYM,
+		"SOUNDS":                                 SOUNDS_SYM,
+		"SOURCE":                                 SOURCE_SYM,
+		"SOURCE_AUTO_POSITION":                   SOURCE_AUTO_POSITION_SYM,
+		"SOURCE_BIND":                            SOURCE_BIND_SYM,
+		"SOURCE_COMPRESSION_ALGORITHMS":          SOURCE_COMPRESSION_ALGORITHM_SYM,
+		"SOURCE_CONNECT_RETRY":                   SOURCE_CONNECT_RETRY_SYM,
+		"SOURCE_CONNECTION_AUTO_FAILOVER":        SOURCE_CONNECTION_AUTO_FAILOVER_SYM,
+		"SOURCE_DELAY":                           SOURCE_DELAY_SYM,
+		"SOURCE_HEARTBEAT_PERIOD":                SOURCE_HEARTBEAT_PERIOD_SYM,
+		"SOURCE_HOST":                            SOURCE_HOST_SYM,
+		"SOURCE_LOG_FILE":                        SOURCE_LOG_FILE_SYM,
+		"SOURCE_LOG_POS":                         SOURCE_LOG_POS_SYM,
+		"SOURCE_PASSWORD":                        SOURCE_PASSWORD_SYM,
+		"SOURCE_PORT":                            SOURCE_PORT_SYM,
+		"SOURCE_PUBLIC_KEY_PATH":                 SOURCE_PUBLIC_KEY_PATH_SYM,
+		"SOURCE_RETRY_COUNT":                     SOURCE_RETRY_COUNT_SYM,
+		"SOURCE_SSL_CAPATH":                      SOURCE_SSL_CAPATH_SYM,
+		"SOURCE_SSL_CA":                          SOURCE_SSL_CA_SYM,
+		"SOURCE_SSL_CERT":                        SOURCE_SSL_CERT_SYM,
+		"SOURCE_SSL_CIPHER":                      SOURCE_SSL_CIPHER_SYM,
+		"SOURCE_SSL_CRL":                         SOURCE_SSL_CRL_SYM,
+		"SOURCE_SSL_CRLPATH":                     SOURCE_SSL_CRLPATH_SYM,
+		"SOURCE_SSL_KEY":                         SOURCE_SSL_KEY_SYM,
+		"SOURCE_SSL":                             SOURCE_SSL_SYM,
+		"SOURCE_SSL_VERIFY_SERVER_CERT":          SOURCE_SSL_VERIFY_SERVER_CERT_SYM,
+		"SOURCE_TLS_CIPHERSUITES":                SOURCE_TLS_CIPHERSUITES_SYM,
+		"SOURCE_TLS_VERSION":                     SOURCE_TLS_VERSION_SYM,
+		"SOURCE_USER":                            SOURCE_USER_SYM,
+		"SOURCE_ZSTD_COMPRESSION_LEVEL":          SOURCE_ZSTD_COMPRESSION_LEVEL_SYM,
+		"SPATIAL":                                SPATIAL_SYM,
+		"SPECIFIC":                               SPECIFIC_SYM,
+		"SQL":                                    SQL_SYM,
+		"SQLEXCEPTION":                           SQLEXCEPTION_SYM,
+		"SQLSTATE":                               SQLSTATE_SYM,
+		"SQLWARNING":                             SQLWARNING_SYM,
+		"SQL_AFTER_GTIDS":                        SQL_AFTER_GTIDS,
+		"SQL_AFTER_MTS_GAPS":                     SQL_AFTER_MTS_GAPS,
+		"SQL_BEFORE_GTIDS":                       SQL_BEFORE_GTIDS,
+		"SQL_BIG_RESULT":                         SQL_BIG_RESULT,
+		"SQL_BUFFER_RESULT":                      SQL_BUFFER_RESULT,
+		"SQL_CALC_FOUND_ROWS":                    SQL_CALC_FOUND_ROWS,
+		"SQL_NO_CACHE":                           SQL_NO_CACHE_SYM,
+		"SQL_SMALL_RESULT":                       SQL_SMALL_RESULT,
+		"SQL_THREAD":                             SQL_THREAD,
+		"SQL_TSI_SECOND":                         SECOND_SYM,
+		"SQL_TSI_MINUTE":                         MINUTE_SYM,
+		"SQL_TSI_HOUR":                           HOUR_SYM,
+		"SQL_TSI_DAY":                            DAY_SYM,
+		"SQL_TSI_WEEK":                           WEEK_SYM,
+		"SQL_TSI_MONTH":                          MONTH_SYM,
+		"SQL_TSI_QUARTER":                        QUARTER_SYM,
+		"SQL_TSI_YEAR":                           YEAR_SYM,
+		"SRID":                                   SRID_SYM,
+		"SSL":                                    SSL_SYM,
+		"STACKED":                                STACKED_SYM,
+		"START":                                  START_SYM,
+		"STARTING":                               STARTING,
+		"STARTS":                                 STARTS_SYM,
+		"STATS_AUTO_RECALC":                      STATS_AUTO_RECALC_SYM,
+		"STATS_PERSISTENT":                       STATS_PERSISTENT_SYM,
+		"STATS_SAMPLE_PAGES":                     STATS_SAMPLE_PAGES_SYM,
+		"STATUS":                                 STATUS_SYM,
+		"STOP":                                   STOP_SYM,
+		"STORAGE":                                STORAGE_SYM,
+		"STORED":                                 STORED_SYM,
+		"STRAIGHT_JOIN":                          STRAIGHT_JOIN,
+		"STREAM":                                 STREAM_SYM,
+		"STRICT_LOAD":                            STRICT_LOAD_SYM,
+		"STRING":                                 STRING_SYM,
+		"SUBCLASS_ORIGIN":                        SUBCLASS_ORIGIN_SYM,
+		"SUBJECT":                                SUBJECT_SYM,
+		"SUBPARTITION":                           SUBPARTITION_SYM,
+		"SUBPARTITIONS":                          SUBPARTITIONS_SYM,
+		"SUPER":                                  SUPER_SYM,
+		"SUSPEND":                                SUSPEND_SYM,
+		"SWAPS":                                  SWAPS_SYM,
+		"SWITCHES":                               SWITCHES_SYM,
+		"SYSTEM":                                 SYSTEM_SYM,
+		"TABLE":                                  TABLE_SYM,
+		"TABLE_NAME":                             TABLE_NAME_SYM,
+		"TABLES":                                 TABLES,
+		"TABLESAMPLE":                            TABLESAMPLE_SYM,
+		"TABLESPACE":                             TABLESPACE_SYM,
+		"TABLE_CHECKSUM":                         TABLE_CHECKSUM_SYM,
+		"TEMPORARY":                              TEMPORARY,
+		"TEMPTABLE":                              TEMPTABLE_SYM,
+		"TERMINATED":                             TERMINATED,
+		"TEXT":                                   TEXT_SYM,
+		"THAN":                                   THAN_SYM,
+		"THEN":                                   THEN_SYM,
+		"THREAD_PRIORITY":                        THREAD_PRIORITY_SYM,
+		"TIES":                                   TIES_SYM,
+		"TIME":                                   TIME_SYM,
+		"TIMESTAMP":                              TIMESTAMP_SYM,
+		"TIMESTAMPADD":                           TIMESTAMP_ADD,
+		"TIMESTAMPDIFF":                          TIMESTAMP_DIFF,
+		"TINYBLOB":                               TINYBLOB_SYM,
+		"TINYINT":                                TINYINT_SYM,
+		"TINYTEXT":                               TINYTEXT_SYN,
+		"TLS":                                    TLS_SYM,
+		"TO":                                     TO_SYM,
+		"TRAILING":                               TRAILING,
+		"TRANSACTION":                            TRANSACTION_SYM,
+		"TRIGGER":                                TRIGGER_SYM,
+		"TRIGGERS":                               TRIGGERS_SYM,
+		"TRUE":                                   TRUE_SYM,
+		"TRUNCATE":                               TRUNCATE_SYM,
+		"TYPE":                                   TYPE_SYM,
+		"TYPES":                                  TYPES_SYM,
+		"UNBOUNDED":                              UNBOUNDED_SYM,
+		"UNCOMMITTED":                            UNCOMMITTED_SYM,
+		"UNDEFINED":                              UNDEFINED_SYM,
+		"UNDO_BUFFER_SIZE":                       UNDO_BUFFER_SIZE_SYM,
+		"UNDOFILE":                               UNDOFILE_SYM,
+		"UNDO":                                   UNDO_SYM,
+		"UNICODE":                                UNICODE_SYM,
+		"UNION":                                  UNION_SYM,
+		"UNIQUE":                                 UNIQUE_SYM,
+		"UNKNOWN":                                UNKNOWN_SYM,
+		"UNLOCK":                                 UNLOCK_SYM,
+		"UNINSTALL":                              UNINSTALL_SYM,
+		"UNREGISTER":                             UNREGISTER_SYM,
+		"UNSIGNED":                               UNSIGNED_SYM,
+		"UNTIL":                                  UNTIL_SYM,
+		"UPGRADE":                                UPGRADE_SYM,
+		"URI":                                    URI_SYM,
+		"URL":                                    URL_SYM,
+		"USAGE":                                  USAGE,
+		"USE":                                    USE_SYM,
+		"USER":                                   USER,
+		"USER_RESOURCES":                         RESOURCES,
+		"USE_FRM":                                USE_FRM,
+		"USING":                                  USING,
+		"UTC_DATE":                               UTC_DATE_SYM,
+		"UTC_TIME":                               UTC_TIME_SYM,
+		"UTC_TIMESTAMP":                          UTC_TIMESTAMP_SYM,
+		"VALIDATE":                               VALIDATE_SYM,
+		"VALIDATION":                             VALIDATION_SYM,
+		"VALUE":                                  VALUE_SYM,
+		"VALUES":                                 VALUES,
+		"VARBINARY":                              VARBINARY_SYM,
+		"VARCHAR":                                VARCHAR_SYM,
+		"VARCHARACTER":                           VARCHAR_SYM,
+		"VARIABLES":                              VARIABLES,
+		"VARYING":                                VARYING,
+		"VECTOR":                                 VECTOR_SYM,
+		"VERIFY_KEY_CONSTRAINTS":                 VERIFY_KEY_CONSTRAINTS_SYM,
+		"WAIT":                                   WAIT_SYM,
+		"WARNINGS":                               WARNINGS,
+		"WEEK":                                   WEEK_SYM,
+		"WEIGHT_STRING":                          WEIGHT_STRING_SYM,
+		"WHEN":                                   WHEN_SYM,
+		"WHERE":                                  WHERE,
+		"WHILE":                                  WHILE_SYM,
+		"WINDOW":                                 WINDOW_SYM,
+		"VCPU":                                   VCPU_SYM,
+		"VIEW":                                   VIEW_SYM,
+		"VIRTUAL":                                VIRTUAL_SYM,
+		"VISIBLE":                                VISIBLE_SYM,
+		"WITH":                                   WITH,
+		"WITHOUT":                                WITHOUT_SYM,
+		"WORK":                                   WORK_SYM,
+		"WRAPPER":                                WRAPPER_SYM,
+		"WRITE":                                  WRITE_SYM,
+		"X509":                                   X509_SYM,
+		"XOR":                                    XOR,
+		"XA":                                     XA_SYM,
+		"XID":                                    XID_SYM,
+		"XML":                                    XML_SYM,
+		"YEAR":                                   YEAR_SYM,
+		"YEAR_MONTH":                             YEAR_MONTH_SYM,
+		"ZEROFILL":                               ZEROFILL_SYM,
+		"ZONE":                                   ZONE_SYM,
+		"||":                                     OR_OR_SYM,
+		"DELETE":                                 DELETE_SYM,
+		"INSERT":                                 INSERT_SYM,
+		"REPLACE":                                REPLACE_SYM,
+		"SELECT":                                 SELECT_SYM,
+		"UPDATE":                                 UPDATE_SYM,
+		"ADDDATE":                                ADDDATE_SYM,
+		"BIT_AND":                                BIT_AND_SYM,
+		"BIT_OR":                                 BIT_OR_SYM,
+		"BIT_XOR":                                BIT_XOR_SYM,
+		"CAST":                                   CAST_SYM,
+		"COUNT":                                  COUNT_SYM,
+		"CURDATE":                                CURDATE,
+		"CURTIME":                                CURTIME,
+		"DATE_ADD":                               DATE_ADD_INTERVAL,
+		"DATE_SUB":                               DATE_SUB_INTERVAL,
+		"EXTRACT":                                EXTRACT_SYM,
+		"GROUP_CONCAT":                           GROUP_CONCAT_SYM,
+		"JSON_DUALITY_OBJECT":                    JSON_DUALITY_OBJECT_SYM,
+		"JSON_OBJECTAGG":                         JSON_OBJECTAGG,
+		"JSON_ARRAYAGG":                          JSON_ARRAYAGG,
+		"MAX":                                    MAX_SYM,
+		"MID":                                    SUBSTRING,
+		"MIN":                                    MIN_SYM,
+		"NOW":                                    NOW_SYM,
+		"POSITION":                               POSITION_SYM,
+		"SESSION_USER":                           USER,
+		"STD":                                    STD_SYM,
+		"STDDEV":                                 STD_SYM,
+		"STDDEV_POP":                             STD_SYM,
+		"STDDEV_SAMP":                            STDDEV_SAMP_SYM,
+		"ST_COLLECT":                             ST_COLLECT_SYM,
+		"SUBDATE":                                SUBDATE_SYM,
+		"SUBSTR":                                 SUBSTRING,
+		"SUBSTRING":                              SUBSTRING,
+		"SUM":                                    SUM_SYM,
+		"SYSDATE":                                SYSDATE,
+		"SYSTEM_USER":                            USER,
+		"TRIM":                                   TRIM,
+		"VARIANCE":                               VARIANCE_SYM,
+		"VAR_POP":                                VARIANCE_SYM,
+		"VAR_SAMP":                               VAR_SAMP_SYM,
+		"BKA":                                    BKA_HINT,
+		"BNL":                                    BNL_HINT,
+		"DUPSWEEDOUT":                            DUPSWEEDOUT_HINT,
+		"FIRSTMATCH":                             FIRSTMATCH_HINT,
+		"INTOEXISTS":                             INTOEXISTS_HINT,
+		"LOOSESCAN":                              LOOSESCAN_HINT,
+		"MATERIALIZATION":                        MATERIALIZATION_HINT,
+		"MAX_EXECUTION_TIME":                     MAX_EXECUTION_TIME_HINT,
+		"NO_BKA":                                 NO_BKA_HINT,
+		"NO_BNL":                                 NO_BNL_HINT,
+		"NO_ICP":                                 NO_ICP_HINT,
+		"NO_MRR":                                 NO_MRR_HINT,
+		"NO_RANGE_OPTIMIZATION":                  NO_RANGE_OPTIMIZATION_HINT,
+		"NO_SEMIJOIN":                            NO_SEMIJOIN_HINT,
+		"MRR":                                    MRR_HINT,
+		"QB_NAME":                                QB_NAME_HINT,
+		"SEMIJOIN":                               SEMIJOIN_HINT,
+		"SET_VAR":                                SET_VAR_HINT,
+		"SUBQUERY":                               SUBQUERY_HINT,
+		"NO_MERGE":                               NO_DERIVED_MERGE_HINT,
+		"JOIN_PREFIX":                            JOIN_PREFIX_HINT,
+		"JOIN_SUFFIX":                            JOIN_SUFFIX_HINT,
+		"JOIN_ORDER":                             JOIN_ORDER_HINT,
+		"JOIN_FIXED_ORDER":                       JOIN_FIXED_ORDER_HINT,
+		"INDEX_MERGE":                            INDEX_MERGE_HINT,
+		"NO_INDEX_MERGE":                         NO_INDEX_MERGE_HINT,
+		"RESOURCE_GROUP":                         RESOURCE_GROUP_HINT,
+		"SKIP_SCAN":                              SKIP_SCAN_HINT,
+		"NO_SKIP_SCAN":                           NO_SKIP_SCAN_HINT,
+		"HASH_JOIN":                              HASH_JOIN_HINT,
+		"NO_HASH_JOIN":                           NO_HASH_JOIN_HINT,
+		"NO_INDEX":                               NO_INDEX_HINT,
+		"JOIN_INDEX":                             JOIN_INDEX_HINT,
+		"NO_JOIN_INDEX":                          NO_JOIN_INDEX_HINT,
+		"GROUP_INDEX":                            GROUP_INDEX_HINT,
+		"NO_GROUP_INDEX":                         NO_GROUP_INDEX_HINT,
+		"ORDER_INDEX":                            ORDER_INDEX_HINT,
+		"NO_ORDER_INDEX":                         NO_ORDER_INDEX_HINT,
+		"DERIVED_CONDITION_PUSHDOWN":             DERIVED_CONDITION_PUSHDOWN_HINT,
+		"NO_DERIVED_CONDITION_PUSHDOWN":          NO_DERIVED_CONDITION_PUSHDOWN_HINT,
+	}
 }
 
-// HintKeywords maps optimizer hint names to their token IDs.
+// HintKeywords returns the optimizer hint names and their token IDs.
 // These are only valid inside /*+ ... */ optimizer hint comments.
-var HintKeywords = map[string]int{
-	"BKA":                           BKA_HINT,
-	"BNL":                           BNL_HINT,
-	"DUPSWEEDOUT":                   DUPSWEEDOUT_HINT,
-	"FIRSTMATCH":                    FIRSTMATCH_HINT,
-	"INTOEXISTS":                    INTOEXISTS_HINT,
-	"LOOSESCAN":                     LOOSESCAN_HINT,
-	"MATERIALIZATION":               MATERIALIZATION_HINT,
-	"MAX_EXECUTION_TIME":            MAX_EXECUTION_TIME_HINT,
-	"NO_BKA":                        NO_BKA_HINT,
-	"NO_BNL":                        NO_BNL_HINT,
-	"NO_ICP":                        NO_ICP_HINT,
-	"NO_MRR":                        NO_MRR_HINT,
-	"NO_RANGE_OPTIMIZATION":         NO_RANGE_OPTIMIZATION_HINT,
-	"NO_SEMIJOIN":                   NO_SEMIJOIN_HINT,
-	"MRR":                           MRR_HINT,
-	"QB_NAME":                       QB_NAME_HINT,
-	"SEMIJOIN":                      SEMIJOIN_HINT,
-	"SET_VAR":                       SET_VAR_HINT,
-	"SUBQUERY":                      SUBQUERY_HINT,
-	"MERGE":                         DERIVED_MERGE_HINT,
-	"NO_MERGE":                      NO_DERIVED_MERGE_HINT,
-	"JOIN_PREFIX":                   JOIN_PREFIX_HINT,
-	"JOIN_SUFFIX":                   JOIN_SUFFIX_HINT,
-	"JOIN_ORDER":                    JOIN_ORDER_HINT,
-	"JOIN_FIXED_ORDER":              JOIN_FIXED_ORDER_HINT,
-	"INDEX_MERGE":                   INDEX_MERGE_HINT,
-	"NO_INDEX_MERGE":                NO_INDEX_MERGE_HINT,
-	"RESOURCE_GROUP":                RESOURCE_GROUP_HINT,
-	"SKIP_SCAN":                     SKIP_SCAN_HINT,
-	"NO_SKIP_SCAN":                  NO_SKIP_SCAN_HINT,
-	"HASH_JOIN":                     HASH_JOIN_HINT,
-	"NO_HASH_JOIN":                  NO_HASH_JOIN_HINT,
-	"INDEX":                         INDEX_HINT,
-	"NO_INDEX":                      NO_INDEX_HINT,
-	"JOIN_INDEX":                    JOIN_INDEX_HINT,
-	"NO_JOIN_INDEX":                 NO_JOIN_INDEX_HINT,
-	"GROUP_INDEX":                   GROUP_INDEX_HINT,
-	"NO_GROUP_INDEX":                NO_GROUP_INDEX_HINT,
-	"ORDER_INDEX":                   ORDER_INDEX_HINT,
-	"NO_ORDER_INDEX":                NO_ORDER_INDEX_HINT,
-	"DERIVED_CONDITION_PUSHDOWN":    DERIVED_CONDITION_PUSHDOWN_HINT,
-	"NO_DERIVED_CONDITION_PUSHDOWN": NO_DERIVED_CONDITION_PUSHDOWN_HINT,
+func HintKeywords() map[string]int {
+	return map[string]int{
+		"BKA":                           BKA_HINT,
+		"BNL":                           BNL_HINT,
+		"DUPSWEEDOUT":                   DUPSWEEDOUT_HINT,
+		"FIRSTMATCH":                    FIRSTMATCH_HINT,
+		"INTOEXISTS":                    INTOEXISTS_HINT,
+		"LOOSESCAN":                     LOOSESCAN_HINT,
+		"MATERIALIZATION":               MATERIALIZATION_HINT,
+		"MAX_EXECUTION_TIME":            MAX_EXECUTION_TIME_HINT,
+		"NO_BKA":                        NO_BKA_HINT,
+		"NO_BNL":                        NO_BNL_HINT,
+		"NO_ICP":                        NO_ICP_HINT,
+		"NO_MRR":                        NO_MRR_HINT,
+		"NO_RANGE_OPTIMIZATION":         NO_RANGE_OPTIMIZATION_HINT,
+		"NO_SEMIJOIN":                   NO_SEMIJOIN_HINT,
+		"MRR":                           MRR_HINT,
+		"QB_NAME":                       QB_NAME_HINT,
+		"SEMIJOIN":                      SEMIJOIN_HINT,
+		"SET_VAR":                       SET_VAR_HINT,
+		"SUBQUERY":                      SUBQUERY_HINT,
+		"MERGE":                         DERIVED_MERGE_HINT,
+		"NO_MERGE":                      NO_DERIVED_MERGE_HINT,
+		"JOIN_PREFIX":                   JOIN_PREFIX_HINT,
+		"JOIN_SUFFIX":                   JOIN_SUFFIX_HINT,
+		"JOIN_ORDER":                    JOIN_ORDER_HINT,
+		"JOIN_FIXED_ORDER":              JOIN_FIXED_ORDER_HINT,
+		"INDEX_MERGE":                   INDEX_MERGE_HINT,
+		"NO_INDEX_MERGE":                NO_INDEX_MERGE_HINT,
+		"RESOURCE_GROUP":                RESOURCE_GROUP_HINT,
+		"SKIP_SCAN":                     SKIP_SCAN_HINT,
+		"NO_SKIP_SCAN":                  NO_SKIP_SCAN_HINT,
+		"HASH_JOIN":                     HASH_JOIN_HINT,
+		"NO_HASH_JOIN":                  NO_HASH_JOIN_HINT,
+		"INDEX":                         INDEX_HINT,
+		"NO_INDEX":                      NO_INDEX_HINT,
+		"JOIN_INDEX":                    JOIN_INDEX_HINT,
+		"NO_JOIN_INDEX":                 NO_JOIN_INDEX_HINT,
+		"GROUP_INDEX":                   GROUP_INDEX_HINT,
+		"NO_GROUP_INDEX":                NO_GROUP_INDEX_HINT,
+		"ORDER_INDEX":                   ORDER_INDEX_HINT,
+		"NO_ORDER_INDEX":                NO_ORDER_INDEX_HINT,
+		"DERIVED_CONDITION_PUSHDOWN":    DERIVED_CONDITION_PUSHDOWN_HINT,
+		"NO_DERIVED_CONDITION_PUSHDOWN": NO_DERIVED_CONDITION_PUSHDOWN_HINT,
+	}
 }
 
-// mysql57Keywords contains keywords that only exist in MySQL 5.7.
-// These are obsolete in MySQL 8.0+ and are merged into TokenKeywords at init time.
-var mysql57Keywords = map[string]int{
-	"ANALYSE":                       OBSOLETE_TOKEN_271,
-	"DES_KEY_FILE":                  OBSOLETE_TOKEN_388,
-	"LOCATOR":                       OBSOLETE_TOKEN_538,
-	"PARSE_GCOL_EXPR":               OBSOLETE_TOKEN_654,
-	"REDOFILE":                      OBSOLETE_TOKEN_693,
-	"SERVER_OPTIONS":                OBSOLETE_TOKEN_755,
-	"SQL_CACHE":                     OBSOLETE_TOKEN_784,
-	"TABLE_REF_PRIORITY":            OBSOLETE_TOKEN_820,
-	"UDF_RETURNS":                   OBSOLETE_TOKEN_848,
-	"WITH_CUBE":                     OBSOLETE_TOKEN_893,
-	"MASTER_AUTO_POSITION":          OBSOLETE_TOKEN_550,
-	"MASTER_BIND":                   OBSOLETE_TOKEN_551,
-	"MASTER_CONNECT_RETRY":          OBSOLETE_TOKEN_552,
-	"MASTER_DELAY":                  OBSOLETE_TOKEN_553,
-	"MASTER_HOST":                   OBSOLETE_TOKEN_554,
-	"MASTER_LOG_FILE":               OBSOLETE_TOKEN_555,
-	"MASTER_LOG_POS":                OBSOLETE_TOKEN_556,
-	"MASTER_PASSWORD":               OBSOLETE_TOKEN_557,
-	"MASTER_PORT":                   OBSOLETE_TOKEN_558,
-	"MASTER_RETRY_COUNT":            OBSOLETE_TOKEN_559,
-	"MASTER_SERVER_ID":              OBSOLETE_TOKEN_561,
-	"MASTER_SSL":                    OBSOLETE_TOKEN_562,
-	"MASTER_SSL_CA":                 OBSOLETE_TOKEN_563,
-	"MASTER_SSL_CAPATH":             OBSOLETE_TOKEN_564,
-	"MASTER_SSL_CERT":               OBSOLETE_TOKEN_565,
-	"MASTER_SSL_CIPHER":             OBSOLETE_TOKEN_566,
-	"MASTER_SSL_CRL":                OBSOLETE_TOKEN_567,
-	"MASTER_SSL_CRLPATH":            OBSOLETE_TOKEN_568,
-	"MASTER_SSL_KEY":                OBSOLETE_TOKEN_569,
-	"MASTER_SSL_VERIFY_SERVER_CERT": OBSOLETE_TOKEN_570,
-	"MASTER_TLS_VERSION":            OBSOLETE_TOKEN_572,
-	"MASTER_USER":                   OBSOLETE_TOKEN_573,
+// mysql57Keywords returns the keywords that only exist in MySQL 5.7.
+// These are obsolete in MySQL 8.0+; KeywordsFor adds them for MySQL57.
+func mysql57Keywords() map[string]int {
+	return map[string]int{
+		"ANALYSE":                       OBSOLETE_TOKEN_271,
+		"DES_KEY_FILE":                  OBSOLETE_TOKEN_388,
+		"LOCATOR":                       OBSOLETE_TOKEN_538,
+		"PARSE_GCOL_EXPR":               OBSOLETE_TOKEN_654,
+		"REDOFILE":                      OBSOLETE_TOKEN_693,
+		"SERVER_OPTIONS":                OBSOLETE_TOKEN_755,
+		"SQL_CACHE":                     OBSOLETE_TOKEN_784,
+		"TABLE_REF_PRIORITY":            OBSOLETE_TOKEN_820,
+		"UDF_RETURNS":                   OBSOLETE_TOKEN_848,
+		"WITH_CUBE":                     OBSOLETE_TOKEN_893,
+		"MASTER_AUTO_POSITION":          OBSOLETE_TOKEN_550,
+		"MASTER_BIND":                   OBSOLETE_TOKEN_551,
+		"MASTER_CONNECT_RETRY":          OBSOLETE_TOKEN_552,
+		"MASTER_DELAY":                  OBSOLETE_TOKEN_553,
+		"MASTER_HOST":                   OBSOLETE_TOKEN_554,
+		"MASTER_LOG_FILE":               OBSOLETE_TOKEN_555,
+		"MASTER_LOG_POS":                OBSOLETE_TOKEN_556,
+		"MASTER_PASSWORD":               OBSOLETE_TOKEN_557,
+		"MASTER_PORT":                   OBSOLETE_TOKEN_558,
+		"MASTER_RETRY_COUNT":            OBSOLETE_TOKEN_559,
+		"MASTER_SERVER_ID":              OBSOLETE_TOKEN_561,
+		"MASTER_SSL":                    OBSOLETE_TOKEN_562,
+		"MASTER_SSL_CA":                 OBSOLETE_TOKEN_563,
+		"MASTER_SSL_CAPATH":             OBSOLETE_TOKEN_564,
+		"MASTER_SSL_CERT":               OBSOLETE_TOKEN_565,
+		"MASTER_SSL_CIPHER":             OBSOLETE_TOKEN_566,
+		"MASTER_SSL_CRL":                OBSOLETE_TOKEN_567,
+		"MASTER_SSL_CRLPATH":            OBSOLETE_TOKEN_568,
+		"MASTER_SSL_KEY":                OBSOLETE_TOKEN_569,
+		"MASTER_SSL_VERIFY_SERVER_CERT": OBSOLETE_TOKEN_570,
+		"MASTER_TLS_VERSION":            OBSOLETE_TOKEN_572,
+		"MASTER_USER":                   OBSOLETE_TOKEN_573,
+	}
 }
@@ -2,34 +2,6 @@ package internal
 
 import "fmt"
 
-type LexError struct {
-	Position int
-	Message  string
-	Input    string
-}
-
-func (e *LexError) Error() string {
-	if e.Input != "" {
-		return fmt.Sprintf("lex error at position %d: %s (near %q)", e.Position, e.Message, e.Input)
-	}
-	return fmt.Sprintf("lex error at position %d: %s", e.Position, e.Message)
-}
-
-const (
-	ErrUnterminatedString   = "unterminated string literal"
-	ErrUnterminatedComment  = "unterminated block comment"
-	ErrUnterminatedIdent    = "unterminated quoted identifier"
-	ErrUnterminatedHint     = "unterminated optimizer hint"
-	ErrInvalidHexLiteral    = "invalid hex literal"
-	ErrInvalidBinaryLiteral = "invalid binary literal"
-	ErrInvalidTokenBounds   = "invalid token bounds"
-	ErrUnterminatedDollar   = "unterminated dollar-quoted string"
-)
-
-func NewLexError(position int, message string, input string) *LexError {
-	return &LexError{Position: position, Message: message, Input: input}
-}
-
 type SQLMode uint64
 
 const (
@@ -58,10 +30,17 @@ type Lexer struct {
 	sqlMode          SQLMode
 	stmtPrepareMode  bool
 	inHintComment    bool
+	hintStart        int // offset of the "/*+" of the open hint
 	inVersionComment bool
+	digestText       bool
+	versionSensitive bool
+	src              *lexSource // set when lexing from an io.Reader
 	lastToken        int
 	digestVersion    MySQLVersion
 	tokenConfig      *TokenConfig
+
+	traceStates bool       // keep the states of the current token in states
+	states      []LexState // for a Trace
 }
 
 var mysqlVersionMap = map[MySQLVersion]int{
@@ -71,7 +50,14 @@ var mysqlVersionMap = map[MySQLVersion]int{
 }
 
 func NewLexer(input string) *Lexer {
-	return &Lexer{
+	l := &Lexer{}
+	l.Reset(input)
+	return l
+}
+
+// Reset discards all state and settings and starts lexing input.
+func (l *Lexer) Reset(input string) {
+	*l = Lexer{
 		input:         input,
 		nextState:     MY_LEX_START,
 		digestVersion: MySQL84,
@@ -79,6 +65,20 @@ func NewLexer(input string) *Lexer {
 	}
 }
 
+// sub returns a lexer for input with the settings of l, for text that l
+// skipped, such as the content of a versioned comment for a later version.
+func (l *Lexer) sub(input string) *Lexer {
+	return &Lexer{
+		input:           input,
+		nextState:       MY_LEX_START,
+		sqlMode:         l.sqlMode,
+		stmtPrepareMode: l.stmtPrepareMode,
+		digestText:      l.digestText,
+		digestVersion:   l.digestVersion,
+		tokenConfig:     l.tokenConfig,
+	}
+}
+
 func (l *Lexer) SetSQLMode(mode SQLMode) {
 	l.sqlMode = mode
 }
@@ -92,33 +92,40 @@ func (l *Lexer) mysqlVersionInt() int {
 	return mysqlVersionMap[l.digestVersion]
 }
 
+// VersionSensitive reports whether the tokens lexed so far depend on the
+// digest version: a keyword that only some versions know, or a versioned
+// comment. Otherwise they are the same for every version.
+func (l *Lexer) VersionSensitive() bool {
+	return l.versionSensitive
+}
+
 func (l *Lexer) SetPrepareMode(enabled bool) {
 	l.stmtPrepareMode = enabled
 }
 
 func (l *Lexer) TokenText(t Token) (string, error) {
 	if t.Start < 0 || t.End > len(l.input) || t.Start > t.End {
-		return "", NewLexError(t.Start, ErrInvalidTokenBounds, "")
+		return "", fmt.Errorf("%w: %d..%d", ErrInvalidTokenBounds, t.Start, t.End)
 	}
 	return l.input[t.Start:t.End], nil
 }
 
 func (l *Lexer) peek() byte {
-	if l.pos >= len(l.input) {
+	if l.pos >= len(l.input) && !l.more(1) {
 		return 0
 	}
 	return l.input[l.pos]
 }
 
 func (l *Lexer) peekN(n int) byte {
-	if l.pos+n >= len(l.input) {
+	if l.pos+n >= len(l.input) && !l.more(n+1) {
 		return 0
 	}
 	return l.input[l.pos+n]
 }
 
 func (l *Lexer) advance() byte {
-	if l.pos >= len(l.input) {
+	if l.pos >= len(l.input) && !l.more(1) {
 		return 0
 	}
 	c := l.input[l.pos]
@@ -127,12 +134,15 @@ func (l *Lexer) advance() byte {
 }
 
 func (l *Lexer) skip() {
-	if l.pos < len(l.input) {
+	if l.pos < len(l.input) || l.more(1) {
 		l.pos++
 	}
 }
 
 func (l *Lexer) skipN(n int) {
+	if l.pos+n > len(l.input) {
+		l.more(n)
+	}
 	l.pos += n
 	if l.pos > len(l.input) {
 		l.pos = len(l.input)
@@ -154,16 +164,18 @@ func (l *Lexer) startToken() {
 }
 
 func (l *Lexer) eof() bool {
-	return l.pos >= len(l.input)
+	return l.pos >= len(l.input) && !l.more(1)
 }
 
 func (l *Lexer) findKeyword(length int) int {
 	if length == 0 {
 		return 0
 	}
-	text := l.input[l.tokStart : l.tokStart+length]
-	upper := toUpper(text)
-	return l.tokenConfig.LookupKeyword(upper)
+	word := l.input[l.tokStart : l.tokStart+length]
+	if versionedKeywordTable.Lookup(word) != 0 {
+		l.versionSensitive = true
+	}
+	return l.tokenConfig.LookupKeyword(word)
 }
 
 func (l *Lexer) returnToken(t Token) Token {
@@ -178,6 +190,17 @@ func (l *Lexer) intToken(length int) int {
 }
 
 func (l *Lexer) Lex() Token {
+	if l.src == nil {
+		return l.lex()
+	}
+	l.slide()
+	return l.lex()
+}
+
+func (l *Lexer) lex() Token {
+	if l.traceStates {
+		l.states = l.states[:0]
+	}
 	if l.inHintComment {
 		return l.lexHintToken()
 	}
@@ -187,6 +210,9 @@ func (l *Lexer) Lex() Token {
 	l.nextState = MY_LEX_START
 
 	for {
+		if l.traceStates {
+			l.states = append(l.states, state)
+		}
 		result, handled := l.dispatchState(state)
 		// Fallback for unregistered states. Shouldn't happen.
 		if !handled {
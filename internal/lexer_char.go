@@ -33,19 +33,6 @@ func isAlnum(c byte) bool {
 	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
 }
 
-func toUpper(s string) string {
-	b := make([]byte, len(s))
-	for i := 0; i < len(s); i++ {
-		c := s[i]
-		if c >= 'a' && c <= 'z' {
-			b[i] = c - 32
-		} else {
-			b[i] = c
-		}
-	}
-	return string(b)
-}
-
 func stripIdentifierQuotes(s string) string {
 	if len(s) < 2 {
 		return s
@@ -61,6 +48,13 @@ func stripIdentifierQuotes(s string) string {
 	}
 }
 
-func escapeBackticks(s string) string {
-	return strings.ReplaceAll(s, "`", "``")
+// appendEscapedBackticks appends s to dst with every '`' doubled.
+func appendEscapedBackticks(dst []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		if s[i] == '`' {
+			dst = append(dst, '`')
+		}
+		dst = append(dst, s[i])
+	}
+	return dst
 }
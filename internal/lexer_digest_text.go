@@ -0,0 +1,47 @@
+package internal
+
+import "strings"
+
+// digestPlaceholders are the normalized forms BuildText writes for values,
+// longest first so that a row list is not read as a single row.
+var digestPlaceholders = []struct {
+	text    string
+	tokType int
+}{
+	{"(...) /* , ... */", TOK_ROW_MULTIPLE_VALUE_LIST},
+	{"(?) /* , ... */", TOK_ROW_SINGLE_VALUE_LIST},
+	{"IN (...)", TOK_IN_GENERIC_VALUE_EXPRESSION},
+	{"(...)", TOK_ROW_MULTIPLE_VALUE},
+	{"?, ...", TOK_GENERIC_VALUE_LIST},
+	{"(?)", TOK_ROW_SINGLE_VALUE},
+	{"?", TOK_GENERIC_VALUE},
+}
+
+// SetDigestTextMode makes the lexer read its input as a DIGEST_TEXT, so the
+// placeholders written by BuildText come back as the TOK_* tokens they
+// stand for instead of being lexed as punctuation and comments.
+func (l *Lexer) SetDigestTextMode(enabled bool) {
+	l.digestText = enabled
+}
+
+// lexPlaceholder matches a digest placeholder at the current position.
+func (l *Lexer) lexPlaceholder() (Token, bool) {
+	l.more(len(digestPlaceholders[0].text))
+	rest := l.input[l.pos:]
+	for _, p := range digestPlaceholders {
+		if !strings.HasPrefix(rest, p.text) {
+			continue
+		}
+		// 5.7 has no IN list token; there "IN (...)" is IN followed by a row.
+		if p.tokType == TOK_IN_GENERIC_VALUE_EXPRESSION {
+			l.versionSensitive = true
+			if l.digestVersion == MySQL57 {
+				continue
+			}
+		}
+		start := l.pos
+		l.skipN(len(p.text))
+		return l.returnToken(Token{Type: p.tokType, Start: start, End: l.pos}), true
+	}
+	return Token{}, false
+}
@@ -0,0 +1,98 @@
+package internal
+
+import "testing"
+
+// fuzzSeeds covers each lexer state at least once; most are taken from the
+// table tests in this package and digest_test.go.
+var fuzzSeeds = []string{
+	"SELECT * FROM users WHERE id = 123",
+	"SELECT 'it''s a test', \"dq\", 'back\\'slash'",
+	"SELECT N'nchar', _utf8mb4'abc', x'4D7953', X'', b'0101', 0x1F, 0b11, 0xZZ",
+	"SELECT 1, -2, +3.5, .5e-3, 1e10, 1.e, 18446744073709551616, 2147483648",
+	"SELECT a FROM t -- line comment\nWHERE b # hash comment\n= 1",
+	"SELECT /* block */ 1 /*!50000 STRAIGHT_JOIN */ /*!99999 hidden */ /*! always */",
+	"SELECT /*+ MAX_EXECUTION_TIME(1000) NO_INDEX(t idx) SET_VAR(x='y') */ 1",
+	"INSERT /*+ QB_NAME(`q``b`) */ INTO t VALUES (1, 'a'), (2, 'b')",
+	"SELECT $$dollar$$, $tag$ quoted $ text $tag$, $notquoted",
+	"SELECT @a, @@global.max_connections, @'quoted', @`bt`, @\"dq\", @@session.sql_mode",
+	"SELECT a->'$.b', a->>'$.c', a <=> b, a <> b, a != b, a && b, a || b, a := 1",
+	"SELECT `用户`, 名字 FROM `员工表` WHERE `部门` = '技术'",
+	"SELECT * FROM t WHERE x IN (1, 2, 3) AND y IN (SELECT id FROM u)",
+	"SELECT ?, ? FROM t WHERE a = ? LIMIT ?, ?",
+	"SELECT a.b.c, t.*, 1a, 1e5x, 0x1g FROM db.t",
+	"SELECT 'unterminated",
+	"SELECT /* unterminated",
+	"SELECT /*+ unterminated",
+	"SELECT `unterminated",
+	"SELECT $$unterminated",
+	"SELECT x'ABC'",
+	"SELECT b'012'",
+	"",
+	";",
+	"SELECT 1;",
+}
+
+// FuzzLexer checks that the lexer terminates and produces in-bounds,
+// non-overlapping token spans for arbitrary input.
+func FuzzLexer(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(s, uint8(0))
+	}
+	f.Add("SELECT \"a\" FROM \"t\"", uint8(MODE_ANSI_QUOTES))
+	f.Add("SELECT 'a\\'", uint8(MODE_NO_BACKSLASH_ESCAPES))
+
+	f.Fuzz(checkLexInvariants)
+}
+
+// FuzzHintLexer is FuzzLexer with the input placed inside an optimizer hint,
+// so mutations exercise the hint lexer rather than the main state machine.
+func FuzzHintLexer(f *testing.F) {
+	f.Add("MAX_EXECUTION_TIME(1000) */ 1", uint8(0))
+	f.Add("SET_VAR(`col``name`='a''b') NO_INDEX(t idx) */", uint8(0))
+	f.Add("QB_NAME(qb1) BKA(t1@qb1) */ * FROM t", uint8(0))
+	f.Add("'unterminated", uint8(0))
+	f.Add("`unterminated", uint8(0))
+
+	f.Fuzz(func(t *testing.T, input string, flags uint8) {
+		checkLexInvariants(t, "SELECT /*+ "+input, flags)
+	})
+}
+
+func checkLexInvariants(t *testing.T, input string, flags uint8) {
+	for _, version := range []MySQLVersion{MySQL57, MySQL80, MySQL84, MySQL90} {
+		l := NewLexer(input)
+		l.SetSQLMode(SQLMode(flags) & (MODE_ANSI_QUOTES | MODE_NO_BACKSLASH_ESCAPES))
+		l.SetDigestVersion(version)
+		l.SetPrepareMode(flags&0x80 != 0)
+
+		prevEnd := 0
+		// Every token consumes at least one byte except the final one.
+		limit := len(input) + 2
+		for i := 0; ; i++ {
+			if i > limit {
+				t.Fatalf("lexer did not terminate after %d tokens on %q", i, input)
+			}
+			tok := l.Lex()
+
+			if tok.Start < 0 || tok.End > len(input) || tok.Start > tok.End {
+				t.Fatalf("token %d (%s) span [%d,%d) out of bounds for %q",
+					i, TokenString(tok.Type), tok.Start, tok.End, input)
+			}
+			if tok.Start < prevEnd {
+				t.Fatalf("token %d (%s) span [%d,%d) overlaps previous end %d in %q",
+					i, TokenString(tok.Type), tok.Start, tok.End, prevEnd, input)
+			}
+			if _, err := l.TokenText(tok); err != nil {
+				t.Fatalf("TokenText(%+v) error: %v", tok, err)
+			}
+			prevEnd = tok.End
+
+			if tok.Type == END_OF_INPUT || tok.Type == ABORT_SYM {
+				break
+			}
+			if tok.Err != nil {
+				t.Fatalf("token %d (%s) has error %v but is not ABORT_SYM", i, TokenString(tok.Type), tok.Err)
+			}
+		}
+	}
+}
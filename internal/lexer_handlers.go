@@ -31,6 +31,11 @@ func (l *Lexer) handleStart() lexResult {
 		l.skip()
 	}
 	l.startToken()
+	if l.digestText {
+		if tok, ok := l.lexPlaceholder(); ok {
+			return doneWithNext(tok, MY_LEX_START)
+		}
+	}
 	c := l.advance()
 	return cont(getStateMap(c))
 }
@@ -267,6 +272,11 @@ func (l *Lexer) handleUserVariable() lexResult {
 		// Another @ follows - this is a system variable (@@var)
 		return doneWithNext(Token{Type: int('@'), Start: l.tokStart, End: l.pos}, MY_LEX_SYSTEM_VAR)
 	default:
+		if l.digestText {
+			// Variable names were written as "@?", so a word after '@' is
+			// a token of its own.
+			return doneWithNext(Token{Type: int('@'), Start: l.tokStart, End: l.pos}, MY_LEX_START)
+		}
 		// Identifier follows - user variable, use MY_LEX_HOSTNAME to return LEX_HOSTNAME
 		return doneWithNext(Token{Type: int('@'), Start: l.tokStart, End: l.pos}, MY_LEX_HOSTNAME)
 	}
@@ -471,7 +481,7 @@ func (l *Lexer) handleHexNumber() lexResult {
 				Type:  ABORT_SYM,
 				Start: l.tokStart,
 				End:   l.pos,
-				Err:   NewLexError(l.tokStart, ErrInvalidHexLiteral, ""),
+				Err:   l.syntaxError(InvalidHexLiteral),
 			})
 		}
 		if !isHexDigit(c) {
@@ -479,7 +489,7 @@ func (l *Lexer) handleHexNumber() lexResult {
 				Type:  ABORT_SYM,
 				Start: l.tokStart,
 				End:   l.pos,
-				Err:   NewLexError(l.tokStart, ErrInvalidHexLiteral, ""),
+				Err:   l.syntaxError(InvalidHexLiteral),
 			})
 		}
 	}
@@ -490,7 +500,7 @@ func (l *Lexer) handleHexNumber() lexResult {
 			Type:  ABORT_SYM,
 			Start: l.tokStart,
 			End:   l.pos,
-			Err:   NewLexError(l.tokStart, ErrInvalidHexLiteral, ""),
+			Err:   l.syntaxError(InvalidHexLiteral),
 		})
 	}
 	return done(Token{Type: HEX_NUM, Start: l.tokStart, End: l.pos})
@@ -509,7 +519,7 @@ func (l *Lexer) handleBinNumber() lexResult {
 				Type:  ABORT_SYM,
 				Start: l.tokStart,
 				End:   l.pos,
-				Err:   NewLexError(l.tokStart, ErrInvalidBinaryLiteral, ""),
+				Err:   l.syntaxError(InvalidBinaryLiteral),
 			})
 		}
 		if c != '0' && c != '1' {
@@ -517,7 +527,7 @@ func (l *Lexer) handleBinNumber() lexResult {
 				Type:  ABORT_SYM,
 				Start: l.tokStart,
 				End:   l.pos,
-				Err:   NewLexError(l.tokStart, ErrInvalidBinaryLiteral, ""),
+				Err:   l.syntaxError(InvalidBinaryLiteral),
 			})
 		}
 	}
@@ -571,11 +581,15 @@ func (l *Lexer) scanQuoted(sep byte, mode QuoteScanMode, tokenType int) lexResul
 		c := l.advance()
 		if c == 0 {
 			// Unterminated quoted literal
+			kind := UnterminatedString
+			if tokenType == IDENT_QUOTED {
+				kind = UnterminatedIdentifier
+			}
 			return done(Token{
 				Type:  ABORT_SYM,
 				Start: l.tokStart,
 				End:   l.pos,
-				Err:   NewLexError(l.tokStart, ErrUnterminatedString, l.input),
+				Err:   l.syntaxError(kind),
 			})
 		}
 
@@ -689,6 +703,7 @@ func (l *Lexer) handleOptimizerHint() lexResult {
 	if TokenIsHintable(l.lastToken) {
 		// Enter hint mode
 		l.inHintComment = true
+		l.hintStart = l.tokStart
 		return done(l.returnToken(Token{Type: TOK_HINT_COMMENT_OPEN, Start: l.tokStart, End: l.pos}))
 	}
 
@@ -706,6 +721,7 @@ func (l *Lexer) handleVersionComment() lexResult {
 	if digitCount >= 5 {
 		// Skip the version digits
 		l.skipN(digitCount)
+		l.versionSensitive = true
 
 		// Check if version is <= configured MySQL version
 		if version <= l.mysqlVersionInt() {
@@ -738,7 +754,7 @@ func (l *Lexer) consumeBlockComment() lexResult {
 			Type:  ABORT_SYM,
 			Start: l.tokStart,
 			End:   l.pos,
-			Err:   NewLexError(l.tokStart, ErrUnterminatedComment, l.input),
+			Err:   l.syntaxError(UnterminatedComment),
 		})
 	}
 	return cont(MY_LEX_START)
@@ -785,11 +801,16 @@ func (l *Lexer) scanDollarQuotedString(tag string) Token {
 	closingLen := len(closingDelim)
 
 	for !l.eof() {
-		if l.pos+closingLen <= len(l.input) && l.input[l.pos:l.pos+closingLen] == closingDelim {
+		if l.more(closingLen) && l.input[l.pos:l.pos+closingLen] == closingDelim {
 			l.pos += closingLen
 			return l.returnToken(Token{Type: DOLLAR_QUOTED_STRING_SYM, Start: l.tokStart, End: l.pos})
 		}
 		l.pos++
 	}
-	return l.returnToken(Token{Type: ABORT_SYM, Start: l.tokStart, End: l.pos})
+	return l.returnToken(Token{
+		Type:  ABORT_SYM,
+		Start: l.tokStart,
+		End:   l.pos,
+		Err:   l.syntaxError(UnterminatedDollarQuote),
+	})
 }
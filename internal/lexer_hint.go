@@ -17,6 +17,12 @@ func (l *Lexer) lexHintToken() Token {
 		return l.lexHintEOF()
 	}
 
+	if l.digestText {
+		if tok, ok := l.lexPlaceholder(); ok {
+			return tok
+		}
+	}
+
 	c := l.advance()
 
 	// Dispatch based on first character
@@ -53,7 +59,7 @@ func (l *Lexer) lexHintEOF() Token {
 		Type:  ABORT_SYM,
 		Start: l.tokStart,
 		End:   l.pos,
-		Err:   NewLexError(l.tokStart, ErrUnterminatedHint, ""),
+		Err:   l.syntaxErrorAt(l.hintStart, UnterminatedHint),
 	})
 }
 
@@ -64,8 +70,7 @@ func (l *Lexer) lexHintIdentOrKeyword() Token {
 	length := l.tokenLen()
 
 	// Check if it's a hint keyword
-	text := l.input[l.tokStart : l.tokStart+length]
-	if tok, ok := HintKeywords[toUpper(text)]; ok {
+	if tok := hintKeywordTable.Lookup(l.input[l.tokStart : l.tokStart+length]); tok != 0 {
 		return l.returnToken(Token{Type: tok, Start: l.tokStart, End: l.pos})
 	}
 
@@ -88,7 +93,7 @@ func (l *Lexer) lexHintString() Token {
 				Type:  ABORT_SYM,
 				Start: l.tokStart,
 				End:   l.pos,
-				Err:   NewLexError(l.tokStart, ErrUnterminatedString, ""),
+				Err:   l.syntaxError(UnterminatedString),
 			})
 		}
 		l.skip()
@@ -112,7 +117,7 @@ func (l *Lexer) lexHintQuotedIdent() Token {
 				Type:  ABORT_SYM,
 				Start: l.tokStart,
 				End:   l.pos,
-				Err:   NewLexError(l.tokStart, ErrUnterminatedIdent, ""),
+				Err:   l.syntaxError(UnterminatedIdentifier),
 			})
 		}
 		l.skip()
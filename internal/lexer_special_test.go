@@ -193,3 +193,26 @@ func TestLexer_Special_MultipleStatements(t *testing.T) {
 		}
 	}
 }
+
+func TestLexer_VersionSensitive(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"SELECT a FROM t WHERE b IN (1, 2)", false},
+		{"SELECT /*!40101 SQL_NO_CACHE */ 1", true},
+		{"SELECT /*! STRAIGHT_JOIN */ 1", false},
+		{"SELECT * FROM t QUALIFY x = 1", true},
+		{"CHANGE MASTER TO MASTER_HOST = 'h'", true},
+		{"SELECT `qualify` FROM t", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			rec := Record(NewLexer(tt.input), 0)
+			if got := rec.VersionSensitive(); got != tt.want {
+				t.Errorf("VersionSensitive() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package internal
 
+import "strconv"
+
 // Matches MySQL's my_lex_states enum in strings/sql_chars.h
 type LexState int
 
@@ -39,6 +41,50 @@ const (
 	MY_LEX_STRING_OR_DELIMITER
 )
 
+var lexStateNames = [...]string{
+	MY_LEX_START:                       "START",
+	MY_LEX_CHAR:                        "CHAR",
+	MY_LEX_IDENT:                       "IDENT",
+	MY_LEX_IDENT_SEP:                   "IDENT_SEP",
+	MY_LEX_IDENT_START:                 "IDENT_START",
+	MY_LEX_REAL:                        "REAL",
+	MY_LEX_HEX_NUMBER:                  "HEX_NUMBER",
+	MY_LEX_BIN_NUMBER:                  "BIN_NUMBER",
+	MY_LEX_CMP_OP:                      "CMP_OP",
+	MY_LEX_LONG_CMP_OP:                 "LONG_CMP_OP",
+	MY_LEX_STRING:                      "STRING",
+	MY_LEX_COMMENT:                     "COMMENT",
+	MY_LEX_END:                         "END",
+	MY_LEX_NUMBER_IDENT:                "NUMBER_IDENT",
+	MY_LEX_INT_OR_REAL:                 "INT_OR_REAL",
+	MY_LEX_REAL_OR_POINT:               "REAL_OR_POINT",
+	MY_LEX_BOOL:                        "BOOL",
+	MY_LEX_EOL:                         "EOL",
+	MY_LEX_LONG_COMMENT:                "LONG_COMMENT",
+	MY_LEX_END_LONG_COMMENT:            "END_LONG_COMMENT",
+	MY_LEX_SEMICOLON:                   "SEMICOLON",
+	MY_LEX_SET_VAR:                     "SET_VAR",
+	MY_LEX_USER_END:                    "USER_END",
+	MY_LEX_HOSTNAME:                    "HOSTNAME",
+	MY_LEX_SKIP:                        "SKIP",
+	MY_LEX_USER_VARIABLE_DELIMITER:     "USER_VARIABLE_DELIMITER",
+	MY_LEX_SYSTEM_VAR:                  "SYSTEM_VAR",
+	MY_LEX_IDENT_OR_KEYWORD:            "IDENT_OR_KEYWORD",
+	MY_LEX_IDENT_OR_HEX:                "IDENT_OR_HEX",
+	MY_LEX_IDENT_OR_BIN:                "IDENT_OR_BIN",
+	MY_LEX_IDENT_OR_NCHAR:              "IDENT_OR_NCHAR",
+	MY_LEX_IDENT_OR_DOLLAR_QUOTED_TEXT: "IDENT_OR_DOLLAR_QUOTED_TEXT",
+	MY_LEX_STRING_OR_DELIMITER:         "STRING_OR_DELIMITER",
+}
+
+// String returns the name of the state without its MY_LEX_ prefix.
+func (s LexState) String() string {
+	if s >= 0 && int(s) < len(lexStateNames) {
+		return lexStateNames[s]
+	}
+	return "LexState(" + strconv.Itoa(int(s)) + ")"
+}
+
 // Matches MySQL's init_state_maps() from strings/sql_chars.cc
 var stateMap [256]LexState
 
@@ -0,0 +1,111 @@
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"unsafe"
+)
+
+const (
+	// streamChunk is how much is read from the reader at a time.
+	streamChunk = 32 * 1024
+	// streamKeep is how many bytes before the current token are kept.
+	streamKeep = 16
+)
+
+// lexSource feeds the lexer from an io.Reader. l.input is a view of the
+// window buf holds; bytes before the current token are dropped between
+// tokens, so memory is bounded by the longest token rather than the
+// statement.
+type lexSource struct {
+	r    io.Reader
+	buf  []byte
+	base int   // offset of buf[0] in the whole statement
+	err  error // first error from r; io.EOF at the end of the statement
+
+	// lines counts the newlines dropped from the window so far and
+	// lineStart is the offset just after the last of them.
+	lines     int
+	lineStart int
+}
+
+// ResetReader discards all state and settings and starts lexing the
+// statement read from r.
+func (l *Lexer) ResetReader(r io.Reader) {
+	l.Reset("")
+	l.src = &lexSource{r: r}
+}
+
+// Streaming reports whether the lexer reads from an io.Reader. Token text
+// then only stays valid until the next call to Lex.
+func (l *Lexer) Streaming() bool {
+	return l.src != nil
+}
+
+// ReadErr returns the error that stopped reading the statement, if it was
+// not the end of the input.
+func (l *Lexer) ReadErr() error {
+	if l.src == nil || errors.Is(l.src.err, io.EOF) {
+		return nil
+	}
+	return l.src.err
+}
+
+// more reads until n bytes past the current position are available and
+// reports whether they are.
+func (l *Lexer) more(n int) bool {
+	src := l.src
+	if src == nil {
+		return l.pos+n <= len(l.input)
+	}
+	for empty := 0; l.pos+n > len(src.buf) && src.err == nil; {
+		if cap(src.buf)-len(src.buf) < streamChunk {
+			grown := make([]byte, len(src.buf), 2*cap(src.buf)+streamChunk)
+			copy(grown, src.buf)
+			src.buf = grown
+		}
+		k, err := src.r.Read(src.buf[len(src.buf):cap(src.buf)])
+		src.buf = src.buf[:len(src.buf)+k]
+		switch {
+		case err != nil:
+			src.err = err
+		case k > 0:
+			empty = 0
+		default:
+			if empty++; empty >= 100 {
+				src.err = io.ErrNoProgress
+			}
+		}
+	}
+	l.input = unsafe.String(unsafe.SliceData(src.buf), len(src.buf))
+	return l.pos+n <= len(l.input)
+}
+
+// slide drops the bytes before the next token, or before an open optimizer
+// hint, once they take up more than half the window. It is only called
+// between tokens, when no position into the window is held outside the
+// lexer.
+func (l *Lexer) slide() {
+	src := l.src
+	keep := l.pos
+	if l.inHintComment {
+		keep = min(keep, l.hintStart)
+	}
+	drop := keep - streamKeep
+	if drop <= 0 || drop < len(src.buf)/2 {
+		return
+	}
+	dropped := src.buf[:drop]
+	if n := bytes.Count(dropped, []byte{'\n'}); n > 0 {
+		src.lines += n
+		src.lineStart = src.base + bytes.LastIndexByte(dropped, '\n') + 1
+	}
+	n := copy(src.buf, src.buf[drop:])
+	src.buf = src.buf[:n]
+	src.base += drop
+	l.pos -= drop
+	l.tokStart -= drop
+	l.hintStart -= drop
+	l.input = unsafe.String(unsafe.SliceData(src.buf), len(src.buf))
+}
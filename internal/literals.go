@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+)
+
+// LiteralMode says what the digest text shows in place of a literal. The
+// hash never depends on it.
+type LiteralMode int
+
+const (
+	// LiteralNormalize prints ? and the folded value lists, like the
+	// server.
+	LiteralNormalize LiteralMode = iota
+	// LiteralVerbatim prints each literal as it was written.
+	LiteralVerbatim
+	// LiteralHMAC prints each literal as ?# and the first 16 hex digits of
+	// its keyed HMAC-SHA256. Without the key the literal cannot be told
+	// from the tag, even a short or guessable one; with it, guesses can be
+	// checked, so the key must be kept like the data. At 64 bits, two
+	// different literals in a workload of billions rarely share a tag.
+	LiteralHMAC
+)
+
+// hmacLen is how many bytes of the HMAC a LiteralHMAC placeholder shows.
+const hmacLen = 8
+
+// literalText returns what the text shows for the literal tok, with sign
+// in front, or "" when literals are normalized.
+func (h *tokenHandler) literalText(tok Token, sign string) string {
+	if h.literals == LiteralNormalize {
+		return ""
+	}
+	text, err := h.lexer.TokenText(tok)
+	if err != nil {
+		return sign + "?"
+	}
+	if h.literals == LiteralHMAC {
+		h.mac.Reset()
+		io.WriteString(h.mac, text)
+		var sum [sha256.Size]byte
+		return sign + "?#" + hex.EncodeToString(h.mac.Sum(sum[:0])[:hmacLen])
+	}
+	return sign + strings.Clone(text) // the input may be a reused buffer
+}
+
+// SetLiteralMode chooses how the text of the next Run shows literals.
+// key is the HMAC key for LiteralHMAC, which fails without one. maxLen,
+// when above 0, is the text length later passed to AppendText; literal
+// texts are not kept past it.
+func (s *State) SetLiteralMode(mode LiteralMode, key []byte, maxLen int) error {
+	if mode == LiteralHMAC && len(key) == 0 {
+		return ErrNoLiteralKey
+	}
+	s.handler.literals = mode
+	s.store.keepLiterals = mode != LiteralNormalize
+	s.store.literalsMax = maxLen
+	if mode == LiteralHMAC {
+		s.handler.mac = hmac.New(sha256.New, key)
+	}
+	return nil
+}
+
+// joinLiterals spells the top n tokens, which a reduction is about to
+// replace, with the literal texts of the values among them. The first is
+// the list being folded into, if any: its text is grown in place with the
+// others, so that a list of n values takes O(n) to spell, and once it is
+// longer than literalsMax, where the text is cut, nothing more is added.
+func (s *tokenStore) joinLiterals(n int) *strings.Builder {
+	toks := s.tokens[len(s.tokens)-n:]
+	b := toks[0].literals
+	if b == nil {
+		b = new(strings.Builder)
+		s.writeLiteral(b, toks[0])
+	}
+	for _, tok := range toks[1:] {
+		if s.literalsMax > 0 && b.Len() > s.literalsMax {
+			break
+		}
+		s.writeLiteral(b, tok)
+	}
+	return b
+}
+
+func (s *tokenStore) writeLiteral(b *strings.Builder, tok storedToken) {
+	switch {
+	case tok.text != "":
+		b.WriteString(tok.text)
+	case tok.tokType == ',':
+		b.WriteString(", ")
+	case tok.tokType == IN_SYM:
+		b.WriteString("IN ")
+	default:
+		b.WriteString(s.tokenConfig.GetString(tok.tokType))
+	}
+}
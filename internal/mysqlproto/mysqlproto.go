@@ -0,0 +1,319 @@
+// Package mysqlproto decodes the parts of the MySQL client/server protocol
+// that carry statements: packet framing, the handshake response, and the
+// COM_QUERY, COM_INIT_DB and COM_STMT_PREPARE commands. Compressed and TLS
+// connections are not decoded.
+package mysqlproto
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Command bytes, the first byte of a command packet.
+const (
+	ComQuit             = 0x01
+	ComInitDB           = 0x02
+	ComQuery            = 0x03
+	ComFieldList        = 0x04
+	ComStatistics       = 0x09
+	ComPing             = 0x0e
+	ComChangeUser       = 0x11
+	ComStmtPrepare      = 0x16
+	ComStmtExecute      = 0x17
+	ComStmtSendLongData = 0x18
+	ComStmtClose        = 0x19
+	ComStmtReset        = 0x1a
+	ComSetOption        = 0x1b
+	ComStmtFetch        = 0x1c
+	ComResetConnection  = 0x1f
+)
+
+var commandNames = map[byte]string{
+	ComQuit:             "COM_QUIT",
+	ComInitDB:           "COM_INIT_DB",
+	ComQuery:            "COM_QUERY",
+	ComFieldList:        "COM_FIELD_LIST",
+	ComStatistics:       "COM_STATISTICS",
+	ComPing:             "COM_PING",
+	ComChangeUser:       "COM_CHANGE_USER",
+	ComStmtPrepare:      "COM_STMT_PREPARE",
+	ComStmtExecute:      "COM_STMT_EXECUTE",
+	ComStmtSendLongData: "COM_STMT_SEND_LONG_DATA",
+	ComStmtClose:        "COM_STMT_CLOSE",
+	ComStmtReset:        "COM_STMT_RESET",
+	ComSetOption:        "COM_SET_OPTION",
+	ComStmtFetch:        "COM_STMT_FETCH",
+	ComResetConnection:  "COM_RESET_CONNECTION",
+}
+
+// CommandName returns the name of command byte c, e.g. "COM_QUERY".
+func CommandName(c byte) string {
+	if name, ok := commandNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("COM_0x%02x", c)
+}
+
+// Capability flags of the handshake.
+const (
+	ClientConnectWithDB    = 1 << 3
+	ClientCompress         = 1 << 5
+	ClientProtocol41       = 1 << 9
+	ClientSSL              = 1 << 11
+	ClientSecureConn       = 1 << 15
+	ClientPluginAuth       = 1 << 19
+	ClientPluginAuthLenenc = 1 << 21
+	ClientDeprecateEOF     = 1 << 24
+	ClientQueryAttributes  = 1 << 27
+)
+
+// MaxPayload is the largest payload of one packet. A payload of exactly
+// MaxPayload is continued in the next packet.
+const MaxPayload = 1<<24 - 1
+
+// Packet is one packet of the protocol: a sequence number and up to
+// MaxPayload bytes of payload.
+type Packet struct {
+	Seq     byte
+	Payload []byte
+}
+
+// ReadPacket reads one packet from r.
+func ReadPacket(r io.Reader) (Packet, error) {
+	var hdr [4]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return Packet{}, err
+	}
+	n := int(hdr[0]) | int(hdr[1])<<8 | int(hdr[2])<<16
+	p := Packet{Seq: hdr[3], Payload: make([]byte, n)}
+	if _, err := io.ReadFull(r, p.Payload); err != nil {
+		return Packet{}, noEOF(err)
+	}
+	return p, nil
+}
+
+// WritePacket writes p to w as one packet; its payload must not be longer
+// than MaxPayload.
+func WritePacket(w io.Writer, p Packet) error {
+	if len(p.Payload) > MaxPayload {
+		return fmt.Errorf("mysqlproto: payload of %d bytes is too long for a packet", len(p.Payload))
+	}
+	buf := make([]byte, 4, 4+len(p.Payload))
+	n := len(p.Payload)
+	buf[0], buf[1], buf[2], buf[3] = byte(n), byte(n>>8), byte(n>>16), p.Seq
+	_, err := w.Write(append(buf, p.Payload...))
+	return err
+}
+
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// Splitter cuts a byte stream, fed as it arrives, into packets.
+type Splitter struct {
+	buf []byte
+}
+
+// Write appends data from the stream.
+func (s *Splitter) Write(data []byte) {
+	s.buf = append(s.buf, data...)
+}
+
+// Next returns the next whole packet, or false until more data arrives.
+// The payload is only valid until the next call to Write.
+func (s *Splitter) Next() (Packet, bool) {
+	if len(s.buf) < 4 {
+		return Packet{}, false
+	}
+	n := int(s.buf[0]) | int(s.buf[1])<<8 | int(s.buf[2])<<16
+	if len(s.buf) < 4+n {
+		return Packet{}, false
+	}
+	p := Packet{Seq: s.buf[3], Payload: s.buf[4 : 4+n : 4+n]}
+	s.buf = s.buf[4+n:]
+	if len(s.buf) == 0 {
+		s.buf = nil // let the buffer of a large packet go
+	}
+	return p, true
+}
+
+// Reset drops what was buffered, after a gap in the stream.
+func (s *Splitter) Reset() {
+	s.buf = nil
+}
+
+var errShort = errors.New("mysqlproto: packet too short")
+
+// HandshakeResponse is the client's answer to the server greeting.
+type HandshakeResponse struct {
+	Capabilities uint32
+	User         string
+	Database     string // set with ClientConnectWithDB
+}
+
+// IsSSLRequest reports whether payload, the first packet from the client,
+// asks to switch to TLS: the truncated handshake response sent before the
+// TLS handshake.
+func IsSSLRequest(payload []byte) bool {
+	return len(payload) == 32 && binary.LittleEndian.Uint32(payload)&ClientSSL != 0
+}
+
+// ParseHandshakeResponse decodes a HandshakeResponse41 packet.
+func ParseHandshakeResponse(payload []byte) (HandshakeResponse, error) {
+	var h HandshakeResponse
+	if len(payload) < 32 {
+		return h, errShort
+	}
+	h.Capabilities = binary.LittleEndian.Uint32(payload)
+	if h.Capabilities&ClientProtocol41 == 0 {
+		return h, errors.New("mysqlproto: pre-4.1 handshake response")
+	}
+	rest := payload[32:] // capabilities, max packet size, charset, filler
+
+	user, rest, ok := cutNull(rest)
+	if !ok {
+		return h, errShort
+	}
+	h.User = string(user)
+
+	// auth-response
+	switch {
+	case h.Capabilities&ClientPluginAuthLenenc != 0:
+		n, m, ok := readLenenc(rest)
+		if !ok || uint64(len(rest)-m) < n {
+			return h, errShort
+		}
+		rest = rest[m+int(n):]
+	case h.Capabilities&ClientSecureConn != 0:
+		if len(rest) < 1 || len(rest) < 1+int(rest[0]) {
+			return h, errShort
+		}
+		rest = rest[1+int(rest[0]):]
+	default:
+		if _, rest, ok = cutNull(rest); !ok {
+			return h, errShort
+		}
+	}
+
+	if h.Capabilities&ClientConnectWithDB != 0 {
+		db, _, ok := cutNull(rest)
+		if !ok {
+			db = rest
+		}
+		h.Database = string(db)
+	}
+	return h, nil
+}
+
+// Greeting is the server's first packet.
+type Greeting struct {
+	ServerVersion string
+}
+
+// ParseGreeting decodes a protocol 10 server greeting.
+func ParseGreeting(payload []byte) (Greeting, error) {
+	if len(payload) < 1 || payload[0] != 10 {
+		return Greeting{}, errors.New("mysqlproto: not a protocol 10 greeting")
+	}
+	version, _, ok := cutNull(payload[1:])
+	if !ok {
+		return Greeting{}, errShort
+	}
+	return Greeting{ServerVersion: string(version)}, nil
+}
+
+// QueryText returns the statement of a COM_QUERY payload, after the
+// command byte. With ClientQueryAttributes the statement follows a
+// parameter count and set count; queries with attributes are rejected,
+// as their values would have to be decoded to find the statement.
+func QueryText(payload []byte, caps uint32) (string, error) {
+	if len(payload) < 1 || payload[0] != ComQuery {
+		return "", errors.New("mysqlproto: not a COM_QUERY packet")
+	}
+	body := payload[1:]
+	if caps&ClientQueryAttributes != 0 {
+		params, n, ok := readLenenc(body)
+		if !ok {
+			return "", errShort
+		}
+		if params > 0 {
+			return "", errors.New("mysqlproto: COM_QUERY with query attributes")
+		}
+		if _, m, ok := readLenenc(body[n:]); ok { // parameter set count, always 1
+			n += m
+		}
+		body = body[n:]
+	}
+	return string(body), nil
+}
+
+// ServerError is an ERR packet.
+type ServerError struct {
+	Code     uint16
+	SQLState string
+	Message  string
+}
+
+func (e *ServerError) Error() string {
+	if e.SQLState == "" {
+		return fmt.Sprintf("ERROR %d: %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("ERROR %d (%s): %s", e.Code, e.SQLState, e.Message)
+}
+
+// ParseError decodes an ERR packet, or returns nil if payload is not one.
+func ParseError(payload []byte) *ServerError {
+	if len(payload) < 3 || payload[0] != 0xff {
+		return nil
+	}
+	e := &ServerError{Code: binary.LittleEndian.Uint16(payload[1:])}
+	msg := payload[3:]
+	if len(msg) >= 6 && msg[0] == '#' {
+		e.SQLState, msg = string(msg[1:6]), msg[6:]
+	}
+	e.Message = string(msg)
+	return e
+}
+
+func cutNull(b []byte) (before, after []byte, ok bool) {
+	for i, c := range b {
+		if c == 0 {
+			return b[:i], b[i+1:], true
+		}
+	}
+	return nil, nil, false
+}
+
+// readLenenc reads a length-encoded integer, returning it and the bytes it
+// took.
+func readLenenc(b []byte) (uint64, int, bool) {
+	if len(b) == 0 {
+		return 0, 0, false
+	}
+	var n int
+	switch b[0] {
+	case 0xfc:
+		n = 2
+	case 0xfd:
+		n = 3
+	case 0xfe:
+		n = 8
+	case 0xfb, 0xff:
+		return 0, 0, false
+	default:
+		return uint64(b[0]), 1, true
+	}
+	if len(b) < 1+n {
+		return 0, 0, false
+	}
+	var v uint64
+	for i := n; i > 0; i-- {
+		v = v<<8 | uint64(b[i])
+	}
+	return v, 1 + n, true
+}
@@ -0,0 +1,187 @@
+package mysqlproto
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+	"testing"
+)
+
+func packet(seq byte, payload string) []byte {
+	var buf bytes.Buffer
+	if err := WritePacket(&buf, Packet{Seq: seq, Payload: []byte(payload)}); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadWritePacket(t *testing.T) {
+	var buf bytes.Buffer
+	for i, payload := range []string{"\x03SELECT 1", "", strings.Repeat("x", 300)} {
+		if err := WritePacket(&buf, Packet{Seq: byte(i), Payload: []byte(payload)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i, want := range []string{"\x03SELECT 1", "", strings.Repeat("x", 300)} {
+		p, err := ReadPacket(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Seq != byte(i) || string(p.Payload) != want {
+			t.Errorf("packet %d = %d %q", i, p.Seq, p.Payload)
+		}
+	}
+	if _, err := ReadPacket(&buf); err != io.EOF {
+		t.Errorf("at the end: %v", err)
+	}
+	if _, err := ReadPacket(bytes.NewReader(packet(0, "abc")[:5])); err != io.ErrUnexpectedEOF {
+		t.Errorf("cut short: %v", err)
+	}
+	if err := WritePacket(io.Discard, Packet{Payload: make([]byte, MaxPayload+1)}); err == nil {
+		t.Error("oversized payload written")
+	}
+}
+
+func TestSplitter(t *testing.T) {
+	stream := append(packet(0, "\x03SELECT 1"), packet(1, "abc")...)
+	var s Splitter
+	var got []string
+	for _, b := range stream { // a byte at a time
+		s.Write([]byte{b})
+		for {
+			p, ok := s.Next()
+			if !ok {
+				break
+			}
+			got = append(got, string(rune('0'+p.Seq))+string(p.Payload))
+		}
+	}
+	if want := []string{"0\x03SELECT 1", "1abc"}; strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	s.Write(packet(0, "abc")[:5])
+	s.Reset()
+	s.Write(packet(0, "xy"))
+	if p, ok := s.Next(); !ok || string(p.Payload) != "xy" {
+		t.Errorf("after Reset: %q %v", p.Payload, ok)
+	}
+}
+
+// handshakeResponse builds a HandshakeResponse41 payload.
+func handshakeResponse(caps uint32, user, auth, db string) []byte {
+	b := make([]byte, 32)
+	binary.LittleEndian.PutUint32(b, caps)
+	binary.LittleEndian.PutUint32(b[4:], 1<<24)
+	b[8] = 45 // utf8mb4
+	b = append(b, user...)
+	b = append(b, 0)
+	switch {
+	case caps&ClientPluginAuthLenenc != 0, caps&ClientSecureConn != 0:
+		b = append(b, byte(len(auth)))
+		b = append(b, auth...)
+	default:
+		b = append(b, auth...)
+		b = append(b, 0)
+	}
+	if caps&ClientConnectWithDB != 0 {
+		b = append(b, db...)
+		b = append(b, 0)
+	}
+	if caps&ClientPluginAuth != 0 {
+		b = append(b, "caching_sha2_password\x00"...)
+	}
+	return b
+}
+
+func TestParseHandshakeResponse(t *testing.T) {
+	base := uint32(ClientProtocol41 | ClientPluginAuth)
+	for _, tt := range []struct {
+		name string
+		caps uint32
+		db   string
+	}{
+		{"lenenc auth", base | ClientPluginAuthLenenc | ClientConnectWithDB, "shop"},
+		{"secure connection", base | ClientSecureConn | ClientConnectWithDB, "shop"},
+		{"null terminated auth", base | ClientConnectWithDB, "shop"},
+		{"no database", base | ClientSecureConn, ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := ParseHandshakeResponse(handshakeResponse(tt.caps, "app", "\x01\x02\x03", tt.db))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if h.Capabilities != tt.caps || h.User != "app" || h.Database != tt.db {
+				t.Errorf("got %+v", h)
+			}
+		})
+	}
+
+	if _, err := ParseHandshakeResponse(make([]byte, 10)); err == nil {
+		t.Error("short packet: no error")
+	}
+	if _, err := ParseHandshakeResponse(handshakeResponse(ClientSecureConn, "app", "", "")); err == nil {
+		t.Error("pre-4.1 response: no error")
+	}
+	ssl := handshakeResponse(ClientProtocol41|ClientSSL, "", "", "")[:32]
+	if !IsSSLRequest(ssl) {
+		t.Error("SSL request not recognized")
+	}
+	if IsSSLRequest(handshakeResponse(ClientProtocol41|ClientSSL|ClientSecureConn, "app", "x", "")) {
+		t.Error("full handshake response taken for an SSL request")
+	}
+}
+
+func TestParseGreeting(t *testing.T) {
+	g, err := ParseGreeting([]byte("\x0a8.0.36-log\x00\x01\x00\x00\x00abcdefgh\x00"))
+	if err != nil || g.ServerVersion != "8.0.36-log" {
+		t.Errorf("got %+v, %v", g, err)
+	}
+	if _, err := ParseGreeting([]byte("\xff\x15\x04Host is blocked")); err == nil {
+		t.Error("ERR packet taken for a greeting")
+	}
+}
+
+func TestQueryText(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		payload string
+		caps    uint32
+		want    string
+		err     bool
+	}{
+		{"plain", "\x03SELECT 1", 0, "SELECT 1", false},
+		{"attributes, none set", "\x03\x00\x01SELECT 1", ClientQueryAttributes, "SELECT 1", false},
+		{"attributes set", "\x03\x01\x01\x00\x01\x08\x00\x01aSELECT 1", ClientQueryAttributes, "", true},
+		{"not a query", "\x16SELECT 1", 0, "", true},
+		{"empty", "", 0, "", true},
+	} {
+		got, err := QueryText([]byte(tt.payload), tt.caps)
+		if got != tt.want || (err != nil) != tt.err {
+			t.Errorf("%s: got %q, %v", tt.name, got, err)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	e := ParseError([]byte("\xff\x7a\x04#42S02Table 'shop.t' doesn't exist"))
+	if e == nil || e.Code != 1146 || e.SQLState != "42S02" || e.Message != "Table 'shop.t' doesn't exist" {
+		t.Fatalf("got %+v", e)
+	}
+	if got, want := e.Error(), "ERROR 1146 (42S02): Table 'shop.t' doesn't exist"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if e := ParseError([]byte("\x00\x00\x00\x02\x00\x00\x00")); e != nil {
+		t.Errorf("OK packet parsed as %+v", e)
+	}
+}
+
+func TestCommandName(t *testing.T) {
+	if got := CommandName(ComStmtPrepare); got != "COM_STMT_PREPARE" {
+		t.Errorf("got %q", got)
+	}
+	if got := CommandName(0x7f); got != "COM_0x7f" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,374 @@
+package mysqlproto
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+)
+
+// Why a Session stopped decoding a connection.
+var (
+	ErrEncrypted  = errors.New("mysqlproto: connection switched to TLS")
+	ErrCompressed = errors.New("mysqlproto: connection uses the compressed protocol")
+)
+
+// Command is a command a client sent, with what is known of the server's
+// response to it.
+type Command struct {
+	Time   time.Time // when the command was sent
+	Type   byte      // ComQuery, ComInitDB, ...
+	Schema string    // the default database it ran in
+
+	// SQL is the statement of ComQuery and ComStmtPrepare, the prepared
+	// statement ComStmtExecute runs, if its preparation was seen, and the
+	// new default database of ComInitDB.
+	SQL string
+
+	// ServerVersion is the version from the server greeting, when the
+	// connection was seen from its start.
+	ServerVersion string
+
+	// Latency runs from Time to the end of the response: its last packet
+	// when the response could be followed, else the last server data
+	// before the next command. It is 0 when the server sent nothing.
+	Latency time.Duration
+	Err     *ServerError // the server answered with an ERR packet
+}
+
+type sessionPhase int
+
+const (
+	phaseStart     sessionPhase = iota // nothing seen yet
+	phaseHandshake                     // greeting seen, waiting for the client's response
+	phaseCommand
+	phaseStopped
+)
+
+// responseState is where a Session is in the server's response to the
+// pending command.
+type responseState int
+
+const (
+	respUntracked   responseState = iota // the response ends with the next command
+	respFirst                            // the first packet of a result
+	respDefinitions                      // column or parameter definitions
+	respRows                             // rows, up to an EOF or OK packet
+)
+
+// Status flag of OK and EOF packets.
+const serverMoreResultsExists = 0x0008
+
+// Session follows one connection, fed with the data of each direction as
+// it arrives, and passes each command to Emit once its response is over:
+// at the response's last packet, or when the next command or the end of
+// the connection shows it is.
+//
+// A connection seen from its start gives the server version, the
+// capabilities the client asked for and the database it connected to, and
+// responses are followed packet by packet. One picked up in the middle is
+// assumed to be at a packet boundary, and starts with no default database.
+type Session struct {
+	Emit func(Command)
+
+	// Err is why the session stopped decoding: ErrEncrypted or
+	// ErrCompressed.
+	Err error
+
+	phase          sessionPhase
+	client, server Splitter
+	partial        []byte // a command longer than MaxPayload so far
+
+	caps      uint32
+	capsKnown bool
+	schema    string
+	version   string
+	stmts     map[uint32]string // prepared statements by id
+
+	pending    *Command
+	firstReply bool // the next server packet is the first of the response
+	resp       responseState
+	remaining  int           // definitions left in respDefinitions
+	then       responseState // what follows the definitions, or respUntracked for the end
+}
+
+// Client adds data the client sent at t.
+func (s *Session) Client(data []byte, t time.Time) {
+	if s.phase == phaseStopped {
+		return
+	}
+	s.client.Write(data)
+	for s.phase != phaseStopped {
+		p, ok := s.client.Next()
+		if !ok {
+			return
+		}
+		s.clientPacket(p, t)
+	}
+}
+
+func (s *Session) clientPacket(p Packet, t time.Time) {
+	switch {
+	case s.phase == phaseHandshake:
+		s.phase = phaseCommand
+		if IsSSLRequest(p.Payload) {
+			s.stop(ErrEncrypted)
+			return
+		}
+		h, err := ParseHandshakeResponse(p.Payload)
+		if err != nil {
+			return
+		}
+		s.caps, s.capsKnown, s.schema = h.Capabilities, true, h.Database
+		if h.Capabilities&ClientCompress != 0 {
+			s.stop(ErrCompressed)
+		}
+	case s.partial != nil:
+		s.partial = append(s.partial, p.Payload...)
+		if len(p.Payload) < MaxPayload {
+			payload := s.partial
+			s.partial = nil
+			s.command(payload, t)
+		}
+	case p.Seq != 0:
+		// The rest of the authentication, or LOAD DATA LOCAL file contents.
+	case len(p.Payload) == MaxPayload:
+		s.phase = phaseCommand
+		s.partial = append([]byte(nil), p.Payload...)
+	default:
+		s.phase = phaseCommand
+		s.command(p.Payload, t)
+	}
+}
+
+func (s *Session) command(payload []byte, t time.Time) {
+	s.finish()
+	if len(payload) == 0 {
+		return
+	}
+	c := &Command{Time: t, Type: payload[0], Schema: s.schema, ServerVersion: s.version}
+	resp := respFirst
+	switch c.Type {
+	case ComQuery:
+		caps := s.caps
+		if !s.capsKnown && len(payload) >= 3 && payload[1] == 0 && payload[2] == 1 {
+			// No statement starts with a NUL byte: this is a parameter
+			// count of 0 and a parameter set count of 1.
+			caps |= ClientQueryAttributes
+		}
+		sql, err := QueryText(payload, caps)
+		if err != nil {
+			return
+		}
+		c.SQL = sql
+	case ComStmtPrepare:
+		c.SQL = string(payload[1:])
+	case ComStmtExecute:
+		if len(payload) >= 5 {
+			c.SQL = s.stmts[binary.LittleEndian.Uint32(payload[1:])]
+		}
+	case ComStmtFetch, ComFieldList:
+		resp = respRows
+	case ComStmtClose, ComStmtSendLongData, ComQuit:
+		// No response.
+		if c.Type == ComStmtClose && len(payload) >= 5 {
+			delete(s.stmts, binary.LittleEndian.Uint32(payload[1:]))
+		}
+		s.pending = c
+		s.finish()
+		return
+	case ComInitDB:
+		c.SQL = string(payload[1:])
+		s.schema = c.SQL
+	case ComResetConnection, ComChangeUser:
+		s.stmts = nil
+		if c.Type == ComChangeUser {
+			resp = respUntracked // an authentication exchange
+		}
+	}
+	if !s.capsKnown {
+		resp = respUntracked
+	}
+	s.pending, s.firstReply, s.resp = c, true, resp
+}
+
+// Server adds data the server sent at t.
+func (s *Session) Server(data []byte, t time.Time) {
+	if s.phase == phaseStopped {
+		return
+	}
+	if s.pending != nil {
+		s.pending.Latency = t.Sub(s.pending.Time)
+	}
+	s.server.Write(data)
+	for {
+		p, ok := s.server.Next()
+		if !ok {
+			return
+		}
+		switch {
+		case s.phase == phaseStart:
+			s.phase = phaseCommand
+			if g, err := ParseGreeting(p.Payload); err == nil && p.Seq == 0 {
+				s.version, s.phase = g.ServerVersion, phaseHandshake
+			}
+		case s.pending != nil && len(p.Payload) > 0:
+			s.reply(p.Payload)
+		}
+	}
+}
+
+// reply follows a packet of the response to the pending command.
+func (s *Session) reply(payload []byte) {
+	c := s.pending
+	first := s.firstReply
+	s.firstReply = false
+	if first {
+		c.Err = ParseError(payload)
+		if c.Type == ComStmtPrepare && payload[0] == 0x00 && len(payload) >= 5 {
+			if s.stmts == nil {
+				s.stmts = make(map[uint32]string)
+			}
+			s.stmts[binary.LittleEndian.Uint32(payload[1:])] = c.SQL
+		}
+	}
+
+	switch s.resp {
+	case respFirst:
+		switch {
+		case payload[0] == 0xff:
+			if c.Err == nil {
+				c.Err = ParseError(payload) // of a later result
+			}
+			s.finish()
+		case payload[0] == 0x00 && c.Type == ComStmtPrepare:
+			s.prepared(payload)
+		case payload[0] == 0x00:
+			s.endResult(payload)
+		case payload[0] == 0xfb && c.Type == ComQuery:
+			// LOAD DATA LOCAL: the client sends the file, then the server
+			// its OK or ERR.
+		case c.Type == ComQuery || c.Type == ComStmtExecute:
+			n, _, ok := readLenenc(payload)
+			if !ok {
+				s.resp = respUntracked
+				return
+			}
+			s.definitions(int(n)+s.eofs(n > 0), respRows)
+		default:
+			s.finish() // a single OK, or the text of COM_STATISTICS
+		}
+	case respDefinitions:
+		s.remaining--
+		if s.remaining <= 0 {
+			s.definitions(0, s.then)
+		}
+	case respRows:
+		switch {
+		case payload[0] == 0xff:
+			if c.Err == nil {
+				c.Err = ParseError(payload)
+			}
+			s.finish()
+		case payload[0] == 0xfe && len(payload) < MaxPayload:
+			s.endResult(payload)
+		}
+	}
+}
+
+// prepared follows the OK of COM_STMT_PREPARE: the definitions of its
+// parameters and columns come next.
+func (s *Session) prepared(payload []byte) {
+	if len(payload) < 9 {
+		s.finish()
+		return
+	}
+	cols := int(binary.LittleEndian.Uint16(payload[5:]))
+	params := int(binary.LittleEndian.Uint16(payload[7:]))
+	s.definitions(cols+params+s.eofs(cols > 0)+s.eofs(params > 0), respUntracked)
+}
+
+// definitions expects n definition packets, then state; respUntracked
+// there ends the response.
+func (s *Session) definitions(n int, state responseState) {
+	if n > 0 {
+		s.resp, s.remaining, s.then = respDefinitions, n, state
+		return
+	}
+	if state == respUntracked {
+		s.finish()
+		return
+	}
+	s.resp = state
+}
+
+// eofs returns the number of EOF packets after a set of definitions: one,
+// if there are any, unless the client asked for CLIENT_DEPRECATE_EOF.
+func (s *Session) eofs(some bool) int {
+	if some && s.caps&ClientDeprecateEOF == 0 {
+		return 1
+	}
+	return 0
+}
+
+// endResult follows the OK or EOF packet that ends a result, which is
+// followed by another when the server has more results.
+func (s *Session) endResult(payload []byte) {
+	var status uint16
+	switch {
+	case payload[0] == 0xfe && s.caps&ClientDeprecateEOF == 0:
+		if len(payload) >= 5 {
+			status = binary.LittleEndian.Uint16(payload[3:])
+		}
+	default:
+		rest := payload[1:]
+		for range 2 { // affected rows, last insert id
+			_, n, ok := readLenenc(rest)
+			if !ok {
+				rest = nil
+				break
+			}
+			rest = rest[n:]
+		}
+		if len(rest) >= 2 {
+			status = binary.LittleEndian.Uint16(rest)
+		}
+	}
+	if status&serverMoreResultsExists != 0 {
+		s.resp = respFirst
+		return
+	}
+	s.finish()
+}
+
+// Gap tells s that data of the connection was lost: the command waiting
+// for its response is passed on, and decoding starts again at the next
+// data, assumed to be at a packet boundary.
+func (s *Session) Gap() {
+	s.finish()
+	s.client.Reset()
+	s.server.Reset()
+	s.partial = nil
+}
+
+// Close passes on the command waiting for its response.
+func (s *Session) Close() {
+	s.finish()
+}
+
+func (s *Session) finish() {
+	if s.pending == nil {
+		return
+	}
+	c := *s.pending
+	s.pending, s.resp = nil, respUntracked
+	if s.Emit != nil {
+		s.Emit(c)
+	}
+}
+
+func (s *Session) stop(err error) {
+	s.finish()
+	s.Err, s.phase = err, phaseStopped
+	s.client.Reset()
+	s.server.Reset()
+}
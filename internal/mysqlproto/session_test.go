@@ -0,0 +1,282 @@
+package mysqlproto
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+// conversation feeds a Session and records the commands it emits.
+type conversation struct {
+	s    Session
+	now  time.Time
+	cmds []Command
+}
+
+func newConversation() *conversation {
+	c := &conversation{now: start}
+	c.s.Emit = func(cmd Command) { c.cmds = append(c.cmds, cmd) }
+	return c
+}
+
+// client sends packets from the client, a millisecond after the last data.
+func (c *conversation) client(packets ...[]byte) {
+	c.now = c.now.Add(time.Millisecond)
+	for _, p := range packets {
+		c.s.Client(p, c.now)
+	}
+}
+
+func (c *conversation) server(packets ...[]byte) {
+	c.now = c.now.Add(time.Millisecond)
+	for _, p := range packets {
+		c.s.Server(p, c.now)
+	}
+}
+
+const (
+	greeting = "\x0a8.0.36\x00\x08\x00\x00\x00salt1234\x00"
+	okPacket = "\x00\x00\x00\x02\x00\x00\x00"
+)
+
+func (c *conversation) handshake(caps uint32, db string) {
+	c.server(packet(0, greeting))
+	c.client(packet(1, string(handshakeResponse(caps, "app", "\x01\x02", db))))
+	c.server(packet(2, "\x01\x04")) // fast auth success
+	c.server(packet(3, okPacket))
+}
+
+func (c *conversation) summary() string {
+	var lines []string
+	for _, cmd := range c.cmds {
+		line := fmt.Sprintf("%s %s schema=%s sql=%q latency=%v",
+			cmd.Time.Sub(start), CommandName(cmd.Type), cmd.Schema, cmd.SQL, cmd.Latency)
+		if cmd.Err != nil {
+			line += fmt.Sprintf(" err=%d", cmd.Err.Code)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestSession(t *testing.T) {
+	c := newConversation()
+	c.handshake(ClientProtocol41|ClientSecureConn|ClientPluginAuth|ClientConnectWithDB, "shop")
+
+	c.client(packet(0, "\x03SELECT * FROM t WHERE id = 1"))
+	c.server(packet(1, "\x01"), packet(2, "column def"), packet(3, "\xfe\x00\x00\x02\x00"))
+	c.server(packet(4, "\x011"), packet(5, "\xfe\x00\x00\x02\x00"))
+
+	c.client(packet(0, "\x02archive"))
+	c.server(packet(1, okPacket))
+
+	c.client(packet(0, "\x03SELECT * FROM missing"))
+	c.server(packet(1, "\xff\x7a\x04#42S02Table 'archive.missing' doesn't exist"))
+
+	c.client(packet(0, "\x16SELECT * FROM t WHERE id = ?"))
+	c.server(packet(1, "\x00\x01\x00\x00\x00\x01\x00\x01\x00\x00"))
+
+	c.client(packet(0, "\x0e")) // COM_PING
+	c.server(packet(1, okPacket))
+	c.client(packet(0, "\x01")) // COM_QUIT
+	c.s.Close()
+
+	want := `5ms COM_QUERY schema=shop sql="SELECT * FROM t WHERE id = 1" latency=2ms
+8ms COM_INIT_DB schema=shop sql="archive" latency=1ms
+10ms COM_QUERY schema=archive sql="SELECT * FROM missing" latency=1ms err=1146
+12ms COM_STMT_PREPARE schema=archive sql="SELECT * FROM t WHERE id = ?" latency=1ms
+14ms COM_PING schema=archive sql="" latency=1ms
+16ms COM_QUIT schema=archive sql="" latency=0s`
+	if got := c.summary(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+	for _, cmd := range c.cmds {
+		if cmd.ServerVersion != "8.0.36" {
+			t.Errorf("%s: ServerVersion = %q", CommandName(cmd.Type), cmd.ServerVersion)
+		}
+	}
+	if c.s.Err != nil {
+		t.Errorf("Err = %v", c.s.Err)
+	}
+}
+
+func TestSession_SplitPackets(t *testing.T) {
+	c := newConversation()
+	c.handshake(ClientProtocol41|ClientSecureConn, "")
+
+	// A command split over TCP segments, and two in one segment.
+	query := packet(0, "\x03SELECT 1")
+	c.client(query[:3])
+	c.client(query[3:])
+	c.server(packet(1, okPacket))
+	c.client(append(packet(0, "\x03SELECT 2"), packet(0, "\x03SELECT 3")...))
+
+	// A command longer than one packet.
+	long := "SELECT '" + strings.Repeat("x", MaxPayload) + "'"
+	payload := "\x03" + long
+	c.client(packet(0, payload[:MaxPayload]), packet(1, payload[MaxPayload:]))
+	c.s.Close()
+
+	if len(c.cmds) != 4 {
+		t.Fatalf("got %d commands, want 4", len(c.cmds))
+	}
+	for i, want := range []string{"SELECT 1", "SELECT 2", "SELECT 3", long} {
+		if c.cmds[i].SQL != want {
+			t.Errorf("command %d: %d bytes, want %d", i, len(c.cmds[i].SQL), len(want))
+		}
+	}
+}
+
+func TestSession_QueryAttributes(t *testing.T) {
+	c := newConversation()
+	c.handshake(ClientProtocol41|ClientSecureConn|ClientQueryAttributes, "")
+	c.client(packet(0, "\x03\x00\x01SELECT 1"))
+	c.s.Close()
+
+	// Picked up in the middle, the attribute counts are recognized.
+	m := newConversation()
+	m.client(packet(0, "\x03\x00\x01SELECT 2"))
+	m.client(packet(0, "\x03SELECT 3"))
+	m.s.Close()
+
+	got := c.summary() + "\n" + m.summary()
+	want := `5ms COM_QUERY schema= sql="SELECT 1" latency=0s
+1ms COM_QUERY schema= sql="SELECT 2" latency=0s
+2ms COM_QUERY schema= sql="SELECT 3" latency=0s`
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestSession_MidStream(t *testing.T) {
+	c := newConversation()
+	c.server(packet(4, "\x011")) // the end of a response
+	c.client(packet(0, "\x03SELECT 1"))
+	c.server(packet(1, okPacket))
+	c.s.Close()
+	if want := `2ms COM_QUERY schema= sql="SELECT 1" latency=1ms`; c.summary() != want {
+		t.Errorf("got %s", c.summary())
+	}
+	if c.cmds[0].ServerVersion != "" {
+		t.Errorf("ServerVersion = %q", c.cmds[0].ServerVersion)
+	}
+}
+
+func TestSession_Gap(t *testing.T) {
+	c := newConversation()
+	c.client(packet(0, "\x03SELECT 1"))
+	c.client(packet(0, "\x03SELECT 2")[:6]) // the rest was lost
+	c.s.Gap()
+	c.client(packet(0, "\x03SELECT 3"))
+	c.s.Close()
+	want := `1ms COM_QUERY schema= sql="SELECT 1" latency=0s
+3ms COM_QUERY schema= sql="SELECT 3" latency=0s`
+	if got := c.summary(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestSession_NotDecoded(t *testing.T) {
+	c := newConversation()
+	c.server(packet(0, greeting))
+	c.client(packet(1, string(handshakeResponse(ClientProtocol41|ClientSSL, "", "", "")[:32])))
+	c.client([]byte("\x16\x03\x01\x02\x00")) // TLS
+	if c.s.Err != ErrEncrypted || len(c.cmds) != 0 {
+		t.Errorf("TLS: Err = %v, %d commands", c.s.Err, len(c.cmds))
+	}
+
+	c = newConversation()
+	c.handshake(ClientProtocol41|ClientSecureConn|ClientCompress, "")
+	c.client([]byte("\x0d\x00\x00\x00\x00\x00\x00\x09\x00\x00\x00\x03SELECT 1"))
+	if c.s.Err != ErrCompressed || len(c.cmds) != 0 {
+		t.Errorf("compressed: Err = %v, %d commands", c.s.Err, len(c.cmds))
+	}
+}
+
+func TestSession_Responses(t *testing.T) {
+	const (
+		eof      = "\xfe\x00\x00\x02\x00"
+		eofMore  = "\xfe\x00\x00\x0a\x00" // SERVER_MORE_RESULTS_EXISTS
+		okMore   = "\x00\x00\x00\x0a\x00\x00\x00"
+		okEOF    = "\xfe\x00\x00\x02\x00\x00\x00" // an OK ending rows, with CLIENT_DEPRECATE_EOF
+		errTable = "\xff\x7a\x04#42S02Table 't' doesn't exist"
+	)
+	for _, tt := range []struct {
+		name    string
+		caps    uint32
+		command string
+		reply   []string // each a packet, in one server write each
+		want    string   // the command, as summary prints it, once the response is over
+	}{{
+		name:    "result set",
+		command: "\x03SELECT a FROM t",
+		reply:   []string{"\x01", "def a", eof, "\x011", "\x012", eof},
+		want:    `5ms COM_QUERY schema= sql="SELECT a FROM t" latency=6ms`,
+	}, {
+		name:    "result set, deprecated EOF",
+		caps:    ClientDeprecateEOF,
+		command: "\x03SELECT a FROM t",
+		reply:   []string{"\x01", "def a", "\x011", okEOF},
+		want:    `5ms COM_QUERY schema= sql="SELECT a FROM t" latency=4ms`,
+	}, {
+		name:    "multiple results",
+		command: "\x03CALL p()",
+		reply:   []string{"\x01", "def a", eof, "\x011", eofMore, okMore, errTable},
+		want:    `5ms COM_QUERY schema= sql="CALL p()" latency=7ms err=1146`,
+	}, {
+		name:    "prepare",
+		command: "\x16SELECT a, b FROM t WHERE c = ?",
+		reply:   []string{"\x00\x07\x00\x00\x00\x02\x00\x01\x00\x00\x00\x00", "def c", eof, "def a", "def b", eof},
+		want:    `5ms COM_STMT_PREPARE schema= sql="SELECT a, b FROM t WHERE c = ?" latency=6ms`,
+	}, {
+		name:    "prepare, deprecated EOF",
+		caps:    ClientDeprecateEOF,
+		command: "\x16UPDATE t SET a = ?",
+		reply:   []string{"\x00\x07\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00", "def ?"},
+		want:    `5ms COM_STMT_PREPARE schema= sql="UPDATE t SET a = ?" latency=2ms`,
+	}, {
+		name:    "load data local",
+		command: "\x03LOAD DATA LOCAL INFILE 'f' INTO TABLE t",
+		reply:   []string{"\xfbf", "\x00\x03\x00\x02\x00\x00\x00"},
+		want:    `5ms COM_QUERY schema= sql="LOAD DATA LOCAL INFILE 'f' INTO TABLE t" latency=2ms`,
+	}} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newConversation()
+			c.handshake(ClientProtocol41|ClientSecureConn|tt.caps, "")
+			c.client(packet(0, tt.command))
+			for i, p := range tt.reply {
+				if len(c.cmds) != 0 {
+					t.Fatalf("emitted before reply packet %d", i)
+				}
+				c.server(packet(byte(i+1), p))
+			}
+			if got := c.summary(); got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSession_PreparedStatements(t *testing.T) {
+	c := newConversation()
+	c.handshake(ClientProtocol41|ClientSecureConn|ClientDeprecateEOF, "")
+	c.client(packet(0, "\x16SELECT ?"))
+	c.server(packet(1, "\x00\x07\x00\x00\x00\x01\x00\x01\x00\x00\x00\x00"), packet(2, "def ?"), packet(3, "def a"))
+	execute := "\x17\x07\x00\x00\x00\x00\x01\x00\x00\x00"
+	c.client(packet(0, execute))
+	c.server(packet(1, "\x01"), packet(2, "def a"), packet(3, "\x00\x00\x011"), packet(4, "\xfe\x00\x00\x02\x00\x00\x00"))
+	c.client(packet(0, "\x19\x07\x00\x00\x00")) // COM_STMT_CLOSE
+	c.client(packet(0, execute))
+	c.server(packet(1, "\xff\x13\x05#HY000Unknown prepared statement handler"))
+
+	want := `5ms COM_STMT_PREPARE schema= sql="SELECT ?" latency=1ms
+7ms COM_STMT_EXECUTE schema= sql="SELECT ?" latency=1ms
+9ms COM_STMT_CLOSE schema= sql="" latency=0s
+10ms COM_STMT_EXECUTE schema= sql="" latency=1ms err=1299`
+	if got := c.summary(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,171 @@
+package pcap
+
+import (
+	"sort"
+	"time"
+)
+
+// maxPending bounds the out of order data kept for one direction. Past it
+// the missing data is given up on and the stream goes on after a gap.
+const maxPending = 4 << 20
+
+// Assembler puts the segments of each TCP flow back in order, dropping
+// retransmitted data, and hands the flow's bytes to Data as they line up.
+type Assembler struct {
+	// Data receives the next bytes of flow f, at the time of the segment
+	// that completed them.
+	Data func(f Flow, data []byte, t time.Time)
+
+	// Gap tells that bytes of f were lost: the capture missed them, or
+	// they came too far out of order. Data goes on after them.
+	Gap func(f Flow)
+
+	// Close tells that f ended with a FIN or RST, or started again with a
+	// SYN. It is also called for every open flow by Flush.
+	Close func(f Flow)
+
+	streams map[Flow]*stream
+}
+
+type stream struct {
+	next     uint32 // sequence number of the next byte to deliver
+	pending  []pendingSegment
+	buffered int
+}
+
+type pendingSegment struct {
+	seq  uint32
+	data []byte
+	fin  bool
+	time time.Time
+}
+
+// Add adds a segment captured at t.
+func (a *Assembler) Add(seg Segment, t time.Time) {
+	if a.streams == nil {
+		a.streams = make(map[Flow]*stream)
+	}
+	f := seg.Flow()
+	s := a.streams[f]
+	switch {
+	case seg.RST:
+		if s != nil {
+			a.close(f)
+		}
+		return
+	case seg.SYN:
+		if s != nil {
+			a.close(f)
+		}
+		a.streams[f] = &stream{next: seg.Seq + 1}
+		return
+	case s == nil:
+		if len(seg.Payload) == 0 {
+			return // a bare ACK of a connection that started before the capture
+		}
+		s = &stream{next: seg.Seq}
+		a.streams[f] = s
+	}
+
+	if len(seg.Payload) == 0 && !seg.FIN {
+		return
+	}
+	if seg.Seq != s.next || len(s.pending) > 0 {
+		s.insert(pendingSegment{seq: seg.Seq, data: append([]byte(nil), seg.Payload...), fin: seg.FIN, time: t})
+		a.drain(f, s)
+		return
+	}
+	a.deliver(f, s, seg.Payload, seg.FIN, t)
+}
+
+// insert keeps the pending segments sorted by sequence number.
+func (s *stream) insert(p pendingSegment) {
+	i := sort.Search(len(s.pending), func(i int) bool { return seqBefore(p.seq, s.pending[i].seq) })
+	s.pending = append(s.pending, pendingSegment{})
+	copy(s.pending[i+1:], s.pending[i:])
+	s.pending[i] = p
+	s.buffered += len(p.data)
+}
+
+// drain delivers the pending segments that line up with s.next. When too
+// much is pending, it skips to the first of them.
+func (a *Assembler) drain(f Flow, s *stream) {
+	for len(s.pending) > 0 {
+		p := s.pending[0]
+		if seqBefore(s.next, p.seq) {
+			if s.buffered <= maxPending {
+				return
+			}
+			if a.Gap != nil {
+				a.Gap(f)
+			}
+			s.next = p.seq
+		}
+		s.pending = s.pending[1:]
+		s.buffered -= len(p.data)
+		if skip := int(s.next - p.seq); skip < len(p.data) {
+			p.data = p.data[skip:]
+		} else {
+			p.data = nil // all retransmitted
+		}
+		if a.deliver(f, s, p.data, p.fin, p.time) {
+			return
+		}
+	}
+}
+
+// deliver hands data to Data and reports whether it closed the flow.
+func (a *Assembler) deliver(f Flow, s *stream, data []byte, fin bool, t time.Time) bool {
+	if len(data) > 0 {
+		s.next += uint32(len(data))
+		if a.Data != nil {
+			a.Data(f, data, t)
+		}
+	}
+	if fin {
+		a.close(f)
+		return true
+	}
+	return false
+}
+
+func (a *Assembler) close(f Flow) {
+	delete(a.streams, f)
+	if a.Close != nil {
+		a.Close(f)
+	}
+}
+
+// Flush delivers what is pending of every flow, after a gap if need be,
+// and closes them all, at the end of a capture.
+func (a *Assembler) Flush() {
+	flows := make([]Flow, 0, len(a.streams))
+	for f := range a.streams {
+		flows = append(flows, f)
+	}
+	sort.Slice(flows, func(i, j int) bool {
+		if c := flows[i].Src.Compare(flows[j].Src); c != 0 {
+			return c < 0
+		}
+		return flows[i].Dst.Compare(flows[j].Dst) < 0
+	})
+	for _, f := range flows {
+		s, ok := a.streams[f]
+		if !ok {
+			continue
+		}
+		for len(s.pending) > 0 && a.streams[f] == s {
+			s.buffered = maxPending + 1 // force the skip over the gap
+			a.drain(f, s)
+		}
+		if a.streams[f] == s {
+			a.close(f)
+		}
+	}
+}
+
+// seqBefore reports whether sequence number a comes before b, allowing
+// for wraparound.
+func seqBefore(a, b uint32) bool {
+	return int32(a-b) < 0
+}
@@ -0,0 +1,171 @@
+package pcap
+
+import (
+	"encoding/binary"
+	"net/netip"
+)
+
+// Segment is the TCP part of a captured packet.
+type Segment struct {
+	Src, Dst netip.AddrPort
+	Seq      uint32
+	SYN      bool
+	FIN      bool
+	RST      bool
+	Payload  []byte
+}
+
+// Flow is one direction of a TCP connection.
+type Flow struct {
+	Src, Dst netip.AddrPort
+}
+
+// Reverse returns the other direction of f.
+func (f Flow) Reverse() Flow {
+	return Flow{Src: f.Dst, Dst: f.Src}
+}
+
+// Flow returns the direction s travels in.
+func (s Segment) Flow() Flow {
+	return Flow{Src: s.Src, Dst: s.Dst}
+}
+
+// Decode returns the TCP segment in a frame of the given link type, or
+// false for frames that are not TCP over IPv4 or IPv6, are fragments, or
+// were cut short by the snapshot length.
+func Decode(linkType uint32, frame []byte) (Segment, bool) {
+	ip, ok := linkPayload(linkType, frame)
+	if !ok || len(ip) == 0 {
+		return Segment{}, false
+	}
+	switch ip[0] >> 4 {
+	case 4:
+		return decodeIPv4(ip)
+	case 6:
+		return decodeIPv6(ip)
+	}
+	return Segment{}, false
+}
+
+const (
+	etherIPv4 = 0x0800
+	etherIPv6 = 0x86dd
+	etherVLAN = 0x8100
+	etherQinQ = 0x88a8
+)
+
+// linkPayload strips the link layer header from frame.
+func linkPayload(linkType uint32, frame []byte) ([]byte, bool) {
+	switch linkType {
+	case LinkNull, LinkLoop:
+		// The address family is in the byte order of the capturing host,
+		// and its values differ between systems: go by the IP version.
+		if len(frame) < 4 {
+			return nil, false
+		}
+		return frame[4:], true
+	case LinkRaw, LinkIPv4, LinkIPv6:
+		return frame, true
+	case LinkEthernet:
+		if len(frame) < 14 {
+			return nil, false
+		}
+		typ, rest := binary.BigEndian.Uint16(frame[12:]), frame[14:]
+		for typ == etherVLAN || typ == etherQinQ {
+			if len(rest) < 4 {
+				return nil, false
+			}
+			typ, rest = binary.BigEndian.Uint16(rest[2:]), rest[4:]
+		}
+		return rest, typ == etherIPv4 || typ == etherIPv6
+	case LinkLinuxSLL:
+		if len(frame) < 16 {
+			return nil, false
+		}
+		typ := binary.BigEndian.Uint16(frame[14:])
+		return frame[16:], typ == etherIPv4 || typ == etherIPv6
+	case LinkLinuxSLL2:
+		if len(frame) < 20 {
+			return nil, false
+		}
+		typ := binary.BigEndian.Uint16(frame[0:])
+		return frame[20:], typ == etherIPv4 || typ == etherIPv6
+	}
+	return nil, false
+}
+
+const protoTCP = 6
+
+func decodeIPv4(ip []byte) (Segment, bool) {
+	if len(ip) < 20 {
+		return Segment{}, false
+	}
+	hl := int(ip[0]&0x0f) * 4
+	total := int(binary.BigEndian.Uint16(ip[2:]))
+	if total == 0 {
+		total = len(ip) // segmentation offload: the length is left to the NIC
+	}
+	if hl < 20 || total < hl || len(ip) < total || ip[9] != protoTCP {
+		return Segment{}, false
+	}
+	if flags := binary.BigEndian.Uint16(ip[6:]); flags&0x3fff != 0 {
+		return Segment{}, false // a fragment: more fragments, or an offset
+	}
+	src := netip.AddrFrom4([4]byte(ip[12:16]))
+	dst := netip.AddrFrom4([4]byte(ip[16:20]))
+	return decodeTCP(src, dst, ip[hl:total])
+}
+
+// IPv6 extension headers that may come before TCP.
+const (
+	ipv6HopByHop    = 0
+	ipv6Routing     = 43
+	ipv6Fragment    = 44
+	ipv6Destination = 60
+)
+
+func decodeIPv6(ip []byte) (Segment, bool) {
+	if len(ip) < 40 {
+		return Segment{}, false
+	}
+	n := int(binary.BigEndian.Uint16(ip[4:]))
+	if len(ip) < 40+n {
+		return Segment{}, false
+	}
+	src := netip.AddrFrom16([16]byte(ip[8:24]))
+	dst := netip.AddrFrom16([16]byte(ip[24:40]))
+	next, rest := ip[6], ip[40:40+n]
+	for {
+		switch next {
+		case protoTCP:
+			return decodeTCP(src, dst, rest)
+		case ipv6HopByHop, ipv6Routing, ipv6Destination:
+			if len(rest) < 8 || len(rest) < 8+int(rest[1])*8 {
+				return Segment{}, false
+			}
+			next, rest = rest[0], rest[8+int(rest[1])*8:]
+		default:
+			return Segment{}, false // fragments and everything else
+		}
+	}
+}
+
+func decodeTCP(src, dst netip.Addr, tcp []byte) (Segment, bool) {
+	if len(tcp) < 20 {
+		return Segment{}, false
+	}
+	off := int(tcp[12]>>4) * 4
+	if off < 20 || len(tcp) < off {
+		return Segment{}, false
+	}
+	flags := tcp[13]
+	return Segment{
+		Src:     netip.AddrPortFrom(src, binary.BigEndian.Uint16(tcp[0:])),
+		Dst:     netip.AddrPortFrom(dst, binary.BigEndian.Uint16(tcp[2:])),
+		Seq:     binary.BigEndian.Uint32(tcp[4:]),
+		FIN:     flags&0x01 != 0,
+		SYN:     flags&0x02 != 0,
+		RST:     flags&0x04 != 0,
+		Payload: tcp[off:],
+	}, true
+}
@@ -0,0 +1,116 @@
+// Package pcap reads libpcap capture files and reassembles the TCP streams
+// in them, without libpcap. It reads the classic file format, not pcapng,
+// and Ethernet, Linux cooked, loopback and raw IP captures.
+package pcap
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Link types of the captures Decode understands.
+const (
+	LinkNull      = 0   // BSD loopback
+	LinkEthernet  = 1   // Ethernet, with 802.1Q tags
+	LinkRaw       = 101 // raw IPv4 or IPv6
+	LinkLoop      = 108 // OpenBSD loopback
+	LinkLinuxSLL  = 113 // Linux cooked capture, as from -i any
+	LinkIPv4      = 228
+	LinkIPv6      = 229
+	LinkLinuxSLL2 = 276
+)
+
+const (
+	magicMicro   = 0xa1b2c3d4
+	magicNano    = 0xa1b23c4d
+	magicPcapng  = 0x0a0d0d0a
+	maxSnapLen   = 1 << 18
+	headerLength = 24
+)
+
+// ErrPcapng is returned for files in the pcapng format.
+var ErrPcapng = errors.New("pcap: pcapng files are not supported; convert with: editcap -F pcap in.pcapng out.pcap")
+
+// Packet is a captured frame.
+type Packet struct {
+	Time time.Time
+	Data []byte // the captured bytes, possibly fewer than the frame had
+	Len  int    // the length of the frame on the wire
+}
+
+// Reader reads the packets of a capture file.
+type Reader struct {
+	r        *bufio.Reader
+	order    binary.ByteOrder
+	nano     bool
+	LinkType uint32
+	hdr      [16]byte
+}
+
+// NewReader reads the file header from r.
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReaderSize(r, 1<<16)
+	var hdr [headerLength]byte
+	if _, err := io.ReadFull(br, hdr[:]); err != nil {
+		return nil, fmt.Errorf("pcap: reading file header: %w", err)
+	}
+	pr := &Reader{r: br}
+	switch magic := binary.LittleEndian.Uint32(hdr[:]); {
+	case magic == magicMicro:
+		pr.order = binary.LittleEndian
+	case magic == magicNano:
+		pr.order, pr.nano = binary.LittleEndian, true
+	case binary.BigEndian.Uint32(hdr[:]) == magicMicro:
+		pr.order = binary.BigEndian
+	case binary.BigEndian.Uint32(hdr[:]) == magicNano:
+		pr.order, pr.nano = binary.BigEndian, true
+	case magic == magicPcapng:
+		return nil, ErrPcapng
+	default:
+		return nil, fmt.Errorf("pcap: not a capture file (magic %#08x)", magic)
+	}
+	pr.LinkType = pr.order.Uint32(hdr[20:]) & 0x0fffffff // the top bits hold FCS flags
+	return pr, nil
+}
+
+// Next returns the next packet, or io.EOF at the end of the file. The
+// packet's Data is only valid until the next call.
+func (r *Reader) Next() (Packet, error) {
+	if _, err := io.ReadFull(r.r, r.hdr[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return Packet{}, fmt.Errorf("pcap: truncated packet header")
+		}
+		return Packet{}, err
+	}
+	sec := int64(r.order.Uint32(r.hdr[0:]))
+	frac := int64(r.order.Uint32(r.hdr[4:]))
+	if !r.nano {
+		frac *= 1000
+	}
+	n := r.order.Uint32(r.hdr[8:])
+	if n > maxSnapLen {
+		return Packet{}, fmt.Errorf("pcap: packet of %d bytes is larger than any snapshot length", n)
+	}
+	data, err := r.r.Peek(int(n))
+	if err != nil {
+		// Larger than the buffer, or cut off at the end of the file.
+		if err != bufio.ErrBufferFull {
+			return Packet{}, fmt.Errorf("pcap: truncated packet: %w", err)
+		}
+		data = make([]byte, n)
+		if _, err := io.ReadFull(r.r, data); err != nil {
+			return Packet{}, fmt.Errorf("pcap: truncated packet: %w", err)
+		}
+	} else if _, err := r.r.Discard(int(n)); err != nil {
+		return Packet{}, err
+	}
+	return Packet{
+		Time: time.Unix(sec, frac),
+		Data: data,
+		Len:  int(r.order.Uint32(r.hdr[12:])),
+	}, nil
+}
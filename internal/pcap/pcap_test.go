@@ -0,0 +1,384 @@
+package pcap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	client   = netip.MustParseAddrPort("10.0.0.5:51000")
+	server   = netip.MustParseAddrPort("10.0.0.9:3306")
+	client6  = netip.MustParseAddrPort("[2001:db8::5]:51000")
+	server6  = netip.MustParseAddrPort("[2001:db8::9]:3306")
+	captured = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+)
+
+// TCP flags, for frame.
+const (
+	fin = 0x01
+	syn = 0x02
+	rst = 0x04
+	ack = 0x10
+)
+
+func tcp(src, dst netip.AddrPort, seq uint32, flags byte, payload string) []byte {
+	b := make([]byte, 20, 20+len(payload))
+	binary.BigEndian.PutUint16(b[0:], src.Port())
+	binary.BigEndian.PutUint16(b[2:], dst.Port())
+	binary.BigEndian.PutUint32(b[4:], seq)
+	b[12] = 5 << 4
+	b[13] = flags | ack
+	return append(b, payload...)
+}
+
+func ipv4(src, dst netip.AddrPort, proto byte, body []byte) []byte {
+	b := make([]byte, 20, 20+len(body))
+	b[0] = 0x45
+	binary.BigEndian.PutUint16(b[2:], uint16(20+len(body)))
+	binary.BigEndian.PutUint16(b[6:], 0x4000) // don't fragment
+	b[8], b[9] = 64, proto
+	s, d := src.Addr().As4(), dst.Addr().As4()
+	copy(b[12:], s[:])
+	copy(b[16:], d[:])
+	return append(b, body...)
+}
+
+func ipv6(src, dst netip.AddrPort, body []byte) []byte {
+	b := make([]byte, 40, 40+len(body))
+	b[0] = 0x60
+	binary.BigEndian.PutUint16(b[4:], uint16(len(body)))
+	b[6], b[7] = protoTCP, 64
+	s, d := src.Addr().As16(), dst.Addr().As16()
+	copy(b[8:], s[:])
+	copy(b[24:], d[:])
+	return append(b, body...)
+}
+
+func ethernet(etherType uint16, body []byte) []byte {
+	b := make([]byte, 14, 14+len(body))
+	binary.BigEndian.PutUint16(b[12:], etherType)
+	return append(b, body...)
+}
+
+// frame is an Ethernet frame carrying a TCP segment over IPv4.
+func frame(src, dst netip.AddrPort, seq uint32, flags byte, payload string) []byte {
+	return ethernet(etherIPv4, ipv4(src, dst, protoTCP, tcp(src, dst, seq, flags, payload)))
+}
+
+// capture writes a pcap file of frames, one microsecond apart.
+func capture(order binary.ByteOrder, nano bool, linkType uint32, frames ...[]byte) []byte {
+	var buf bytes.Buffer
+	hdr := make([]byte, headerLength)
+	magic := uint32(magicMicro)
+	if nano {
+		magic = magicNano
+	}
+	order.PutUint32(hdr[0:], magic)
+	order.PutUint16(hdr[4:], 2)
+	order.PutUint16(hdr[6:], 4)
+	order.PutUint32(hdr[16:], 65535)
+	order.PutUint32(hdr[20:], linkType)
+	buf.Write(hdr)
+	for i, f := range frames {
+		t := captured.Add(time.Duration(i) * time.Microsecond)
+		rec := make([]byte, 16)
+		order.PutUint32(rec[0:], uint32(t.Unix()))
+		frac := uint32(t.Nanosecond() / 1000)
+		if nano {
+			frac = uint32(t.Nanosecond())
+		}
+		order.PutUint32(rec[4:], frac)
+		order.PutUint32(rec[8:], uint32(len(f)))
+		order.PutUint32(rec[12:], uint32(len(f)))
+		buf.Write(rec)
+		buf.Write(f)
+	}
+	return buf.Bytes()
+}
+
+func TestReader(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		order binary.ByteOrder
+		nano  bool
+	}{
+		{"little endian", binary.LittleEndian, false},
+		{"big endian", binary.BigEndian, false},
+		{"nanoseconds", binary.LittleEndian, true},
+		{"big endian nanoseconds", binary.BigEndian, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			big := bytes.Repeat([]byte{0xee}, 100000) // larger than the read buffer
+			file := capture(tt.order, tt.nano, LinkEthernet, frame(client, server, 1, 0, "a"), big)
+			r, err := NewReader(bytes.NewReader(file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.LinkType != LinkEthernet {
+				t.Errorf("LinkType = %d", r.LinkType)
+			}
+			p, err := r.Next()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !p.Time.Equal(captured) {
+				t.Errorf("Time = %v", p.Time)
+			}
+			if seg, ok := Decode(r.LinkType, p.Data); !ok || string(seg.Payload) != "a" || p.Len != len(p.Data) {
+				t.Errorf("decoded %v, %q", ok, seg.Payload)
+			}
+			p, err = r.Next()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !p.Time.Equal(captured.Add(time.Microsecond)) || !bytes.Equal(p.Data, big) {
+				t.Errorf("second packet: %v, %d bytes", p.Time, len(p.Data))
+			}
+			if _, err := r.Next(); err != io.EOF {
+				t.Errorf("at the end: %v, want io.EOF", err)
+			}
+		})
+	}
+}
+
+func TestReader_Errors(t *testing.T) {
+	pcapng := []byte{0x0a, 0x0d, 0x0d, 0x0a, 0x1c, 0, 0, 0, 0x4d, 0x3c, 0x2b, 0x1a, 1, 0, 0, 0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	if _, err := NewReader(bytes.NewReader(pcapng)); !errors.Is(err, ErrPcapng) {
+		t.Errorf("pcapng: %v", err)
+	}
+	if _, err := NewReader(strings.NewReader("SELECT 1; -- not a capture file")); err == nil {
+		t.Error("text file: no error")
+	}
+	if _, err := NewReader(strings.NewReader("")); err == nil {
+		t.Error("empty file: no error")
+	}
+
+	file := capture(binary.LittleEndian, false, LinkEthernet, frame(client, server, 1, 0, "abc"))
+	r, err := NewReader(bytes.NewReader(file[:len(file)-2]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Next(); err == nil || err == io.EOF {
+		t.Errorf("truncated packet: %v", err)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	seg4 := tcp(client, server, 7, 0, "SELECT 1")
+	seg6 := tcp(client6, server6, 7, 0, "SELECT 1")
+
+	vlan := make([]byte, 14, 64)
+	binary.BigEndian.PutUint16(vlan[12:], etherVLAN)
+	vlan = append(vlan, 0, 42, byte(etherIPv4>>8), byte(etherIPv4&0xff))
+	vlan = append(vlan, ipv4(client, server, protoTCP, seg4)...)
+
+	sll := make([]byte, 16)
+	binary.BigEndian.PutUint16(sll[14:], etherIPv6)
+	sll = append(sll, ipv6(client6, server6, seg6)...)
+
+	sll2 := make([]byte, 20)
+	binary.BigEndian.PutUint16(sll2[0:], etherIPv4)
+	sll2 = append(sll2, ipv4(client, server, protoTCP, seg4)...)
+
+	// Segmentation offload: the capture has a segment larger than the MTU,
+	// with a total length of 0.
+	tso := ipv4(client, server, protoTCP, seg4)
+	tso[2], tso[3] = 0, 0
+
+	// An IPv6 hop-by-hop options header before TCP.
+	hop := append([]byte{protoTCP, 0, 0, 0, 0, 0, 0, 0}, seg6...)
+	withHop := ipv6(client6, server6, hop)
+	withHop[6] = ipv6HopByHop
+
+	for _, tt := range []struct {
+		name     string
+		linkType uint32
+		frame    []byte
+		src, dst netip.AddrPort
+	}{
+		{"ethernet", LinkEthernet, ethernet(etherIPv4, ipv4(client, server, protoTCP, seg4)), client, server},
+		{"ethernet IPv6", LinkEthernet, ethernet(etherIPv6, ipv6(client6, server6, seg6)), client6, server6},
+		{"802.1Q", LinkEthernet, vlan, client, server},
+		{"linux cooked", LinkLinuxSLL, sll, client6, server6},
+		{"linux cooked v2", LinkLinuxSLL2, sll2, client, server},
+		{"loopback", LinkNull, append([]byte{2, 0, 0, 0}, ipv4(client, server, protoTCP, seg4)...), client, server},
+		{"raw", LinkRaw, ipv6(client6, server6, seg6), client6, server6},
+		{"hop-by-hop", LinkRaw, withHop, client6, server6},
+		{"segmentation offload", LinkRaw, tso, client, server},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			seg, ok := Decode(tt.linkType, tt.frame)
+			if !ok {
+				t.Fatal("not decoded")
+			}
+			if seg.Src != tt.src || seg.Dst != tt.dst || seg.Seq != 7 || string(seg.Payload) != "SELECT 1" {
+				t.Errorf("got %v -> %v seq %d %q", seg.Src, seg.Dst, seg.Seq, seg.Payload)
+			}
+		})
+	}
+
+	fragment := ipv4(client, server, protoTCP, seg4)
+	binary.BigEndian.PutUint16(fragment[6:], 0x2000) // more fragments
+	udp := ipv4(client, server, 17, seg4)
+	for _, tt := range []struct {
+		name     string
+		linkType uint32
+		frame    []byte
+	}{
+		{"fragment", LinkRaw, fragment},
+		{"UDP", LinkRaw, udp},
+		{"ARP", LinkEthernet, ethernet(0x0806, make([]byte, 28))},
+		{"cut short", LinkEthernet, frame(client, server, 1, 0, "SELECT 1")[:40]},
+		{"unknown link type", 9999, fragment},
+		{"empty", LinkRaw, nil},
+	} {
+		if _, ok := Decode(tt.linkType, tt.frame); ok {
+			t.Errorf("%s: decoded", tt.name)
+		}
+	}
+}
+
+// recorder records what an Assembler hands on.
+type recorder struct {
+	events []string
+}
+
+func (r *recorder) assembler() *Assembler {
+	return &Assembler{
+		Data: func(f Flow, data []byte, t time.Time) {
+			r.events = append(r.events, f.Src.String()+" "+string(data))
+		},
+		Gap: func(f Flow) {
+			r.events = append(r.events, f.Src.String()+" gap")
+		},
+		Close: func(f Flow) {
+			r.events = append(r.events, f.Src.String()+" close")
+		},
+	}
+}
+
+func (r *recorder) add(a *Assembler, frames ...[]byte) {
+	for i, f := range frames {
+		seg, ok := Decode(LinkEthernet, f)
+		if !ok {
+			panic("bad test frame")
+		}
+		a.Add(seg, captured.Add(time.Duration(i)*time.Millisecond))
+	}
+}
+
+func TestAssembler(t *testing.T) {
+	c, s := client.String(), server.String()
+	for _, tt := range []struct {
+		name   string
+		frames [][]byte
+		want   []string
+	}{{
+		name: "in order",
+		frames: [][]byte{
+			frame(client, server, 100, syn, ""),
+			frame(server, client, 500, syn, ""),
+			frame(client, server, 101, 0, "ab"),
+			frame(server, client, 501, 0, "xy"),
+			frame(client, server, 103, 0, "cd"),
+			frame(client, server, 105, fin, ""),
+		},
+		want: []string{c + " ab", s + " xy", c + " cd", c + " close", s + " close"},
+	}, {
+		name: "out of order",
+		frames: [][]byte{
+			frame(client, server, 100, syn, ""),
+			frame(client, server, 105, 0, "ef"),
+			frame(client, server, 103, 0, "cd"),
+			frame(client, server, 101, 0, "ab"),
+			frame(client, server, 107, 0, "gh"),
+		},
+		want: []string{c + " ab", c + " cd", c + " ef", c + " gh", c + " close"},
+	}, {
+		name: "retransmissions",
+		frames: [][]byte{
+			frame(client, server, 100, syn, ""),
+			frame(client, server, 101, 0, "abc"),
+			frame(client, server, 101, 0, "abc"),
+			frame(client, server, 102, 0, "bcde"),
+			frame(client, server, 100, syn, ""), // a retransmitted SYN starts over
+			frame(client, server, 101, 0, "abc"),
+		},
+		want: []string{c + " abc", c + " de", c + " close", c + " abc", c + " close"},
+	}, {
+		name: "started before the capture",
+		frames: [][]byte{
+			frame(client, server, 9000, 0, ""), // an ACK
+			frame(client, server, 9000, 0, "ab"),
+			frame(client, server, 9002, 0, "cd"),
+		},
+		want: []string{c + " ab", c + " cd", c + " close"},
+	}, {
+		name: "lost segment",
+		frames: [][]byte{
+			frame(client, server, 100, syn, ""),
+			frame(client, server, 101, 0, "ab"),
+			frame(client, server, 105, 0, "ef"),
+			frame(client, server, 107, fin, ""),
+		},
+		want: []string{c + " ab", c + " gap", c + " ef", c + " close"},
+	}, {
+		name: "reset",
+		frames: [][]byte{
+			frame(client, server, 100, syn, ""),
+			frame(client, server, 101, 0, "ab"),
+			frame(client, server, 103, rst, ""),
+			frame(server, client, 1, rst, ""),
+		},
+		want: []string{c + " ab", c + " close"},
+	}, {
+		name: "wraparound",
+		frames: [][]byte{
+			frame(client, server, 0xfffffffe, syn, ""),
+			frame(client, server, 1, 0, "cd"),
+			frame(client, server, 0xffffffff, 0, "ab"),
+		},
+		want: []string{c + " ab", c + " cd", c + " close"},
+	}} {
+		t.Run(tt.name, func(t *testing.T) {
+			var r recorder
+			a := r.assembler()
+			r.add(a, tt.frames...)
+			a.Flush()
+			if got, want := strings.Join(r.events, "\n"), strings.Join(tt.want, "\n"); got != want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestAssembler_TooFarOutOfOrder(t *testing.T) {
+	var r recorder
+	a := r.assembler()
+	r.add(a, frame(client, server, 100, syn, ""), frame(client, server, 101, 0, "ab"))
+	big := strings.Repeat("x", 60000)
+	seq := uint32(200)
+	for range maxPending/len(big) + 1 {
+		r.add(a, frame(client, server, seq, 0, big))
+		seq += uint32(len(big))
+	}
+	want := client.String() + " gap"
+	var gaps, data int
+	for _, e := range r.events {
+		switch {
+		case e == want:
+			gaps++
+		case strings.HasSuffix(e, big):
+			data++
+		}
+	}
+	if gaps != 1 || data != maxPending/len(big)+1 {
+		t.Errorf("%d gaps and %d segments delivered, want 1 and %d", gaps, data, maxPending/len(big)+1)
+	}
+}
@@ -0,0 +1,263 @@
+package internal
+
+import "strings"
+
+// prettyIndent is how far subqueries, joins and AND/OR lines are indented,
+// and prettyHang how far a line wrapped at the width is.
+const (
+	prettyIndent = 2
+	prettyHang   = 4
+)
+
+// Pretty lays out the reduced tokens of a digest text over several lines:
+// each clause on a line of its own, joins and the AND/OR of WHERE and
+// HAVING indented under it, the AND/OR of a join condition aligned with
+// its ON, and subqueries indented between their parentheses. Only the
+// tokens are written, so no literal comes back. Lines longer than width
+// are wrapped between tokens; a width of 0 or less only breaks lines
+// between clauses. rest, the text after the last token that could be
+// read, is kept as it is.
+func Pretty(toks []DigestToken, rest string, width int) string {
+	p := prettyPrinter{toks: toks, width: width, onCol: -1}
+	p.frames = append(p.frames, prettyFrame{})
+	for i := range p.toks {
+		p.token(i)
+	}
+	if text := strings.TrimSpace(rest); text != "" {
+		p.write(text, true, len(text))
+	}
+	return string(p.out)
+}
+
+// prettyFrame is a query or subquery: its clauses start at indent, with
+// depth parentheses open. The clause and join of the enclosing query are
+// kept for when the subquery ends.
+type prettyFrame struct {
+	indent, depth int
+	clause, onCol int
+}
+
+type prettyPrinter struct {
+	toks  []DigestToken
+	width int
+
+	out       []byte
+	lineStart int // offset of the current line in out
+	hang      int // indent of a wrapped line
+
+	frames  []prettyFrame
+	depth   int  // parentheses open
+	clause  int  // keyword of the current clause
+	onCol   int  // column of the ON of the current join, or -1
+	between bool // a BETWEEN waits for its AND
+}
+
+func (p *prettyPrinter) typ(i int) int {
+	if i < 0 || i >= len(p.toks) {
+		return TOK_UNUSED
+	}
+	return p.toks[i].Type
+}
+
+func (p *prettyPrinter) token(i int) {
+	tok := p.toks[i]
+	text := tok.Text
+	prev, next := p.typ(i-1), p.typ(i+1)
+	f := &p.frames[len(p.frames)-1]
+	top := p.depth == f.depth
+
+	switch t := tok.Type; {
+	case t == '(' && (next == SELECT_SYM || next == WITH):
+		p.write(text, p.spaced(i), 0)
+		p.depth++
+		p.frames = append(p.frames, prettyFrame{
+			indent: p.indent() + prettyIndent, depth: p.depth,
+			clause: p.clause, onCol: p.onCol,
+		})
+		p.clause, p.onCol = 0, -1
+		p.newline(p.indent() + prettyIndent)
+		return
+	case t == '(':
+		p.write(text, p.spaced(i), 0)
+		p.depth++
+		return
+	case t == ')' && top && len(p.frames) > 1:
+		p.frames = p.frames[:len(p.frames)-1]
+		p.newline(f.indent - prettyIndent)
+		p.write(text, false, 0)
+		p.depth--
+		p.clause, p.onCol = f.clause, f.onCol
+		return
+	case t == ')':
+		p.write(text, false, 0)
+		p.depth--
+		return
+	case t == ';' && p.depth == 0:
+		p.write(text, false, 0)
+		p.frames = p.frames[:1]
+		p.clause, p.onCol = 0, -1
+		p.newline(0)
+		return
+	case !top:
+	case isPrettyClause(t, prev, next, p.clause):
+		p.clause, p.onCol, p.between = t, -1, false
+		p.newline(f.indent)
+	case isPrettyJoin(t, prev, next, p.clause):
+		p.clause, p.onCol = JOIN_SYM, -1
+		p.newline(f.indent + prettyIndent)
+	case t == ON_SYM && p.clause == JOIN_SYM:
+		p.write(text, true, p.run(i))
+		p.onCol = len(p.out) - p.lineStart - len(text)
+		return
+	case t == BETWEEN_SYM:
+		p.between = true
+	case isPrettyLogical(t):
+		switch {
+		case t == AND_SYM && p.between:
+			p.between = false
+		case p.onCol >= 0:
+			p.newline(p.onCol)
+		case p.clause == WHERE || p.clause == HAVING:
+			p.newline(f.indent + prettyIndent)
+		}
+	}
+	p.write(text, p.spaced(i), p.run(i))
+}
+
+// spaced reports whether token i is written after a space: when the text
+// has one before it, except after an opening and before a closing
+// parenthesis or a comma, and around the dot after an identifier, which
+// qualifies a name.
+func (p *prettyPrinter) spaced(i int) bool {
+	if i == 0 || !TokenAppendSpace(p.toks[i-1].Type) {
+		return false
+	}
+	switch p.toks[i].Type {
+	case ',', ')':
+		return false
+	case '.':
+		return !p.qualifier(i - 1)
+	}
+	switch p.toks[i-1].Type {
+	case '(':
+		return false
+	case '.':
+		return !p.qualifier(i - 2)
+	}
+	return true
+}
+
+// qualifier reports whether token i is an identifier, which a dot after it
+// joins to the name it qualifies.
+func (p *prettyPrinter) qualifier(i int) bool {
+	return i >= 0 && p.toks[i].Type == TOK_IDENT
+}
+
+// run returns the length of token i and of the tokens written right after
+// it, which a wrapped line is not broken between: those without a space
+// before them, and an opening parenthesis.
+func (p *prettyPrinter) run(i int) int {
+	n := len(p.toks[i].Text)
+	for j := i + 1; j < len(p.toks) && (!p.spaced(j) || p.toks[j].Type == '('); j++ {
+		if p.spaced(j) {
+			n++
+		}
+		n += len(p.toks[j].Text)
+	}
+	return n
+}
+
+// indent returns the indent of the current line.
+func (p *prettyPrinter) indent() int {
+	n := 0
+	for p.lineStart+n < len(p.out) && p.out[p.lineStart+n] == ' ' {
+		n++
+	}
+	return n
+}
+
+// blank reports whether nothing but the indent is on the current line.
+func (p *prettyPrinter) blank() bool {
+	return p.indent() == len(p.out)-p.lineStart
+}
+
+// newline starts a line at indent, unless the current one is still blank.
+func (p *prettyPrinter) newline(indent int) {
+	if p.blank() {
+		p.out = p.out[:p.lineStart]
+	} else {
+		p.out = append(p.out, '\n')
+		p.lineStart = len(p.out)
+	}
+	for range indent {
+		p.out = append(p.out, ' ')
+	}
+	p.hang = indent + prettyHang
+}
+
+// write adds text to the current line, wrapping it first if the fit bytes
+// from its start would not fit in the width. A fit of 0 never wraps.
+func (p *prettyPrinter) write(text string, space bool, fit int) {
+	if p.blank() {
+		space = false
+	} else if p.width > 0 && space && fit > 0 && len(p.out)-p.lineStart+1+fit > p.width {
+		hang := p.hang
+		p.newline(hang)
+		p.hang = hang
+		space = false
+	}
+	if space {
+		p.out = append(p.out, ' ')
+	}
+	p.out = append(p.out, text...)
+}
+
+// isPrettyClause reports whether t starts a clause of a query at the top
+// level of its frame, given the tokens around it and the current clause.
+func isPrettyClause(t, prev, next, clause int) bool {
+	switch t {
+	case SELECT_SYM, WHERE, GROUP_SYM, HAVING, ORDER_SYM, LIMIT, WINDOW_SYM,
+		UNION_SYM, EXCEPT_SYM, INTERSECT_SYM:
+		return true
+	case FROM:
+		return prev != DELETE_SYM
+	case SET_SYM:
+		return prev != CHAR_SYM
+	case VALUES:
+		return clause != ON_SYM
+	case ON_SYM:
+		return next == DUPLICATE_SYM
+	case FOR_SYM:
+		return next == UPDATE_SYM || next == SHARE_SYM
+	}
+	return false
+}
+
+// isPrettyJoin reports whether t starts a join.
+func isPrettyJoin(t, prev, next, clause int) bool {
+	switch t {
+	case JOIN_SYM:
+		switch prev {
+		case INNER_SYM, LEFT, RIGHT, CROSS, NATURAL, OUTER_SYM:
+			return false
+		}
+		return true
+	case INNER_SYM, CROSS:
+		return true
+	case STRAIGHT_JOIN:
+		return clause != SELECT_SYM // else it is a SELECT option
+	case NATURAL:
+		return next != '('
+	case LEFT, RIGHT:
+		return next != '(' && prev != NATURAL
+	}
+	return false
+}
+
+func isPrettyLogical(t int) bool {
+	switch t {
+	case AND_SYM, OR_SYM, AND_AND_SYM, OR_OR_SYM, XOR:
+		return true
+	}
+	return false
+}
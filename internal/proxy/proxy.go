@@ -0,0 +1,269 @@
+// Package proxy relays MySQL connections to an upstream server unchanged,
+// digesting the statements clients send on the way through.
+package proxy
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	digest "github.com/rashiq/mysql-digest"
+	"github.com/rashiq/mysql-digest/internal/mysqlproto"
+)
+
+// cacheSize is the number of prepared statements each caching digester
+// remembers.
+const cacheSize = 4096
+
+// queueSize is the number of batches of completed commands a connection
+// holds for its digest worker. Commands that complete while it is full
+// are counted as undigested instead of holding up the traffic.
+const queueSize = 256
+
+// Proxy accepts MySQL client connections and relays each to Upstream,
+// adding the statements sent with COM_QUERY, COM_STMT_PREPARE and
+// COM_STMT_EXECUTE to Stats once the server has answered them. Prepared
+// statements are digested in digest.Options.Prepared mode, and their
+// digests are cached, as the same text comes back with every execution;
+// COM_QUERY texts, which mostly differ in their literals, are not.
+//
+// Each connection digests on a worker of its own, off the goroutines that
+// relay its traffic.
+//
+// Connections that switch to TLS or use the compressed protocol are
+// relayed without being digested.
+type Proxy struct {
+	Upstream string // host:port of the MySQL server
+	Stats    *Stats // where the statements are aggregated; required
+
+	// Version is the version to digest as; nil takes it from the server
+	// greeting.
+	Version *digest.MySQLVersion
+	SQLMode digest.SQLMode
+	Lenient bool
+
+	// ErrorLog receives connection errors; nil logs with the log package.
+	ErrorLog *log.Logger
+
+	mu        sync.Mutex
+	digesters map[digesterKey]statementDigester
+	warned    map[string]bool // server versions digested as 8.0
+}
+
+// statementDigester is a *digest.Digester or a *digest.CachingDigester.
+type statementDigester interface {
+	Digest(sql string) (digest.Digest, error)
+}
+
+type digesterKey struct {
+	version  digest.MySQLVersion
+	prepared bool
+}
+
+// Serve accepts connections on l and serves each in its own goroutine,
+// until l fails; it returns that error.
+func (p *Proxy) Serve(l net.Listener) error {
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			if err := p.ServeConn(c); err != nil {
+				p.logf("%s: %v", c.RemoteAddr(), err)
+			}
+		}()
+	}
+}
+
+// ServeConn relays client to a new connection to Upstream until either
+// side closes its connection, then closes both. It returns an error only
+// when the upstream cannot be reached.
+func (p *Proxy) ServeConn(client net.Conn) error {
+	defer client.Close()
+	server, err := net.Dial("tcp", p.Upstream)
+	if err != nil {
+		return err
+	}
+	defer server.Close()
+
+	r := &relay{p: p, work: make(chan []mysqlproto.Command, queueSize)}
+	r.s.Emit = func(c mysqlproto.Command) { r.done = append(r.done, c) }
+	recorded := make(chan struct{})
+	go func() {
+		for cmds := range r.work {
+			p.record(cmds)
+		}
+		close(recorded)
+	}()
+
+	errc := make(chan error, 2)
+	go func() { errc <- r.copy(server, client, (*mysqlproto.Session).Client) }()
+	go func() { errc <- r.copy(client, server, (*mysqlproto.Session).Server) }()
+	err = <-errc
+	// Unblock the other direction.
+	client.Close()
+	server.Close()
+	<-errc
+
+	close(r.work)
+	<-recorded
+	r.mu.Lock()
+	r.s.Close()
+	done := r.take()
+	r.mu.Unlock()
+	p.record(done)
+	if r.s.Err != nil {
+		p.logf("%s: not digested: %v", client.RemoteAddr(), r.s.Err)
+	}
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+		p.logf("%s: %v", client.RemoteAddr(), err)
+	}
+	return nil
+}
+
+// relay is the state both directions of a connection share.
+type relay struct {
+	p    *Proxy
+	mu   sync.Mutex
+	s    mysqlproto.Session
+	done []mysqlproto.Command // emitted, not yet queued
+	work chan []mysqlproto.Command
+}
+
+// copy relays src to dst, passing what it reads to the session with feed
+// before writing it on, so the session sees a command before its response.
+// The commands that complete are queued for the worker after the write.
+func (r *relay) copy(dst, src net.Conn, feed func(*mysqlproto.Session, []byte, time.Time)) error {
+	buf := make([]byte, 32<<10)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			r.mu.Lock()
+			feed(&r.s, buf[:n], time.Now())
+			done := r.take()
+			r.mu.Unlock()
+
+			if _, werr := dst.Write(buf[:n]); werr != nil {
+				return werr
+			}
+			r.queue(done)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (r *relay) take() []mysqlproto.Command {
+	done := r.done
+	r.done = nil
+	return done
+}
+
+// queue hands cmds to the connection's worker, or counts them as
+// undigested if it is behind.
+func (r *relay) queue(cmds []mysqlproto.Command) {
+	if len(cmds) == 0 {
+		return
+	}
+	select {
+	case r.work <- cmds:
+	default:
+		n := 0
+		for _, c := range cmds {
+			if _, ok := statement(c); ok {
+				n++
+			}
+		}
+		r.p.Stats.fail(n)
+	}
+}
+
+func (p *Proxy) record(cmds []mysqlproto.Command) {
+	for _, c := range cmds {
+		prepared, ok := statement(c)
+		if !ok {
+			continue
+		}
+		d, err := p.digester(c.ServerVersion, prepared).Digest(c.SQL)
+		if err != nil {
+			p.Stats.fail(1)
+			continue
+		}
+		p.Stats.add(c, d)
+	}
+}
+
+// statement reports whether c carries a statement to digest, and whether
+// that is a prepared one.
+func statement(c mysqlproto.Command) (prepared, ok bool) {
+	if c.SQL == "" {
+		return false, false
+	}
+	switch c.Type {
+	case mysqlproto.ComQuery:
+		return false, true
+	case mysqlproto.ComStmtPrepare, mysqlproto.ComStmtExecute:
+		return true, true
+	}
+	return false, false
+}
+
+// digester returns the digester for statements of a server with the given
+// version string.
+func (p *Proxy) digester(serverVersion string, prepared bool) statementDigester {
+	k := digesterKey{prepared: prepared}
+	switch {
+	case p.Version != nil:
+		k.version = *p.Version
+	case serverVersion != "":
+		v, err := digest.ParseVersion(serverVersion)
+		if err == nil {
+			k.version = v
+			break
+		}
+		p.mu.Lock()
+		if !p.warned[serverVersion] {
+			if p.warned == nil {
+				p.warned = make(map[string]bool)
+			}
+			p.warned[serverVersion] = true
+			p.logf("server version %q unknown, digesting as 8.0", serverVersion)
+		}
+		p.mu.Unlock()
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	d := p.digesters[k]
+	if d == nil {
+		if p.digesters == nil {
+			p.digesters = make(map[digesterKey]statementDigester)
+		}
+		opts := digest.Options{
+			SQLMode:  p.SQLMode,
+			Version:  k.version,
+			Prepared: prepared,
+			Lenient:  p.Lenient,
+		}
+		if prepared {
+			d = digest.NewCachingDigester(opts, cacheSize)
+		} else {
+			d = digest.NewDigester(opts)
+		}
+		p.digesters[k] = d
+	}
+	return d
+}
+
+func (p *Proxy) logf(format string, args ...any) {
+	if p.ErrorLog != nil {
+		p.ErrorLog.Printf(format, args...)
+		return
+	}
+	log.Printf(format, args...)
+}
@@ -0,0 +1,335 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	digest "github.com/rashiq/mysql-digest"
+	"github.com/rashiq/mysql-digest/internal/mysqlproto"
+)
+
+const (
+	eof      = "\xfe\x00\x00\x02\x00"
+	okPacket = "\x00\x00\x00\x02\x00\x00\x00"
+	errTable = "\xff\x7a\x04#42S02Table 'shop.missing' doesn't exist"
+)
+
+// exchange is a command and the packets the fake upstream answers it with.
+type exchange struct {
+	command string
+	reply   []string
+}
+
+var resultSet = []string{"\x01", "def a", eof, "\x011", eof}
+
+var script = []exchange{
+	{"\x03SELECT a FROM t WHERE id = 1", resultSet},
+	{"\x03SELECT a FROM t WHERE id = 2", resultSet},
+	{"\x03SELECT * FROM missing", []string{errTable}},
+	{"\x16SELECT a FROM t WHERE id = ?", []string{
+		"\x00\x01\x00\x00\x00\x01\x00\x01\x00\x00\x00\x00", "def ?", eof, "def a", eof,
+	}},
+	{"\x17\x01\x00\x00\x00\x00\x01\x00\x00\x00", resultSet},
+}
+
+// recorder keeps a copy of everything read from and written to a
+// connection.
+type recorder struct {
+	net.Conn
+	read, written bytes.Buffer
+}
+
+func (r *recorder) Read(b []byte) (int, error) {
+	n, err := r.Conn.Read(b)
+	r.read.Write(b[:n])
+	return n, err
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	r.written.Write(b)
+	return r.Conn.Write(b)
+}
+
+// fakeUpstream serves one connection with a 5.7 greeting, then the
+// replies of script, and closes it at COM_QUIT. The connection's traffic
+// is sent on the returned channel once it is over.
+func fakeUpstream(t *testing.T) (addr string, traffic <-chan *recorder) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	ch := make(chan *recorder, 1)
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		conn := &recorder{Conn: c}
+		defer func() {
+			c.Close()
+			ch <- conn
+		}()
+		send := func(seq byte, payload string) error {
+			return mysqlproto.WritePacket(conn, mysqlproto.Packet{Seq: seq, Payload: []byte(payload)})
+		}
+		if send(0, "\x0a5.7.44-log\x00\x08\x00\x00\x00salt1234\x00") != nil {
+			return
+		}
+		if _, err := mysqlproto.ReadPacket(conn); err != nil { // handshake response
+			return
+		}
+		if send(2, okPacket) != nil {
+			return
+		}
+		for _, x := range script {
+			if _, err := mysqlproto.ReadPacket(conn); err != nil {
+				return
+			}
+			for i, p := range x.reply {
+				if send(byte(i+1), p) != nil {
+					return
+				}
+			}
+		}
+		mysqlproto.ReadPacket(conn) // COM_QUIT
+	}()
+	return l.Addr().String(), ch
+}
+
+func handshakeResponse(db string) string {
+	b := make([]byte, 32)
+	binary.LittleEndian.PutUint32(b, mysqlproto.ClientProtocol41|mysqlproto.ClientSecureConn|mysqlproto.ClientConnectWithDB)
+	b = append(b, "app\x00\x02\x01\x02"...)
+	b = append(b, db...)
+	return string(append(b, 0))
+}
+
+// runClient plays the client side of script through p, and returns the
+// traffic of both ends.
+func runClient(t *testing.T, p *Proxy) (client, upstream *recorder) {
+	t.Helper()
+	addr, traffic := fakeUpstream(t)
+	p.Upstream = addr
+
+	c, proxyEnd := net.Pipe()
+	served := make(chan error, 1)
+	go func() { served <- p.ServeConn(proxyEnd) }()
+
+	client = &recorder{Conn: c}
+	read := func(n int) {
+		t.Helper()
+		for range n {
+			if _, err := mysqlproto.ReadPacket(client); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	send := func(seq byte, payload string) {
+		t.Helper()
+		if err := mysqlproto.WritePacket(client, mysqlproto.Packet{Seq: seq, Payload: []byte(payload)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	read(1) // greeting
+	send(1, handshakeResponse("shop"))
+	read(1)
+	for _, x := range script {
+		send(0, x.command)
+		read(len(x.reply))
+	}
+	send(0, "\x01") // COM_QUIT
+	if _, err := mysqlproto.ReadPacket(client); err != io.EOF {
+		t.Errorf("after COM_QUIT: %v", err)
+	}
+	c.Close()
+	if err := <-served; err != nil {
+		t.Fatal(err)
+	}
+	return client, <-traffic
+}
+
+func TestProxy(t *testing.T) {
+	p := &Proxy{Stats: &Stats{}, ErrorLog: log.New(io.Discard, "", 0)}
+	client, upstream := runClient(t, p)
+
+	if !bytes.Equal(client.written.Bytes(), upstream.read.Bytes()) {
+		t.Error("the upstream did not get what the client sent")
+	}
+	if !bytes.Equal(upstream.written.Bytes(), client.read.Bytes()) {
+		t.Error("the client did not get what the upstream sent")
+	}
+
+	// The version is the greeting's, and the prepared statement has the
+	// digest of the queries it stands for.
+	want := func(sql string) digest.Digest {
+		d, err := digest.Compute(sql, digest.Options{Version: digest.MySQL57})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	byID, missing := want("SELECT a FROM t WHERE id = 1"), want("SELECT * FROM missing")
+
+	entries, undigested := p.Stats.Snapshot()
+	if len(entries) != 2 || undigested != 0 {
+		t.Fatalf("got %d entries, %d undigested: %+v", len(entries), undigested, entries)
+	}
+	got := map[string]Entry{}
+	for _, e := range entries {
+		got[e.Digest] = e
+		if e.Schema != "shop" || e.MinLatency > e.MaxLatency || e.FirstSeen.After(e.LastSeen) {
+			t.Errorf("entry %+v", e)
+		}
+	}
+	e := got[byID.Hash]
+	if e.Count != 4 || e.Errors != 0 || e.DigestText != byID.Text {
+		t.Errorf("by id: %+v", e)
+	}
+	if e.Commands["COM_QUERY"] != 2 || e.Commands["COM_STMT_PREPARE"] != 1 || e.Commands["COM_STMT_EXECUTE"] != 1 {
+		t.Errorf("by id commands: %v", e.Commands)
+	}
+	if e := got[missing.Hash]; e.Count != 1 || e.Errors != 1 {
+		t.Errorf("missing table: %+v", e)
+	}
+}
+
+func TestProxy_UpstreamDown(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+
+	p := &Proxy{Upstream: addr, Stats: &Stats{}}
+	c, proxyEnd := net.Pipe()
+	defer c.Close()
+	if err := p.ServeConn(proxyEnd); err == nil {
+		t.Error("no error")
+	}
+}
+
+func TestStats_ServeHTTP(t *testing.T) {
+	p := &Proxy{Stats: &Stats{}, ErrorLog: log.New(io.Discard, "", 0)}
+	runClient(t, p)
+
+	rec := httptest.NewRecorder()
+	p.Stats.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/digests?limit=1", nil))
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/json" {
+		t.Fatalf("got %d %q", rec.Code, rec.Header().Get("Content-Type"))
+	}
+	var body struct {
+		Digests []map[string]any `json:"digests"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if len(body.Digests) != 1 {
+		t.Fatalf("got %d digests", len(body.Digests))
+	}
+	for _, field := range []string{"digest", "digest_text", "schema", "count", "total_latency_us", "avg_latency_us", "commands"} {
+		if _, ok := body.Digests[0][field]; !ok {
+			t.Errorf("no %s in %v", field, body.Digests[0])
+		}
+	}
+
+	for _, tt := range []struct {
+		method, target string
+		code           int
+	}{
+		{http.MethodPost, "/digests", http.StatusMethodNotAllowed},
+		{http.MethodGet, "/digests?limit=x", http.StatusBadRequest},
+	} {
+		rec := httptest.NewRecorder()
+		p.Stats.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, nil))
+		if rec.Code != tt.code {
+			t.Errorf("%s %s: got %d, want %d", tt.method, tt.target, rec.Code, tt.code)
+		}
+	}
+
+	p.Stats.Reset()
+	rec = httptest.NewRecorder()
+	p.Stats.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/digests", nil))
+	if got := rec.Body.String(); got != `{"digests":[],"undigested":0}`+"\n" {
+		t.Errorf("after Reset: %s", got)
+	}
+}
+
+func TestProxy_UnknownVersion(t *testing.T) {
+	var logged bytes.Buffer
+	p := &Proxy{Stats: &Stats{}, ErrorLog: log.New(&logged, "", 0)}
+	for range 2 {
+		p.digester("5.6.51-log", false)
+		p.digester("8.3.0", false)
+	}
+	if got, want := logged.String(), "server version \"5.6.51-log\" unknown, digesting as 8.0\n"; got != want {
+		t.Errorf("logged %q, want %q", got, want)
+	}
+}
+
+func TestProxy_Digesters(t *testing.T) {
+	p := &Proxy{Stats: &Stats{}}
+	if _, ok := p.digester("8.0.36", false).(*digest.Digester); !ok {
+		t.Errorf("COM_QUERY digester is %T, want *digest.Digester", p.digester("8.0.36", false))
+	}
+	if _, ok := p.digester("8.0.36", true).(*digest.CachingDigester); !ok {
+		t.Errorf("prepared digester is %T, want *digest.CachingDigester", p.digester("8.0.36", true))
+	}
+}
+
+func TestRelay_QueueFull(t *testing.T) {
+	p := &Proxy{Stats: &Stats{}}
+	r := &relay{p: p, work: make(chan []mysqlproto.Command)} // no worker
+	r.queue([]mysqlproto.Command{
+		{Type: mysqlproto.ComQuery, SQL: "SELECT 1"},
+		{Type: mysqlproto.ComStmtExecute, SQL: "SELECT ?"},
+		{Type: mysqlproto.ComQuit},
+	})
+	if entries, undigested := p.Stats.Snapshot(); len(entries) != 0 || undigested != 2 {
+		t.Errorf("got %d entries, %d undigested; want 0, 2", len(entries), undigested)
+	}
+}
+
+func TestStats_MaxDigests(t *testing.T) {
+	s := &Stats{MaxDigests: 2}
+	add := func(sql string, latency time.Duration) {
+		d, err := digest.Compute(sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.add(mysqlproto.Command{Type: mysqlproto.ComQuery, SQL: sql, Schema: "shop", Latency: latency}, d)
+	}
+	add("SELECT a FROM t", 4*time.Millisecond)
+	add("SELECT b FROM t", 3*time.Millisecond)
+	add("SELECT c FROM t", 2*time.Millisecond)
+	add("SELECT d FROM t", 1*time.Millisecond)
+	add("SELECT a FROM t", 4*time.Millisecond)
+
+	entries, _ := s.Snapshot()
+	if len(entries) != 3 {
+		t.Fatalf("got %d entries, want 2 and the overflow: %+v", len(entries), entries)
+	}
+	if e := entries[0]; e.DigestText != "SELECT `a` FROM `t`" || e.Count != 2 {
+		t.Errorf("kept entry %+v", e)
+	}
+	i := slices.IndexFunc(entries, func(e Entry) bool { return e.Digest == "" })
+	if i < 0 {
+		t.Fatalf("no overflow entry: %+v", entries)
+	}
+	e := entries[i]
+	if e.Schema != "" || e.Digest != "" || e.DigestText != "" || e.Count != 2 ||
+		e.TotalLatency != 3*time.Millisecond || e.MinLatency != time.Millisecond || e.Commands["COM_QUERY"] != 2 {
+		t.Errorf("overflow entry %+v", e)
+	}
+}
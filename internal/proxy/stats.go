@@ -0,0 +1,193 @@
+package proxy
+
+import (
+	"cmp"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	digest "github.com/rashiq/mysql-digest"
+	"github.com/rashiq/mysql-digest/internal/mysqlproto"
+)
+
+// Stats aggregates statements by default database and digest, as
+// performance_schema.events_statements_summary_by_digest does. The zero
+// value is ready to use, and it is safe for concurrent use.
+//
+// Like the server's table, Stats holds a bounded number of digests. Once
+// it is full, the statements of further digests are aggregated in one
+// overflow entry, with an empty Schema, Digest and DigestText where the
+// server has NULLs.
+//
+// Stats is an http.Handler serving Snapshot as JSON, ordered by total
+// latency; the limit query parameter keeps the first so many digests.
+type Stats struct {
+	// MaxDigests is the number of digests kept, as
+	// performance_schema_digests_size; 0 means DefaultMaxDigests.
+	MaxDigests int
+
+	mu         sync.Mutex
+	entries    map[statsKey]*Entry
+	undigested int64
+}
+
+// DefaultMaxDigests is the number of digests Stats keeps by default.
+const DefaultMaxDigests = 10000
+
+type statsKey struct {
+	schema, digest string
+}
+
+// Entry is the aggregate of the statements with one digest in one default
+// database. Latencies run from the command to the end of the server's
+// response.
+type Entry struct {
+	Schema     string           `json:"schema"`
+	Digest     string           `json:"digest"`
+	DigestText string           `json:"digest_text"`
+	Count      int64            `json:"count"`
+	Errors     int64            `json:"errors"`
+	Commands   map[string]int64 `json:"commands"` // count by command name
+	FirstSeen  time.Time        `json:"first_seen"`
+	LastSeen   time.Time        `json:"last_seen"`
+
+	TotalLatency time.Duration `json:"-"`
+	MinLatency   time.Duration `json:"-"`
+	MaxLatency   time.Duration `json:"-"`
+}
+
+func (e Entry) MarshalJSON() ([]byte, error) {
+	type entry Entry // without the method
+	return json.Marshal(struct {
+		entry
+		TotalUS int64 `json:"total_latency_us"`
+		AvgUS   int64 `json:"avg_latency_us"`
+		MinUS   int64 `json:"min_latency_us"`
+		MaxUS   int64 `json:"max_latency_us"`
+	}{
+		entry:   entry(e),
+		TotalUS: e.TotalLatency.Microseconds(),
+		AvgUS:   (e.TotalLatency / time.Duration(max(e.Count, 1))).Microseconds(),
+		MinUS:   e.MinLatency.Microseconds(),
+		MaxUS:   e.MaxLatency.Microseconds(),
+	})
+}
+
+func (s *Stats) add(c mysqlproto.Command, d digest.Digest) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := statsKey{c.Schema, d.Hash}
+	e := s.entries[k]
+	if e == nil && s.full() {
+		k = statsKey{} // the overflow entry
+		e = s.entries[k]
+	}
+	if e == nil {
+		if s.entries == nil {
+			s.entries = make(map[statsKey]*Entry)
+		}
+		e = &Entry{
+			Schema:     k.schema,
+			Digest:     k.digest,
+			Commands:   make(map[string]int64),
+			FirstSeen:  c.Time,
+			MinLatency: c.Latency,
+		}
+		if k.digest != "" {
+			e.DigestText = d.Text
+		}
+		s.entries[k] = e
+	}
+	e.Count++
+	if c.Err != nil {
+		e.Errors++
+	}
+	e.Commands[mysqlproto.CommandName(c.Type)]++
+	e.LastSeen = c.Time
+	e.TotalLatency += c.Latency
+	e.MinLatency = min(e.MinLatency, c.Latency)
+	e.MaxLatency = max(e.MaxLatency, c.Latency)
+}
+
+// full reports whether there is no room for another digest. The overflow
+// entry does not take one.
+func (s *Stats) full() bool {
+	limit := s.MaxDigests
+	if limit <= 0 {
+		limit = DefaultMaxDigests
+	}
+	n := len(s.entries)
+	if _, ok := s.entries[statsKey{}]; ok {
+		n--
+	}
+	return n >= limit
+}
+
+// fail counts n statements that were not digested.
+func (s *Stats) fail(n int) {
+	s.mu.Lock()
+	s.undigested += int64(n)
+	s.mu.Unlock()
+}
+
+// Snapshot returns a copy of the aggregates, ordered by total latency,
+// highest first, and the number of statements that were not digested:
+// those that failed to, and those that completed while their connection's
+// digest queue was full.
+func (s *Stats) Snapshot() (entries []Entry, undigested int64) {
+	s.mu.Lock()
+	for _, e := range s.entries {
+		c := *e
+		c.Commands = make(map[string]int64, len(e.Commands))
+		for name, n := range e.Commands {
+			c.Commands[name] = n
+		}
+		entries = append(entries, c)
+	}
+	undigested = s.undigested
+	s.mu.Unlock()
+
+	slices.SortFunc(entries, func(a, b Entry) int {
+		return cmp.Or(
+			cmp.Compare(b.TotalLatency, a.TotalLatency),
+			cmp.Compare(a.Schema, b.Schema),
+			cmp.Compare(a.Digest, b.Digest),
+		)
+	})
+	return entries, undigested
+}
+
+// Reset forgets all aggregates.
+func (s *Stats) Reset() {
+	s.mu.Lock()
+	s.entries, s.undigested = nil, 0
+	s.mu.Unlock()
+}
+
+func (s *Stats) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	entries, undigested := s.Snapshot()
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			http.Error(w, "limit: want a count", http.StatusBadRequest)
+			return
+		}
+		entries = entries[:min(n, len(entries))]
+	}
+	if entries == nil {
+		entries = []Entry{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(struct {
+		Digests    []Entry `json:"digests"`
+		Undigested int64   `json:"undigested"`
+	}{entries, undigested})
+}
@@ -0,0 +1,38 @@
+package internal
+
+// Recording is the token stream of one lexing pass. Unless the lexer saw
+// something version specific, the same stream can be replayed into the
+// token store of every digest version.
+type Recording struct {
+	lexer  *Lexer
+	tokens []Token
+}
+
+// Record lexes the whole input, up to END_OF_INPUT or the first error.
+// With maxTokens above 0 it stops one token past it, which is enough for
+// a replay to fail with the LimitError a full run would give.
+func Record(l *Lexer, maxTokens int) *Recording {
+	r := &Recording{lexer: l}
+	for {
+		tok := l.Lex()
+		r.tokens = append(r.tokens, tok)
+		if tok.Type == END_OF_INPUT || tok.Type == ABORT_SYM ||
+			(maxTokens > 0 && len(r.tokens) > maxTokens) {
+			return r
+		}
+	}
+}
+
+// VersionSensitive reports whether the recorded tokens are only valid for
+// the version they were lexed with.
+func (r *Recording) VersionSensitive() bool {
+	return r.lexer.VersionSensitive()
+}
+
+// NewReplayHandler creates a token handler that reads its tokens from rec
+// instead of lexing the input again.
+func NewReplayHandler(rec *Recording, store *tokenStore, reducer *reducer) *tokenHandler {
+	h := NewTokenHandler(rec.lexer, store, reducer)
+	h.replay = rec.tokens
+	return h
+}
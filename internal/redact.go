@@ -0,0 +1,294 @@
+package internal
+
+import "strings"
+
+// RedactConfig selects what Redact does besides replacing literals.
+type RedactConfig struct {
+	StripComments     bool // drop comments other than hints and versioned ones
+	TypedPlaceholders bool // ?int, ?dec, ?float, ?str, ?hex or ?bin instead of ?
+	CollapseInLists   bool // IN (1, 2, 3) becomes IN (?, ...)
+}
+
+// Redact returns the input of l with every literal token replaced by a
+// placeholder, and everything between and around the literals, spacing,
+// case and comments included, as it was. Optimizer hints are kept but for
+// their literals, such as the value of a SET_VAR. Adjacent string literals, which the server concatenates, become one
+// placeholder. Versioned comments for a later version are redacted as if
+// that version ran them; one whose content cannot be lexed loses it.
+// Anything after a NUL byte, where the lexer stops, is left out, since it
+// was never checked for literals.
+func Redact(l *Lexer, cfg RedactConfig) (string, error) {
+	var toks []Token
+	end := 0
+	for {
+		tok := l.Lex()
+		if tok.Type == ABORT_SYM {
+			return "", tok.Err
+		}
+		if tok.Type == END_OF_INPUT {
+			end = tok.Start
+			break
+		}
+		toks = append(toks, tok)
+	}
+
+	r := redactor{lexer: l, input: l.input[:end], cfg: cfg, toks: toks}
+	r.out.Grow(len(l.input))
+	r.run()
+	return r.out.String(), nil
+}
+
+type redactor struct {
+	lexer *Lexer
+	input string
+	cfg   RedactConfig
+	toks  []Token
+	out   strings.Builder
+	prev  int // end of the last token copied
+}
+
+func (r *redactor) run() {
+	inHint := false
+	for i := 0; i < len(r.toks); i++ {
+		tok := r.toks[i]
+		r.gap(tok.Start, i == 0)
+
+		switch {
+		case tok.Type == TOK_HINT_COMMENT_OPEN:
+			inHint = true
+		case tok.Type == TOK_HINT_COMMENT_CLOSE:
+			inHint = false
+		case redactedLiteral(tok.Type):
+			i = r.literal(i)
+			continue
+		case inHint:
+		case tok.Type == IN_SYM && r.cfg.CollapseInLists:
+			if n := r.inList(i); n > 0 {
+				i = r.collapse(i, n)
+				continue
+			}
+		}
+		r.copy(tok)
+	}
+	r.gap(len(r.input), len(r.toks) == 0)
+	if r.cfg.StripComments {
+		trimmed := strings.TrimRight(r.out.String(), " \t\r\n")
+		if len(trimmed) < r.out.Len() {
+			r.out.Reset()
+			r.out.WriteString(trimmed)
+		}
+	}
+}
+
+// copy writes tok as it is.
+func (r *redactor) copy(tok Token) {
+	r.out.WriteString(r.input[tok.Start:tok.End])
+	r.prev = tok.End
+}
+
+// gap writes the text between the last token and end.
+func (r *redactor) gap(end int, first bool) {
+	if end <= r.prev {
+		return
+	}
+	gap := r.comments(r.input[r.prev:end])
+	r.prev = end
+	if !r.cfg.StripComments {
+		r.out.WriteString(gap)
+		return
+	}
+	switch {
+	case first:
+		gap = strings.TrimLeft(gap, " \t\r\n")
+	case gap == "":
+		gap = " " // keep the tokens apart
+	}
+	r.out.WriteString(gap)
+}
+
+// comments returns the text between two tokens with its skipped versioned
+// comments redacted and, with StripComments, its other comments removed
+// along with the blanks in front of them. Versioned comments, whose
+// content the server may run, are kept.
+func (r *redactor) comments(gap string) string {
+	var b []byte
+	for i := 0; i < len(gap); {
+		rest := gap[i:]
+		n := 0
+		switch {
+		case strings.HasPrefix(rest, "/*!"):
+			var text string
+			text, n = r.versionedComment(rest)
+			b = append(b, text...)
+			i += n
+			continue
+		case strings.HasPrefix(rest, "/*"):
+			n = len(rest)
+			if end := strings.Index(rest[2:], "*/"); end >= 0 {
+				n = end + 4
+			}
+		case rest[0] == '#':
+			n = lineCommentLen(rest)
+		case strings.HasPrefix(rest, "--") && (len(rest) == 2 || rest[2] <= ' '):
+			n = lineCommentLen(rest)
+		default:
+			b = append(b, rest[0])
+			i++
+			continue
+		}
+		if !r.cfg.StripComments {
+			b = append(b, rest[:n]...)
+		} else {
+			for len(b) > 0 && (b[len(b)-1] == ' ' || b[len(b)-1] == '\t') {
+				b = b[:len(b)-1]
+			}
+		}
+		i += n
+	}
+	return string(b)
+}
+
+// versionedComment returns the redacted text of the versioned comment at
+// the start of s and its length in s. The opening of a comment the lexer
+// ran is all of it that is in a gap; a comment it skipped is there whole,
+// and its content is redacted as the server that runs it would lex it.
+func (r *redactor) versionedComment(s string) (string, int) {
+	n := len("/*!")
+	version, digits := 0, 0
+	for digits < 6 && n < len(s) && isDigit(s[n]) {
+		version = version*10 + int(s[n]-'0')
+		n++
+		digits++
+	}
+	if digits == 0 || digits >= 5 && version <= r.lexer.mysqlVersionInt() {
+		return s[:n], n
+	}
+
+	open := s[:n]
+	end := strings.Index(s[n:], "*/")
+	if end < 0 {
+		return open, len(s) // cannot happen: the lexer rejects it
+	}
+	body := s[n : n+end]
+	content := strings.TrimLeft(body, " \t\r\n")
+	lead := body[:len(body)-len(content)]
+	content = strings.TrimRight(content, " \t\r\n")
+	trail := body[len(lead)+len(content):]
+	if content != "" {
+		redacted, err := Redact(r.lexer.sub(content), r.cfg)
+		if err != nil {
+			redacted, trail = "", ""
+		}
+		content = redacted
+	}
+	return open + lead + content + trail + "*/", n + end + 2
+}
+
+// literal writes the placeholder for the literal at i, together with the
+// string literals that directly follow it, and returns the index of the
+// last token it consumed.
+func (r *redactor) literal(i int) int {
+	r.out.WriteString(r.placeholder(r.toks[i].Type))
+	r.prev = r.toks[i].End
+	for isRedactedString(r.toks[i].Type) && i+1 < len(r.toks) &&
+		isRedactedString(r.toks[i+1].Type) &&
+		strings.TrimSpace(r.input[r.toks[i].End:r.toks[i+1].Start]) == "" {
+		i++
+		r.prev = r.toks[i].End
+	}
+	return i
+}
+
+// inList returns how many tokens after the IN at i form a list of two or
+// more literals, "( [sign] literal , ... )", or 0 if they don't.
+func (r *redactor) inList(i int) int {
+	j := i + 1
+	if j >= len(r.toks) || r.toks[j].Type != '(' {
+		return 0
+	}
+	elems := 0
+	for j++; j < len(r.toks); j++ {
+		if t := r.toks[j].Type; (t == '-' || t == '+') && j+1 < len(r.toks) {
+			j++
+		}
+		if t := r.toks[j].Type; !redactedLiteral(t) && t != PARAM_MARKER {
+			return 0
+		}
+		elems++
+		j++
+		if j >= len(r.toks) {
+			return 0
+		}
+		switch r.toks[j].Type {
+		case ',':
+			continue
+		case ')':
+			if elems < 2 {
+				return 0
+			}
+			return j - i
+		}
+		return 0
+	}
+	return 0
+}
+
+// collapse writes the IN list of n tokens after the IN at i as its first
+// element and ", ...", and returns the index of its closing parenthesis.
+func (r *redactor) collapse(i, n int) int {
+	open, first := r.toks[i+1], r.toks[i+2]
+	if first.Type == '-' || first.Type == '+' {
+		first = r.toks[i+3]
+	}
+	r.copy(r.toks[i])
+	r.gap(open.Start, false)
+	r.copy(open)
+	r.out.WriteString(r.placeholder(first.Type))
+	r.out.WriteString(", ...")
+	r.copy(r.toks[i+n])
+	return i + n
+}
+
+func (r *redactor) placeholder(tok int) string {
+	if !r.cfg.TypedPlaceholders {
+		return "?"
+	}
+	switch tok {
+	case NUM, LONG_NUM, ULONGLONG_NUM:
+		return "?int"
+	case DECIMAL_NUM:
+		return "?dec"
+	case FLOAT_NUM:
+		return "?float"
+	case HEX_NUM:
+		return "?hex"
+	case BIN_NUM:
+		return "?bin"
+	case PARAM_MARKER:
+		return "?"
+	}
+	return "?str"
+}
+
+// redactedLiteral reports whether a token of type tok is a literal that
+// Redact replaces. Parameter markers are placeholders already.
+func redactedLiteral(tok int) bool {
+	return isRedactedString(tok) || isNumericLiteral(tok)
+}
+
+func isRedactedString(tok int) bool {
+	switch tok {
+	case TEXT_STRING, NCHAR_STRING, DOLLAR_QUOTED_STRING_SYM:
+		return true
+	}
+	return false
+}
+
+// lineCommentLen returns the length of the line comment at the start of s,
+// without the newline that ends it.
+func lineCommentLen(s string) int {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return i
+	}
+	return len(s)
+}
@@ -9,28 +9,44 @@ func NewReducer(store *tokenStore) *reducer {
 	return &reducer{store: store}
 }
 
-// Absorbs unary +/- signs before numeric literals.
-func (r *reducer) reduceUnarySign() {
+// Absorbs unary +/- signs before numeric literals. It returns the signs
+// when the store keeps literals.
+func (r *reducer) reduceUnarySign() string {
+	sign := ""
 	for {
 		prev, last := r.store.peek2()
 		if (last == '+' || last == '-') && startsExpression(prev) {
+			if r.store.keepLiterals {
+				sign = string(rune(last)) + sign
+			}
 			r.store.pop(1)
+			if r.store.trace != nil {
+				r.store.trace.reduce(RuleUnarySign, r.store, false)
+			}
 		} else {
 			break
 		}
 	}
+	return sign
 }
 
 // Checks for pattern: VALUE/VALUE_LIST ',' VALUE -> VALUE_LIST
 func (r *reducer) reduceAfterValue() {
 	first, comma, _ := r.store.peek3()
 	if comma == ',' && isValueOrValueList(first) {
-		r.store.pop(3)
-		r.store.push(TOK_GENERIC_VALUE_LIST)
+		r.fold(RuleValueList, 3, TOK_GENERIC_VALUE_LIST)
 	}
 	r.reduceAll()
 }
 
+// fold replaces the top n tokens with tokType, as rule says.
+func (r *reducer) fold(rule string, n, tokType int) {
+	r.store.replace(n, tokType)
+	if r.store.trace != nil {
+		r.store.trace.reduce(rule, r.store, true)
+	}
+}
+
 func (r *reducer) reduceAll() {
 	for {
 		if r.reduceParenthesizedValue() {
@@ -62,12 +78,10 @@ func (r *reducer) reduceParenthesizedValue() bool {
 
 	switch mid {
 	case TOK_GENERIC_VALUE:
-		r.store.pop(3)
-		r.store.push(TOK_ROW_SINGLE_VALUE)
+		r.fold(RuleRow, 3, TOK_ROW_SINGLE_VALUE)
 		return true
 	case TOK_GENERIC_VALUE_LIST:
-		r.store.pop(3)
-		r.store.push(TOK_ROW_MULTIPLE_VALUE)
+		r.fold(RuleRow, 3, TOK_ROW_MULTIPLE_VALUE)
 		return true
 	}
 	return false
@@ -88,14 +102,12 @@ func (r *reducer) reduceRowList() bool {
 	}
 
 	if isSingleValueRow(first) && isSingleValueRow(last) {
-		r.store.pop(3)
-		r.store.push(TOK_ROW_SINGLE_VALUE_LIST)
+		r.fold(RuleRowList, 3, TOK_ROW_SINGLE_VALUE_LIST)
 		return true
 	}
 
 	if isMultiValueRow(first) && isMultiValueRow(last) {
-		r.store.pop(3)
-		r.store.push(TOK_ROW_MULTIPLE_VALUE_LIST)
+		r.fold(RuleRowList, 3, TOK_ROW_MULTIPLE_VALUE_LIST)
 		return true
 	}
 
@@ -119,8 +131,7 @@ func (r *reducer) reduceInClause() bool {
 	}
 
 	if last == TOK_ROW_SINGLE_VALUE || last == TOK_ROW_MULTIPLE_VALUE {
-		r.store.pop(2)
-		r.store.push(TOK_IN_GENERIC_VALUE_EXPRESSION)
+		r.fold(RuleInList, 2, TOK_IN_GENERIC_VALUE_EXPRESSION)
 		return true
 	}
 
@@ -0,0 +1,104 @@
+package internal
+
+import (
+	"context"
+	"io"
+)
+
+// State holds everything one digest computation needs: the lexer, token
+// store, reducer and handler. It can be reused for any number of
+// statements; once its buffers have grown to fit them, digesting a
+// statement does not allocate.
+type State struct {
+	lexer   Lexer
+	store   tokenStore
+	reducer reducer
+	handler tokenHandler
+}
+
+// NewState creates an empty State.
+func NewState() *State {
+	s := &State{store: *NewTokenStore(MySQL80)}
+	s.reducer.store = &s.store
+	s.handler = tokenHandler{lexer: &s.lexer, store: &s.store, reducer: &s.reducer}
+	return s
+}
+
+// Reset prepares s to digest input as version. Further lexer settings are
+// made through Lexer before calling Run.
+func (s *State) Reset(input string, version MySQLVersion) {
+	s.lexer.Reset(input)
+	s.lexer.SetDigestVersion(version)
+	s.store.reset(version)
+	s.handler.lexer = &s.lexer
+	s.handler.replay = nil
+	s.handler.lenient = false
+	s.handler.ctx, s.handler.maxTokens = nil, 0
+	s.handler.literals = LiteralNormalize
+}
+
+// ResetReader prepares s to digest the statement read from r as version,
+// keeping only a bounded window of it and the reducible tail of the token
+// stack in memory. maxLen is the text length later passed to AppendText.
+func (s *State) ResetReader(r io.Reader, version MySQLVersion, maxLen int) {
+	s.lexer.ResetReader(r)
+	s.lexer.SetDigestVersion(version)
+	s.store.reset(version)
+	s.store.StartStream(maxLen)
+	s.handler.lexer = &s.lexer
+	s.handler.replay = nil
+	s.handler.lenient = false
+	s.handler.ctx, s.handler.maxTokens = nil, 0
+	s.handler.literals = LiteralNormalize
+}
+
+// ResetReplay prepares s to digest the tokens in rec as version.
+func (s *State) ResetReplay(rec *Recording, version MySQLVersion) {
+	s.lexer.Reset("")
+	s.store.reset(version)
+	s.handler.lexer = rec.lexer
+	s.handler.replay = rec.tokens
+	s.handler.lenient = false
+	s.handler.ctx, s.handler.maxTokens = nil, 0
+	s.handler.literals = LiteralNormalize
+}
+
+// SetLenient makes Run end the digest of a statement it cannot lex with
+// an ABORT_SYM marker token where the error occurred, as the server's
+// digest does. Run still returns the error.
+func (s *State) SetLenient(on bool) {
+	s.handler.lenient = on
+}
+
+// SetLimits makes Run give up with ctx's error once ctx is done, checking
+// it every few hundred tokens, and with a LimitError after maxTokens
+// tokens. A nil ctx or a maxTokens of 0 means no limit.
+func (s *State) SetLimits(ctx context.Context, maxTokens int) {
+	s.handler.ctx, s.handler.maxTokens = ctx, maxTokens
+}
+
+// SetTrace makes Run record what it does in t: every token the lexer
+// returns, every push and every reduction. A nil t turns tracing off.
+func (s *State) SetTrace(t *Trace) {
+	s.store.trace = t
+	s.lexer.traceStates = t != nil
+}
+
+// Lexer returns the lexer used by Run.
+func (s *State) Lexer() *Lexer {
+	return &s.lexer
+}
+
+// Store returns the token store Run fills.
+func (s *State) Store() *TokenStore {
+	return &s.store
+}
+
+// Run digests the statement given to Reset.
+func (s *State) Run() error {
+	err := s.handler.ProcessAll()
+	if readErr := s.lexer.ReadErr(); readErr != nil {
+		return readErr
+	}
+	return err
+}
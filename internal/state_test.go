@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"io"
+	"testing"
+)
+
+var typicalQueries = []string{
+	"SELECT * FROM users WHERE id = 1",
+	"select u.id, u.name from users u join orders o on u.id = o.user_id where o.status = 'active' limit 10",
+	"SELECT * FROM t WHERE a IN (1, 2, 3, 4, 5) AND b IS NOT NULL",
+	"INSERT INTO t (a, b) VALUES (1, 'x'), (2, 'y'), (3, 'z')",
+	"UPDATE `orders` SET total = total + 1.5 WHERE id = ? -- trailing",
+	"SELECT /*+ MAX_EXECUTION_TIME(1000) */ @a, @@session.sql_mode FROM dual",
+}
+
+func TestState_ZeroAllocs(t *testing.T) {
+	s := NewState()
+	buf := make([]byte, 0, 1024)
+	for _, v := range []MySQLVersion{MySQL80, MySQL57} {
+		for _, sql := range typicalQueries {
+			allocs := testing.AllocsPerRun(100, func() {
+				s.Reset(sql, v)
+				if err := s.Run(); err != nil {
+					t.Fatal(err)
+				}
+				buf = s.Store().AppendHash(buf[:0])
+				buf = s.Store().AppendText(buf, 0)
+			})
+			if allocs != 0 {
+				t.Errorf("%v %q: %v allocations per run, want 0", v, sql, allocs)
+			}
+		}
+	}
+}
+
+func BenchmarkState(b *testing.B) {
+	s := NewState()
+	buf := make([]byte, 0, 1024)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.Reset(typicalQueries[i%len(typicalQueries)], MySQL80)
+		s.Run() //nolint:errcheck
+		buf = s.Store().AppendHash(buf[:0])
+		buf = s.Store().AppendText(buf, 0)
+	}
+}
+
+// repeatReader returns head, then body count times, then tail.
+type repeatReader struct {
+	head, body, tail string
+	count            int
+	pending          string
+}
+
+func (r *repeatReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if r.pending == "" {
+			switch {
+			case r.head != "":
+				r.pending, r.head = r.head, ""
+			case r.count > 0:
+				r.pending = r.body
+				r.count--
+			case r.tail != "":
+				r.pending, r.tail = r.tail, ""
+			default:
+				if n == 0 {
+					return 0, io.EOF
+				}
+				return n, nil
+			}
+		}
+		k := copy(p[n:], r.pending)
+		r.pending = r.pending[k:]
+		n += k
+	}
+	return n, nil
+}
+
+func TestState_StreamBoundedMemory(t *testing.T) {
+	s := NewState()
+	s.ResetReader(&repeatReader{
+		head:  "INSERT INTO t (a, b, c) VALUES (0, 'x', NULL)",
+		body:  ", (12345, 'some text that repeats', 3.25)",
+		tail:  ";",
+		count: 200000, // about 8 MB
+	}, MySQL80, 0)
+	if err := s.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := cap(s.lexer.src.buf); got > 4*streamChunk {
+		t.Errorf("lexer window grew to %d bytes", got)
+	}
+	if got := cap(s.store.tokens); got > 256 {
+		t.Errorf("token stack grew to %d tokens", got)
+	}
+	want := "INSERT INTO `t` ( `a` , `b` , `c` ) VALUES (...) /* , ... */"
+	if got := string(s.Store().AppendText(nil, 0)); got != want {
+		t.Errorf("text = %q, want %q", got, want)
+	}
+}
+
+func TestState_StreamFlushesFinalTokens(t *testing.T) {
+	s := NewState()
+	s.ResetReader(&repeatReader{
+		body:  "SELECT a, b FROM t UNION ALL ",
+		tail:  "SELECT 1",
+		count: 10000,
+	}, MySQL80, 0)
+	if err := s.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if got := cap(s.store.tokens); got > 256 {
+		t.Errorf("token stack grew to %d tokens", got)
+	}
+}
@@ -8,15 +8,32 @@ import (
 )
 
 type storedToken struct {
-	tokType int
-	text    string
+	tokType   int
+	text      string
+	literals  *strings.Builder // text of a folded list, grown by joinLiterals
+	versioned bool             // lexed inside a versioned comment
 }
 
+// maxRetainedTokens is the largest token buffer reset keeps.
+const maxRetainedTokens = 16 * 1024
+
 type tokenStore struct {
 	tokens      []storedToken
 	tokenArray  []byte
 	version     MySQLVersion
 	tokenConfig *TokenConfig
+	stream      *tokenStream // set by StartStream
+	trace       *Trace       // set by State.SetTrace
+
+	// keepLiterals makes values carry the text of their literals, which
+	// reductions join, for a LiteralMode other than LiteralNormalize.
+	// literalsMax, when above 0, bounds the joined text.
+	keepLiterals bool
+	literalsMax  int
+
+	// inVersionComment is set by the handler while it pushes tokens
+	// lexed inside a versioned comment.
+	inVersionComment bool
 }
 
 // TokenStore holds the normalized tokens for digest computation.
@@ -32,8 +49,44 @@ func NewTokenStore(version MySQLVersion) *tokenStore {
 	}
 }
 
+// reset empties the store for a new statement digested as version.
+// Buffers grown by an unusually large statement are dropped rather than
+// kept for the next one.
+func (s *tokenStore) reset(version MySQLVersion) {
+	if cap(s.tokens) > maxRetainedTokens || cap(s.tokenArray) > 4*maxRetainedTokens {
+		*s = *NewTokenStore(version)
+		return
+	}
+	clear(s.tokens) // drop references to the previous input
+	s.tokens = s.tokens[:0]
+	s.stream = nil
+	s.trace = nil
+	s.keepLiterals, s.literalsMax = false, 0
+	s.inVersionComment = false
+	s.tokenArray = s.tokenArray[:0]
+	s.version = version
+	s.tokenConfig = GetTokenConfig(version)
+}
+
 func (s *tokenStore) push(tokType int) {
-	s.tokens = append(s.tokens, storedToken{tokType: tokType})
+	s.pushLiteral(tokType, "")
+}
+
+// pushLiteral pushes a value token that prints as text, or as its token
+// string when text is empty.
+func (s *tokenStore) pushLiteral(tokType int, text string) {
+	s.add(tokType, text)
+	if s.trace != nil {
+		s.trace.push(s, 2)
+	}
+	if s.stream != nil && len(s.tokens) >= s.stream.flushAt {
+		s.flush(false)
+	}
+}
+
+// add appends a token and its 2-byte hash record.
+func (s *tokenStore) add(tokType int, text string) {
+	s.tokens = append(s.tokens, storedToken{tokType: tokType, text: text, versioned: s.inVersionComment})
 	binTok := s.translateToken(tokType)
 	s.tokenArray = append(s.tokenArray,
 		byte(binTok&0xff),
@@ -42,7 +95,7 @@ func (s *tokenStore) push(tokType int) {
 
 // Binary format for identifiers: 2 bytes (token) + 2 bytes (length) + N bytes (text).
 func (s *tokenStore) pushIdent(text string) {
-	s.tokens = append(s.tokens, storedToken{tokType: TOK_IDENT, text: text})
+	s.tokens = append(s.tokens, storedToken{tokType: TOK_IDENT, text: text, versioned: s.inVersionComment})
 	binTok := s.translateToken(TOK_IDENT)
 	s.tokenArray = append(s.tokenArray,
 		byte(binTok&0xff),
@@ -50,6 +103,35 @@ func (s *tokenStore) pushIdent(text string) {
 		byte(len(text)&0xff),
 		byte((len(text)>>8)&0xff))
 	s.tokenArray = append(s.tokenArray, text...)
+	if s.trace != nil {
+		s.trace.push(s, 4+len(text))
+	}
+	if s.stream != nil && len(s.tokens) >= s.stream.flushAt {
+		s.flush(false)
+	}
+}
+
+// replace pops the top n tokens and pushes one tokType in their place.
+func (s *tokenStore) replace(n int, tokType int) {
+	var literals *strings.Builder
+	if s.keepLiterals {
+		literals = s.joinLiterals(n)
+	}
+	versioned := false
+	for _, tok := range s.tokens[len(s.tokens)-n:] {
+		versioned = versioned || tok.versioned
+	}
+	s.pop(n)
+	if literals == nil {
+		s.add(tokType, "")
+	} else {
+		s.add(tokType, literals.String())
+		s.tokens[len(s.tokens)-1].literals = literals
+	}
+	s.tokens[len(s.tokens)-1].versioned = versioned
+	if s.stream != nil && len(s.tokens) >= s.stream.flushAt {
+		s.flush(false)
+	}
 }
 
 func (s *tokenStore) pop(n int) {
@@ -112,51 +194,112 @@ func (s *tokenStore) translateToken(tokType int) int {
 
 // ComputeHash returns the digest hash.
 func (s *tokenStore) ComputeHash() string {
+	return string(s.AppendHash(nil))
+}
+
+// AppendHash appends the hex encoded digest hash to dst.
+func (s *tokenStore) AppendHash(dst []byte) []byte {
+	sum, n := s.Sum()
+	return hex.AppendEncode(dst, sum[:n])
+}
+
+// Sum returns the raw digest hash and its length: 32 bytes of SHA-256, or
+// 16 bytes of MD5 for MySQL 5.7.
+func (s *tokenStore) Sum() ([32]byte, int) {
+	if s.stream != nil {
+		var sum [32]byte
+		s.flush(true)
+		return sum, copy(sum[:], s.stream.hash.Sum(nil))
+	}
 	if s.version == MySQL57 {
+		var sum [32]byte
 		hash := md5.Sum(s.tokenArray)
-		return hex.EncodeToString(hash[:])
+		return sum, copy(sum[:], hash[:])
 	}
-	hash := sha256.Sum256(s.tokenArray)
-	return hex.EncodeToString(hash[:])
+	return sha256.Sum256(s.tokenArray), sha256.Size
 }
 
 // BuildText returns the normalized query text.
 func (s *tokenStore) BuildText(maxLen int) string {
-	var b strings.Builder
+	return string(s.AppendText(nil, maxLen))
+}
+
+// AppendText appends the normalized query text to dst. With maxLen > 0
+// the text is cut after maxLen bytes and "..." is added.
+func (s *tokenStore) AppendText(dst []byte, maxLen int) []byte {
+	start := len(dst)
 	addSpace := false
+	if s.stream != nil {
+		dst = append(dst, s.stream.text...)
+		addSpace = s.stream.addSpace
+	}
 
 	for _, tok := range s.tokens {
-		text := s.tokenToText(tok)
-		if text == "" {
-			continue
-		}
+		n := len(dst)
 		if addSpace {
-			b.WriteByte(' ')
+			dst = append(dst, ' ')
+		}
+		text := len(dst)
+		dst = s.appendToken(dst, tok)
+		if len(dst) == text {
+			dst = dst[:n]
+			continue
 		}
-		b.WriteString(text)
 		addSpace = TokenAppendSpace(tok.tokType)
 	}
 
-	result := b.String()
-	if maxLen > 0 && len(result) > maxLen {
-		result = result[:maxLen] + "..."
+	if maxLen > 0 && len(dst)-start > maxLen {
+		dst = append(dst[:start+maxLen], "..."...)
 	}
-	return result
+	return dst
 }
 
 func (s *tokenStore) removeTrailingSemicolon() {
 	if len(s.tokens) > 0 && s.tokens[len(s.tokens)-1].tokType == ';' {
 		s.pop(1)
+		if s.trace != nil {
+			s.trace.reduce(RuleSemicolon, s, false)
+		}
 	}
 }
 
-func (s *tokenStore) tokenToText(tok storedToken) string {
+func (s *tokenStore) appendToken(dst []byte, tok storedToken) []byte {
 	if tok.tokType == TOK_IDENT {
-		return "`" + escapeBackticks(tok.text) + "`"
+		dst = append(dst, '`')
+		dst = appendEscapedBackticks(dst, tok.text)
+		return append(dst, '`')
+	}
+	if tok.text != "" {
+		return append(dst, tok.text...)
 	}
 	text := s.tokenConfig.GetString(tok.tokType)
 	if text == "(unknown)" {
-		return ""
+		return dst
+	}
+	return append(dst, text...)
+}
+
+// DigestToken is one token of a reduced statement: its type, how the
+// digest text spells it, and whether it was lexed inside a versioned
+// comment, or reduced from tokens that were.
+type DigestToken struct {
+	Type      int
+	Text      string
+	Versioned bool
+}
+
+// Tokens returns the reduced tokens in the store. Tokens the digest text
+// leaves out are spelled by their token name.
+func (s *tokenStore) Tokens() []DigestToken {
+	out := make([]DigestToken, len(s.tokens))
+	var buf []byte
+	for i, tok := range s.tokens {
+		buf = s.appendToken(buf[:0], tok)
+		text := string(buf)
+		if text == "" {
+			text = TokenString(tok.tokType)
+		}
+		out[i] = DigestToken{Type: tok.tokType, Text: text, Versioned: tok.versioned}
 	}
-	return text
+	return out
 }
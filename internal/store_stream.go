@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"hash"
+)
+
+// streamFlushAt is how much the stack of a streaming store grows before it
+// hands its final tokens to the running hash and text.
+const streamFlushAt = 64
+
+// tokenStream is the part of a streamed statement that has left the token
+// stack: the hash of its token array and its text.
+type tokenStream struct {
+	hash     hash.Hash
+	text     []byte
+	addSpace bool
+	maxLen   int
+	flushAt  int // stack depth that triggers the next flush
+}
+
+// StartStream makes s hand tokens that no reduction can remove any more to
+// a running hash and text, so that only the reducible tail of the stack is
+// kept. maxLen must match the one later passed to AppendText; text beyond
+// it is not kept.
+func (s *tokenStore) StartStream(maxLen int) {
+	h := sha256.New()
+	if s.version == MySQL57 {
+		h = md5.New()
+	}
+	s.stream = &tokenStream{hash: h, maxLen: maxLen, flushAt: streamFlushAt}
+}
+
+// reducible reports whether a reduction can pop a token of type tok.
+func reducible(tok int) bool {
+	switch tok {
+	case '(', ')', ',', '+', '-', ';', IN_SYM:
+		return true
+	}
+	return isValueOrValueList(tok) || isSingleValueRow(tok) || isMultiValueRow(tok)
+}
+
+// finalTokens returns how many tokens at the bottom of the stack can never
+// be popped: everything below the topmost token no reduction removes. The
+// three tokens the reducer may look back at are kept.
+func (s *tokenStore) finalTokens() int {
+	for i := len(s.tokens) - 1; i >= 0; i-- {
+		if !reducible(s.tokens[i].tokType) {
+			return i - 2
+		}
+	}
+	return 0
+}
+
+// flush moves final tokens, or with all set every token, into the stream.
+func (s *tokenStore) flush(all bool) {
+	st := s.stream
+	n := len(s.tokens)
+	if !all {
+		n = s.finalTokens()
+	}
+	if n <= 0 {
+		// Nothing is final yet; don't rescan the stack on every push.
+		st.flushAt = len(s.tokens) + streamFlushAt
+		return
+	}
+
+	size := 0
+	for _, tok := range s.tokens[:n] {
+		size += 2
+		if tok.tokType == TOK_IDENT {
+			size += 2 + len(tok.text)
+		}
+		if st.maxLen > 0 && len(st.text) > st.maxLen {
+			continue
+		}
+		mark := len(st.text)
+		if st.addSpace {
+			st.text = append(st.text, ' ')
+		}
+		text := len(st.text)
+		st.text = s.appendToken(st.text, tok)
+		if len(st.text) == text {
+			st.text = st.text[:mark]
+			continue
+		}
+		st.addSpace = TokenAppendSpace(tok.tokType)
+	}
+	st.hash.Write(s.tokenArray[:size])
+
+	k := copy(s.tokens, s.tokens[n:])
+	clear(s.tokens[k:])
+	s.tokens = s.tokens[:k]
+	s.tokenArray = s.tokenArray[:copy(s.tokenArray, s.tokenArray[size:])]
+	st.flushAt = len(s.tokens) + streamFlushAt
+}
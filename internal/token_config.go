@@ -2,13 +2,14 @@ package internal
 
 type TokenConfig struct {
 	Version      MySQLVersion
-	Keywords     map[string]int
+	Keywords     *KeywordTable
 	TokenStrings map[int]string
 	HashTokens   map[int]int
 }
 
+// LookupKeyword returns the token for word, in any case, or 0.
 func (c *TokenConfig) LookupKeyword(word string) int {
-	return c.Keywords[word]
+	return c.Keywords.Lookup(word)
 }
 
 func (c *TokenConfig) GetString(tok int) string {
@@ -133,10 +134,23 @@ var mysql90Keywords = map[string]bool{
 	"VERIFY_KEY_CONSTRAINTS": true,
 }
 
-func buildKeywordsFor(version MySQLVersion) map[string]int {
-	keywords := make(map[string]int, len(TokenKeywords))
-	for k, v := range TokenKeywords {
-		keywords[k] = v
+// KeywordsFor returns the keywords version knows and their tokens. It is
+// the source gen/kwtable builds the lexer's keyword tables from.
+func KeywordsFor(version MySQLVersion) map[string]int {
+	keywords := TokenKeywords()
+
+	if version == MySQL57 {
+		all := keywords
+		keywords = make(map[string]int, len(all))
+		for k, v := range all {
+			if mapped, ok := mysql80To57TokenMap[v]; ok && mapped != m57TOK_UNUSED {
+				keywords[k] = v
+			}
+		}
+		for k, v := range mysql57Keywords() {
+			keywords[k] = v
+		}
+		return keywords
 	}
 
 	switch version {
@@ -163,6 +177,32 @@ func buildKeywordsFor(version MySQLVersion) map[string]int {
 	return keywords
 }
 
+// VersionedKeywords returns the words that do not lex to the same token in
+// every version, with their token in the first of 8.0, 8.4, 9.0 and 5.7
+// that knows them.
+func VersionedKeywords() map[string]int {
+	versions := []MySQLVersion{MySQL80, MySQL84, MySQL90, MySQL57}
+	sets := make([]map[string]int, len(versions))
+	for i, v := range versions {
+		sets[i] = KeywordsFor(v)
+	}
+
+	versioned := make(map[string]int)
+	for _, set := range sets {
+		for word, tok := range set {
+			if _, ok := versioned[word]; ok {
+				continue
+			}
+			for _, other := range sets {
+				if other[word] != tok {
+					versioned[word] = tok
+				}
+			}
+		}
+	}
+	return versioned
+}
+
 func buildMySQL80Config() *TokenConfig {
 	tokenStrings := map[int]string{
 		OBSOLETE_TOKEN_550: "MASTER_AUTO_POSITION",
@@ -195,7 +235,7 @@ func buildMySQL80Config() *TokenConfig {
 	}
 	return &TokenConfig{
 		Version:      MySQL80,
-		Keywords:     buildKeywordsFor(MySQL80),
+		Keywords:     keywordTable80,
 		TokenStrings: tokenStrings,
 	}
 }
@@ -203,28 +243,18 @@ func buildMySQL80Config() *TokenConfig {
 func buildMySQL84Config() *TokenConfig {
 	return &TokenConfig{
 		Version:  MySQL84,
-		Keywords: buildKeywordsFor(MySQL84),
+		Keywords: keywordTable84,
 	}
 }
 
 func buildMySQL90Config() *TokenConfig {
 	return &TokenConfig{
 		Version:  MySQL90,
-		Keywords: buildKeywordsFor(MySQL90),
+		Keywords: keywordTable90,
 	}
 }
 
 func buildMySQL57Config() *TokenConfig {
-	keywords := make(map[string]int, len(TokenKeywords)+len(mysql57Keywords))
-	for k, v := range TokenKeywords {
-		if mapped, ok := mysql80To57TokenMap[v]; ok && mapped != m57TOK_UNUSED {
-			keywords[k] = v
-		}
-	}
-	for k, v := range mysql57Keywords {
-		keywords[k] = v
-	}
-
 	tokenStrings := map[int]string{
 		OBSOLETE_TOKEN_271: "ANALYSE",
 		OBSOLETE_TOKEN_388: "DES_KEY_FILE",
@@ -262,7 +292,7 @@ func buildMySQL57Config() *TokenConfig {
 
 	return &TokenConfig{
 		Version:      MySQL57,
-		Keywords:     keywords,
+		Keywords:     keywordTable57,
 		TokenStrings: tokenStrings,
 		HashTokens:   mysql80To57TokenMap,
 	}
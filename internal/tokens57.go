@@ -1,11 +1,8 @@
+// Code generated by tokengen from MySQL sources. DO NOT EDIT.
+
 package internal
 
 // This file maps MySQL 8.0 token IDs to MySQL 5.7 token IDs.
-//
-// MySQL 5.7 token ranges:
-//   Main tokens: 258-909 (652 tokens)
-//   Hint tokens: 910-932 (23 tokens)
-//   Digest tokens: 928-938
 
 const (
 	m57_ABORT_SYM                         = 258
@@ -660,31 +657,29 @@ const (
 	m57_ZEROFILL                          = 907
 	m57_JSON_OBJECTAGG                    = 908
 	m57_JSON_ARRAYAGG                     = 909
-
-	// MySQL 5.7 hint tokens
-	m57_MAX_EXECUTION_TIME_HINT    = 910
-	m57_BKA_HINT                   = 911
-	m57_BNL_HINT                   = 912
-	m57_DUPSWEEDOUT_HINT           = 913
-	m57_FIRSTMATCH_HINT            = 914
-	m57_INTOEXISTS_HINT            = 915
-	m57_LOOSESCAN_HINT             = 916
-	m57_MATERIALIZATION_HINT       = 917
-	m57_NO_BKA_HINT                = 918
-	m57_NO_BNL_HINT                = 919
-	m57_NO_ICP_HINT                = 920
-	m57_NO_MRR_HINT                = 921
-	m57_NO_RANGE_OPTIMIZATION_HINT = 922
-	m57_NO_SEMIJOIN_HINT           = 923
-	m57_MRR_HINT                   = 924
-	m57_QB_NAME_HINT               = 925
-	m57_SEMIJOIN_HINT              = 926
-	m57_SUBQUERY_HINT              = 927
-	m57_HINT_ARG_NUMBER            = 928
-	m57_HINT_ARG_IDENT             = 929
-	m57_HINT_ARG_QB_NAME           = 930
-	m57_HINT_CLOSE                 = 931
-	m57_HINT_ERROR                 = 932
+	m57_MAX_EXECUTION_TIME_HINT           = 910
+	m57_BKA_HINT                          = 911
+	m57_BNL_HINT                          = 912
+	m57_DUPSWEEDOUT_HINT                  = 913
+	m57_FIRSTMATCH_HINT                   = 914
+	m57_INTOEXISTS_HINT                   = 915
+	m57_LOOSESCAN_HINT                    = 916
+	m57_MATERIALIZATION_HINT              = 917
+	m57_NO_BKA_HINT                       = 918
+	m57_NO_BNL_HINT                       = 919
+	m57_NO_ICP_HINT                       = 920
+	m57_NO_MRR_HINT                       = 921
+	m57_NO_RANGE_OPTIMIZATION_HINT        = 922
+	m57_NO_SEMIJOIN_HINT                  = 923
+	m57_MRR_HINT                          = 924
+	m57_QB_NAME_HINT                      = 925
+	m57_SEMIJOIN_HINT                     = 926
+	m57_SUBQUERY_HINT                     = 927
+	m57_HINT_ARG_NUMBER                   = 928
+	m57_HINT_ARG_IDENT                    = 929
+	m57_HINT_ARG_QB_NAME                  = 930
+	m57_HINT_CLOSE                        = 931
+	m57_HINT_ERROR                        = 932
 
 	// MySQL 5.7 digest tokens
 	m57TOK_GENERIC_VALUE           = 928
@@ -702,8 +697,6 @@ const (
 
 // mysql80To57TokenMap maps MySQL 8.0 token IDs to MySQL 5.7 token IDs.
 var mysql80To57TokenMap = map[int]int{
-	// Main SQL tokens (258+)
-	// These are listed in MySQL 8.0 token order with their MySQL 5.7 mappings
 	ABORT_SYM:                           m57_ABORT_SYM,
 	ACCESSIBLE_SYM:                      m57_ACCESSIBLE_SYM,
 	ACCOUNT_SYM:                         m57_ACCOUNT_SYM,
@@ -834,7 +827,7 @@ var mysql80To57TokenMap = map[int]int{
 	DELETE_SYM:                          m57_DELETE_SYM,
 	DESC:                                m57_DESC,
 	DESCRIBE:                            m57_DESCRIBE,
-	OBSOLETE_TOKEN_388:                  m57_DES_KEY_FILE,
+	OBSOLETE_TOKEN_388:                  m57_DES_KEY_FILE, // DES_KEY_FILE removed in 8.0
 	DETERMINISTIC_SYM:                   m57_DETERMINISTIC_SYM,
 	DIAGNOSTICS_SYM:                     m57_DIAGNOSTICS_SYM,
 	DIRECTORY_SYM:                       m57_DIRECTORY_SYM,
@@ -984,7 +977,7 @@ var mysql80To57TokenMap = map[int]int{
 	LIST_SYM:                            m57_LIST_SYM,
 	LOAD:                                m57_LOAD,
 	LOCAL_SYM:                           m57_LOCAL_SYM,
-	OBSOLETE_TOKEN_538:                  m57_LOCATOR_SYM,
+	OBSOLETE_TOKEN_538:                  m57_LOCATOR_SYM, // LOCATOR_SYM removed in 8.0
 	LOCKS_SYM:                           m57_LOCKS_SYM,
 	LOCK_SYM:                            m57_LOCK_SYM,
 	LOGFILE_SYM:                         m57_LOGFILE_SYM,
@@ -996,29 +989,29 @@ var mysql80To57TokenMap = map[int]int{
 	LOOP_SYM:                            m57_LOOP_SYM,
 	LOW_PRIORITY:                        m57_LOW_PRIORITY,
 	LT:                                  m57_LT,
-	OBSOLETE_TOKEN_550:                  m57_MASTER_AUTO_POSITION_SYM,
-	OBSOLETE_TOKEN_551:                  m57_MASTER_BIND_SYM,
-	OBSOLETE_TOKEN_552:                  m57_MASTER_CONNECT_RETRY_SYM,
-	OBSOLETE_TOKEN_553:                  m57_MASTER_DELAY_SYM,
-	OBSOLETE_TOKEN_554:                  m57_MASTER_HOST_SYM,
-	OBSOLETE_TOKEN_555:                  m57_MASTER_LOG_FILE_SYM,
-	OBSOLETE_TOKEN_556:                  m57_MASTER_LOG_POS_SYM,
-	OBSOLETE_TOKEN_557:                  m57_MASTER_PASSWORD_SYM,
-	OBSOLETE_TOKEN_558:                  m57_MASTER_PORT_SYM,
-	OBSOLETE_TOKEN_559:                  m57_MASTER_RETRY_COUNT_SYM,
-	OBSOLETE_TOKEN_561:                  m57_MASTER_SERVER_ID_SYM,
-	OBSOLETE_TOKEN_562:                  m57_MASTER_SSL_SYM,
-	OBSOLETE_TOKEN_563:                  m57_MASTER_SSL_CA_SYM,
-	OBSOLETE_TOKEN_564:                  m57_MASTER_SSL_CAPATH_SYM,
-	OBSOLETE_TOKEN_565:                  m57_MASTER_SSL_CERT_SYM,
-	OBSOLETE_TOKEN_566:                  m57_MASTER_SSL_CIPHER_SYM,
-	OBSOLETE_TOKEN_567:                  m57_MASTER_SSL_CRL_SYM,
-	OBSOLETE_TOKEN_568:                  m57_MASTER_SSL_CRLPATH_SYM,
-	OBSOLETE_TOKEN_569:                  m57_MASTER_SSL_KEY_SYM,
-	OBSOLETE_TOKEN_570:                  m57_MASTER_SSL_VERIFY_SERVER_CERT_SYM,
+	OBSOLETE_TOKEN_550:                  m57_MASTER_AUTO_POSITION_SYM,          // MASTER_AUTO_POSITION_SYM removed in 8.0
+	OBSOLETE_TOKEN_551:                  m57_MASTER_BIND_SYM,                   // MASTER_BIND_SYM removed in 8.0
+	OBSOLETE_TOKEN_552:                  m57_MASTER_CONNECT_RETRY_SYM,          // MASTER_CONNECT_RETRY_SYM removed in 8.0
+	OBSOLETE_TOKEN_553:                  m57_MASTER_DELAY_SYM,                  // MASTER_DELAY_SYM removed in 8.0
+	OBSOLETE_TOKEN_554:                  m57_MASTER_HOST_SYM,                   // MASTER_HOST_SYM removed in 8.0
+	OBSOLETE_TOKEN_555:                  m57_MASTER_LOG_FILE_SYM,               // MASTER_LOG_FILE_SYM removed in 8.0
+	OBSOLETE_TOKEN_556:                  m57_MASTER_LOG_POS_SYM,                // MASTER_LOG_POS_SYM removed in 8.0
+	OBSOLETE_TOKEN_557:                  m57_MASTER_PASSWORD_SYM,               // MASTER_PASSWORD_SYM removed in 8.0
+	OBSOLETE_TOKEN_558:                  m57_MASTER_PORT_SYM,                   // MASTER_PORT_SYM removed in 8.0
+	OBSOLETE_TOKEN_559:                  m57_MASTER_RETRY_COUNT_SYM,            // MASTER_RETRY_COUNT_SYM removed in 8.0
+	OBSOLETE_TOKEN_561:                  m57_MASTER_SERVER_ID_SYM,              // MASTER_SERVER_ID_SYM removed in 8.0
+	OBSOLETE_TOKEN_562:                  m57_MASTER_SSL_SYM,                    // MASTER_SSL_SYM removed in 8.0
+	OBSOLETE_TOKEN_563:                  m57_MASTER_SSL_CA_SYM,                 // MASTER_SSL_CA_SYM removed in 8.0
+	OBSOLETE_TOKEN_564:                  m57_MASTER_SSL_CAPATH_SYM,             // MASTER_SSL_CAPATH_SYM removed in 8.0
+	OBSOLETE_TOKEN_565:                  m57_MASTER_SSL_CERT_SYM,               // MASTER_SSL_CERT_SYM removed in 8.0
+	OBSOLETE_TOKEN_566:                  m57_MASTER_SSL_CIPHER_SYM,             // MASTER_SSL_CIPHER_SYM removed in 8.0
+	OBSOLETE_TOKEN_567:                  m57_MASTER_SSL_CRL_SYM,                // MASTER_SSL_CRL_SYM removed in 8.0
+	OBSOLETE_TOKEN_568:                  m57_MASTER_SSL_CRLPATH_SYM,            // MASTER_SSL_CRLPATH_SYM removed in 8.0
+	OBSOLETE_TOKEN_569:                  m57_MASTER_SSL_KEY_SYM,                // MASTER_SSL_KEY_SYM removed in 8.0
+	OBSOLETE_TOKEN_570:                  m57_MASTER_SSL_VERIFY_SERVER_CERT_SYM, // MASTER_SSL_VERIFY_SERVER_CERT_SYM removed in 8.0
 	MASTER_SYM:                          m57_MASTER_SYM,
-	OBSOLETE_TOKEN_572:                  m57_MASTER_TLS_VERSION_SYM,
-	OBSOLETE_TOKEN_573:                  m57_MASTER_USER_SYM,
+	OBSOLETE_TOKEN_572:                  m57_MASTER_TLS_VERSION_SYM, // MASTER_TLS_VERSION_SYM removed in 8.0
+	OBSOLETE_TOKEN_573:                  m57_MASTER_USER_SYM,        // MASTER_USER_SYM removed in 8.0
 	MATCH:                               m57_MATCH,
 	MAX_CONNECTIONS_PER_HOUR:            m57_MAX_CONNECTIONS_PER_HOUR,
 	MAX_QUERIES_PER_HOUR:                m57_MAX_QUERIES_PER_HOUR,
@@ -1099,7 +1092,7 @@ var mysql80To57TokenMap = map[int]int{
 	PAGE_SYM:                            m57_PAGE_SYM,
 	PARAM_MARKER:                        m57_PARAM_MARKER,
 	PARSER_SYM:                          m57_PARSER_SYM,
-	OBSOLETE_TOKEN_654:                  m57_PARSE_GCOL_EXPR_SYM,
+	OBSOLETE_TOKEN_654:                  m57_PARSE_GCOL_EXPR_SYM, // PARSE_GCOL_EXPR_SYM removed in 8.0
 	PARTIAL:                             m57_PARTIAL,
 	PARTITION_SYM:                       m57_PARTITION_SYM,
 	PARTITIONS_SYM:                      m57_PARTITIONS_SYM,
@@ -1138,7 +1131,7 @@ var mysql80To57TokenMap = map[int]int{
 	REAL_SYM:                            m57_REAL,
 	REBUILD_SYM:                         m57_REBUILD_SYM,
 	RECOVER_SYM:                         m57_RECOVER_SYM,
-	OBSOLETE_TOKEN_693:                  m57_REDOFILE_SYM,
+	OBSOLETE_TOKEN_693:                  m57_REDOFILE_SYM, // REDOFILE_SYM removed in 8.0
 	REDO_BUFFER_SIZE_SYM:                m57_REDO_BUFFER_SIZE_SYM,
 	REDUNDANT_SYM:                       m57_REDUNDANT_SYM,
 	REFERENCES:                          m57_REFERENCES,
@@ -1200,7 +1193,7 @@ var mysql80To57TokenMap = map[int]int{
 	SERIAL_SYM:                          m57_SERIAL_SYM,
 	SESSION_SYM:                         m57_SESSION_SYM,
 	SERVER_SYM:                          m57_SERVER_SYM,
-	OBSOLETE_TOKEN_755:                  m57_SERVER_OPTIONS,
+	OBSOLETE_TOKEN_755:                  m57_SERVER_OPTIONS, // SERVER_OPTIONS removed in 8.0
 	SET_SYM:                             m57_SET,
 	SET_VAR:                             m57_SET_VAR,
 	SHARE_SYM:                           m57_SHARE_SYM,
@@ -1229,7 +1222,7 @@ var mysql80To57TokenMap = map[int]int{
 	SQL_BEFORE_GTIDS:                    m57_SQL_BEFORE_GTIDS,
 	SQL_BIG_RESULT:                      m57_SQL_BIG_RESULT,
 	SQL_BUFFER_RESULT:                   m57_SQL_BUFFER_RESULT,
-	OBSOLETE_TOKEN_784:                  m57_SQL_CACHE_SYM,
+	OBSOLETE_TOKEN_784:                  m57_SQL_CACHE_SYM, // SQL_CACHE_SYM removed in 8.0
 	SQL_CALC_FOUND_ROWS:                 m57_SQL_CALC_FOUND_ROWS,
 	SQL_NO_CACHE_SYM:                    m57_SQL_NO_CACHE_SYM,
 	SQL_SMALL_RESULT:                    m57_SQL_SMALL_RESULT,
@@ -1265,7 +1258,7 @@ var mysql80To57TokenMap = map[int]int{
 	SYSDATE:                             m57_SYSDATE,
 	TABLES:                              m57_TABLES,
 	TABLESPACE_SYM:                      m57_TABLESPACE_SYM,
-	OBSOLETE_TOKEN_820:                  m57_TABLE_REF_PRIORITY,
+	OBSOLETE_TOKEN_820:                  m57_TABLE_REF_PRIORITY, // TABLE_REF_PRIORITY removed in 8.0
 	TABLE_SYM:                           m57_TABLE_SYM,
 	TABLE_CHECKSUM_SYM:                  m57_TABLE_CHECKSUM_SYM,
 	TABLE_NAME_SYM:                      m57_TABLE_NAME_SYM,
@@ -1293,7 +1286,7 @@ var mysql80To57TokenMap = map[int]int{
 	TRUNCATE_SYM:                        m57_TRUNCATE_SYM,
 	TYPES_SYM:                           m57_TYPES_SYM,
 	TYPE_SYM:                            m57_TYPE_SYM,
-	OBSOLETE_TOKEN_848:                  m57_UDF_RETURNS_SYM,
+	OBSOLETE_TOKEN_848:                  m57_UDF_RETURNS_SYM, // UDF_RETURNS_SYM removed in 8.0
 	ULONGLONG_NUM:                       m57_ULONGLONG_NUM,
 	UNCOMMITTED_SYM:                     m57_UNCOMMITTED_SYM,
 	UNDEFINED_SYM:                       m57_UNDEFINED_SYM,
@@ -1338,7 +1331,7 @@ var mysql80To57TokenMap = map[int]int{
 	WHERE:                               m57_WHERE,
 	WHILE_SYM:                           m57_WHILE_SYM,
 	WITH:                                m57_WITH,
-	OBSOLETE_TOKEN_893:                  m57_WITH_CUBE_SYM,
+	OBSOLETE_TOKEN_893:                  m57_WITH_CUBE_SYM, // WITH_CUBE_SYM removed in 8.0
 	WITH_ROLLUP_SYM:                     m57_WITH_ROLLUP_SYM,
 	WITHOUT_SYM:                         m57_WITHOUT_SYM,
 	WORK_SYM:                            m57_WORK_SYM,
@@ -1353,100 +1346,100 @@ var mysql80To57TokenMap = map[int]int{
 	YEAR_SYM:                            m57_YEAR_SYM,
 	ZEROFILL_SYM:                        m57_ZEROFILL,
 	JSON_UNQUOTED_SEPARATOR_SYM:         m57_JSON_UNQUOTED_SEPARATOR_SYM,
-	PERSIST_SYM:                         m57TOK_UNUSED, // New in MySQL 8.0
-	ROLE_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	ADMIN_SYM:                           m57TOK_UNUSED, // New in MySQL 8.0
-	INVISIBLE_SYM:                       m57TOK_UNUSED, // New in MySQL 8.0
-	VISIBLE_SYM:                         m57TOK_UNUSED, // New in MySQL 8.0
-	EXCEPT_SYM:                          m57TOK_UNUSED, // New in MySQL 8.0
-	COMPONENT_SYM:                       m57TOK_UNUSED, // New in MySQL 8.0
-	RECURSIVE_SYM:                       m57TOK_UNUSED, // New in MySQL 8.0
-	GRAMMAR_SELECTOR_EXPR:               m57TOK_UNUSED, // New in MySQL 8.0
-	GRAMMAR_SELECTOR_GCOL:               m57TOK_UNUSED, // New in MySQL 8.0
-	GRAMMAR_SELECTOR_PART:               m57TOK_UNUSED, // New in MySQL 8.0
-	GRAMMAR_SELECTOR_CTE:                m57TOK_UNUSED, // New in MySQL 8.0
+	PERSIST_SYM:                         m57TOK_UNUSED, // Not in MySQL 5.7
+	ROLE_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	ADMIN_SYM:                           m57TOK_UNUSED, // Not in MySQL 5.7
+	INVISIBLE_SYM:                       m57TOK_UNUSED, // Not in MySQL 5.7
+	VISIBLE_SYM:                         m57TOK_UNUSED, // Not in MySQL 5.7
+	EXCEPT_SYM:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	COMPONENT_SYM:                       m57TOK_UNUSED, // Not in MySQL 5.7
+	RECURSIVE_SYM:                       m57TOK_UNUSED, // Not in MySQL 5.7
+	GRAMMAR_SELECTOR_EXPR:               m57TOK_UNUSED, // Not in MySQL 5.7
+	GRAMMAR_SELECTOR_GCOL:               m57TOK_UNUSED, // Not in MySQL 5.7
+	GRAMMAR_SELECTOR_PART:               m57TOK_UNUSED, // Not in MySQL 5.7
+	GRAMMAR_SELECTOR_CTE:                m57TOK_UNUSED, // Not in MySQL 5.7
 	JSON_OBJECTAGG:                      m57_JSON_OBJECTAGG,
 	JSON_ARRAYAGG:                       m57_JSON_ARRAYAGG,
-	OF_SYM:                              m57TOK_UNUSED, // New in MySQL 8.0
-	SKIP_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	LOCKED_SYM:                          m57TOK_UNUSED, // New in MySQL 8.0
-	NOWAIT_SYM:                          m57TOK_UNUSED, // New in MySQL 8.0
-	GROUPING_SYM:                        m57TOK_UNUSED, // New in MySQL 8.0
-	PERSIST_ONLY_SYM:                    m57TOK_UNUSED, // New in MySQL 8.0
-	HISTOGRAM_SYM:                       m57TOK_UNUSED, // New in MySQL 8.0
-	BUCKETS_SYM:                         m57TOK_UNUSED, // New in MySQL 8.0
-	OBSOLETE_TOKEN_930:                  m57TOK_UNUSED, // New in MySQL 8.0
-	CLONE_SYM:                           m57TOK_UNUSED, // New in MySQL 8.0
-	CUME_DIST_SYM:                       m57TOK_UNUSED, // New in MySQL 8.0
-	DENSE_RANK_SYM:                      m57TOK_UNUSED, // New in MySQL 8.0
-	EXCLUDE_SYM:                         m57TOK_UNUSED, // New in MySQL 8.0
-	FIRST_VALUE_SYM:                     m57TOK_UNUSED, // New in MySQL 8.0
-	FOLLOWING_SYM:                       m57TOK_UNUSED, // New in MySQL 8.0
-	GROUPS_SYM:                          m57TOK_UNUSED, // New in MySQL 8.0
-	LAG_SYM:                             m57TOK_UNUSED, // New in MySQL 8.0
-	LAST_VALUE_SYM:                      m57TOK_UNUSED, // New in MySQL 8.0
-	LEAD_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	NTH_VALUE_SYM:                       m57TOK_UNUSED, // New in MySQL 8.0
-	NTILE_SYM:                           m57TOK_UNUSED, // New in MySQL 8.0
-	NULLS_SYM:                           m57TOK_UNUSED, // New in MySQL 8.0
-	OTHERS_SYM:                          m57TOK_UNUSED, // New in MySQL 8.0
-	OVER_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	PERCENT_RANK_SYM:                    m57TOK_UNUSED, // New in MySQL 8.0
-	PRECEDING_SYM:                       m57TOK_UNUSED, // New in MySQL 8.0
-	RANK_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	RESPECT_SYM:                         m57TOK_UNUSED, // New in MySQL 8.0
-	ROW_NUMBER_SYM:                      m57TOK_UNUSED, // New in MySQL 8.0
-	TIES_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	UNBOUNDED_SYM:                       m57TOK_UNUSED, // New in MySQL 8.0
-	WINDOW_SYM:                          m57TOK_UNUSED, // New in MySQL 8.0
-	EMPTY_SYM:                           m57TOK_UNUSED, // New in MySQL 8.0
-	JSON_TABLE_SYM:                      m57TOK_UNUSED, // New in MySQL 8.0
-	NESTED_SYM:                          m57TOK_UNUSED, // New in MySQL 8.0
-	ORDINALITY_SYM:                      m57TOK_UNUSED, // New in MySQL 8.0
-	PATH_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	HISTORY_SYM:                         m57TOK_UNUSED, // New in MySQL 8.0
-	REUSE_SYM:                           m57TOK_UNUSED, // New in MySQL 8.0
-	SRID_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	THREAD_PRIORITY_SYM:                 m57TOK_UNUSED, // New in MySQL 8.0
-	RESOURCE_SYM:                        m57TOK_UNUSED, // New in MySQL 8.0
-	SYSTEM_SYM:                          m57TOK_UNUSED, // New in MySQL 8.0
-	VCPU_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	OBSOLETE_TOKEN_966:                  m57TOK_UNUSED, // New in MySQL 8.0
-	OBSOLETE_TOKEN_967:                  m57TOK_UNUSED, // New in MySQL 8.0
-	RESTART_SYM:                         m57TOK_UNUSED, // New in MySQL 8.0
-	DEFINITION_SYM:                      m57TOK_UNUSED, // New in MySQL 8.0
-	DESCRIPTION_SYM:                     m57TOK_UNUSED, // New in MySQL 8.0
-	ORGANIZATION_SYM:                    m57TOK_UNUSED, // New in MySQL 8.0
-	REFERENCE_SYM:                       m57TOK_UNUSED, // New in MySQL 8.0
-	ACTIVE_SYM:                          m57TOK_UNUSED, // New in MySQL 8.0
-	INACTIVE_SYM:                        m57TOK_UNUSED, // New in MySQL 8.0
-	LATERAL_SYM:                         m57TOK_UNUSED, // New in MySQL 8.0
-	ARRAY_SYM:                           m57TOK_UNUSED, // New in MySQL 8.0
-	MEMBER_SYM:                          m57TOK_UNUSED, // New in MySQL 8.0
-	OPTIONAL_SYM:                        m57TOK_UNUSED, // New in MySQL 8.0
-	SECONDARY_SYM:                       m57TOK_UNUSED, // New in MySQL 8.0
-	SECONDARY_ENGINE_SYM:                m57TOK_UNUSED, // New in MySQL 8.0
-	SECONDARY_LOAD_SYM:                  m57TOK_UNUSED, // New in MySQL 8.0
-	SECONDARY_UNLOAD_SYM:                m57TOK_UNUSED, // New in MySQL 8.0
-	RETAIN_SYM:                          m57TOK_UNUSED, // New in MySQL 8.0
-	OLD_SYM:                             m57TOK_UNUSED, // New in MySQL 8.0
-	ENFORCED_SYM:                        m57TOK_UNUSED, // New in MySQL 8.0
-	OJ_SYM:                              m57TOK_UNUSED, // New in MySQL 8.0
-	NETWORK_NAMESPACE_SYM:               m57TOK_UNUSED, // New in MySQL 8.0
-	RANDOM_SYM:                          m57TOK_UNUSED, // New in MySQL 8.0
-	OBSOLETE_TOKEN_989:                  m57TOK_UNUSED, // New in MySQL 8.0
-	OBSOLETE_TOKEN_990:                  m57TOK_UNUSED, // New in MySQL 8.0
-	PRIVILEGE_CHECKS_USER_SYM:           m57TOK_UNUSED, // New in MySQL 8.0
-	OBSOLETE_TOKEN_992:                  m57TOK_UNUSED, // New in MySQL 8.0
-	REQUIRE_ROW_FORMAT_SYM:              m57TOK_UNUSED, // New in MySQL 8.0
-	PASSWORD_LOCK_TIME_SYM:              m57TOK_UNUSED, // New in MySQL 8.0
-	FAILED_LOGIN_ATTEMPTS_SYM:           m57TOK_UNUSED, // New in MySQL 8.0
-	REQUIRE_TABLE_PRIMARY_KEY_CHECK_SYM: m57TOK_UNUSED, // New in MySQL 8.0
-	STREAM_SYM:                          m57TOK_UNUSED, // New in MySQL 8.0
-	OFF_SYM:                             m57TOK_UNUSED, // New in MySQL 8.0
-	RETURNING_SYM:                       m57TOK_UNUSED, // New in MySQL 8.0
+	OF_SYM:                              m57TOK_UNUSED, // Not in MySQL 5.7
+	SKIP_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	LOCKED_SYM:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	NOWAIT_SYM:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	GROUPING_SYM:                        m57TOK_UNUSED, // Not in MySQL 5.7
+	PERSIST_ONLY_SYM:                    m57TOK_UNUSED, // Not in MySQL 5.7
+	HISTOGRAM_SYM:                       m57TOK_UNUSED, // Not in MySQL 5.7
+	BUCKETS_SYM:                         m57TOK_UNUSED, // Not in MySQL 5.7
+	OBSOLETE_TOKEN_930:                  m57TOK_UNUSED, // Not in MySQL 5.7
+	CLONE_SYM:                           m57TOK_UNUSED, // Not in MySQL 5.7
+	CUME_DIST_SYM:                       m57TOK_UNUSED, // Not in MySQL 5.7
+	DENSE_RANK_SYM:                      m57TOK_UNUSED, // Not in MySQL 5.7
+	EXCLUDE_SYM:                         m57TOK_UNUSED, // Not in MySQL 5.7
+	FIRST_VALUE_SYM:                     m57TOK_UNUSED, // Not in MySQL 5.7
+	FOLLOWING_SYM:                       m57TOK_UNUSED, // Not in MySQL 5.7
+	GROUPS_SYM:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	LAG_SYM:                             m57TOK_UNUSED, // Not in MySQL 5.7
+	LAST_VALUE_SYM:                      m57TOK_UNUSED, // Not in MySQL 5.7
+	LEAD_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	NTH_VALUE_SYM:                       m57TOK_UNUSED, // Not in MySQL 5.7
+	NTILE_SYM:                           m57TOK_UNUSED, // Not in MySQL 5.7
+	NULLS_SYM:                           m57TOK_UNUSED, // Not in MySQL 5.7
+	OTHERS_SYM:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	OVER_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	PERCENT_RANK_SYM:                    m57TOK_UNUSED, // Not in MySQL 5.7
+	PRECEDING_SYM:                       m57TOK_UNUSED, // Not in MySQL 5.7
+	RANK_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	RESPECT_SYM:                         m57TOK_UNUSED, // Not in MySQL 5.7
+	ROW_NUMBER_SYM:                      m57TOK_UNUSED, // Not in MySQL 5.7
+	TIES_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	UNBOUNDED_SYM:                       m57TOK_UNUSED, // Not in MySQL 5.7
+	WINDOW_SYM:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	EMPTY_SYM:                           m57TOK_UNUSED, // Not in MySQL 5.7
+	JSON_TABLE_SYM:                      m57TOK_UNUSED, // Not in MySQL 5.7
+	NESTED_SYM:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	ORDINALITY_SYM:                      m57TOK_UNUSED, // Not in MySQL 5.7
+	PATH_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	HISTORY_SYM:                         m57TOK_UNUSED, // Not in MySQL 5.7
+	REUSE_SYM:                           m57TOK_UNUSED, // Not in MySQL 5.7
+	SRID_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	THREAD_PRIORITY_SYM:                 m57TOK_UNUSED, // Not in MySQL 5.7
+	RESOURCE_SYM:                        m57TOK_UNUSED, // Not in MySQL 5.7
+	SYSTEM_SYM:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	VCPU_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	OBSOLETE_TOKEN_966:                  m57TOK_UNUSED, // Not in MySQL 5.7
+	OBSOLETE_TOKEN_967:                  m57TOK_UNUSED, // Not in MySQL 5.7
+	RESTART_SYM:                         m57TOK_UNUSED, // Not in MySQL 5.7
+	DEFINITION_SYM:                      m57TOK_UNUSED, // Not in MySQL 5.7
+	DESCRIPTION_SYM:                     m57TOK_UNUSED, // Not in MySQL 5.7
+	ORGANIZATION_SYM:                    m57TOK_UNUSED, // Not in MySQL 5.7
+	REFERENCE_SYM:                       m57TOK_UNUSED, // Not in MySQL 5.7
+	ACTIVE_SYM:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	INACTIVE_SYM:                        m57TOK_UNUSED, // Not in MySQL 5.7
+	LATERAL_SYM:                         m57TOK_UNUSED, // Not in MySQL 5.7
+	ARRAY_SYM:                           m57TOK_UNUSED, // Not in MySQL 5.7
+	MEMBER_SYM:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	OPTIONAL_SYM:                        m57TOK_UNUSED, // Not in MySQL 5.7
+	SECONDARY_SYM:                       m57TOK_UNUSED, // Not in MySQL 5.7
+	SECONDARY_ENGINE_SYM:                m57TOK_UNUSED, // Not in MySQL 5.7
+	SECONDARY_LOAD_SYM:                  m57TOK_UNUSED, // Not in MySQL 5.7
+	SECONDARY_UNLOAD_SYM:                m57TOK_UNUSED, // Not in MySQL 5.7
+	RETAIN_SYM:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	OLD_SYM:                             m57TOK_UNUSED, // Not in MySQL 5.7
+	ENFORCED_SYM:                        m57TOK_UNUSED, // Not in MySQL 5.7
+	OJ_SYM:                              m57TOK_UNUSED, // Not in MySQL 5.7
+	NETWORK_NAMESPACE_SYM:               m57TOK_UNUSED, // Not in MySQL 5.7
+	RANDOM_SYM:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	OBSOLETE_TOKEN_989:                  m57TOK_UNUSED, // Not in MySQL 5.7
+	OBSOLETE_TOKEN_990:                  m57TOK_UNUSED, // Not in MySQL 5.7
+	PRIVILEGE_CHECKS_USER_SYM:           m57TOK_UNUSED, // Not in MySQL 5.7
+	OBSOLETE_TOKEN_992:                  m57TOK_UNUSED, // Not in MySQL 5.7
+	REQUIRE_ROW_FORMAT_SYM:              m57TOK_UNUSED, // Not in MySQL 5.7
+	PASSWORD_LOCK_TIME_SYM:              m57TOK_UNUSED, // Not in MySQL 5.7
+	FAILED_LOGIN_ATTEMPTS_SYM:           m57TOK_UNUSED, // Not in MySQL 5.7
+	REQUIRE_TABLE_PRIMARY_KEY_CHECK_SYM: m57TOK_UNUSED, // Not in MySQL 5.7
+	STREAM_SYM:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	OFF_SYM:                             m57TOK_UNUSED, // Not in MySQL 5.7
+	RETURNING_SYM:                       m57TOK_UNUSED, // Not in MySQL 5.7
 	MAX_EXECUTION_TIME_HINT:             m57_MAX_EXECUTION_TIME_HINT,
-	RESOURCE_GROUP_HINT:                 m57TOK_UNUSED, // New in MySQL 8.0
+	RESOURCE_GROUP_HINT:                 m57TOK_UNUSED, // Not in MySQL 5.7
 	BKA_HINT:                            m57_BKA_HINT,
 	BNL_HINT:                            m57_BNL_HINT,
 	DUPSWEEDOUT_HINT:                    m57_DUPSWEEDOUT_HINT,
@@ -1464,37 +1457,37 @@ var mysql80To57TokenMap = map[int]int{
 	QB_NAME_HINT:                        m57_QB_NAME_HINT,
 	SEMIJOIN_HINT:                       m57_SEMIJOIN_HINT,
 	SUBQUERY_HINT:                       m57_SUBQUERY_HINT,
-	DERIVED_MERGE_HINT:                  m57TOK_UNUSED, // New in MySQL 8.0
-	NO_DERIVED_MERGE_HINT:               m57TOK_UNUSED, // New in MySQL 8.0
-	JOIN_PREFIX_HINT:                    m57TOK_UNUSED, // New in MySQL 8.0
-	JOIN_SUFFIX_HINT:                    m57TOK_UNUSED, // New in MySQL 8.0
-	JOIN_ORDER_HINT:                     m57TOK_UNUSED, // New in MySQL 8.0
-	JOIN_FIXED_ORDER_HINT:               m57TOK_UNUSED, // New in MySQL 8.0
-	INDEX_MERGE_HINT:                    m57TOK_UNUSED, // New in MySQL 8.0
-	NO_INDEX_MERGE_HINT:                 m57TOK_UNUSED, // New in MySQL 8.0
-	SET_VAR_HINT:                        m57TOK_UNUSED, // New in MySQL 8.0
-	SKIP_SCAN_HINT:                      m57TOK_UNUSED, // New in MySQL 8.0
-	NO_SKIP_SCAN_HINT:                   m57TOK_UNUSED, // New in MySQL 8.0
-	HASH_JOIN_HINT:                      m57TOK_UNUSED, // New in MySQL 8.0
-	NO_HASH_JOIN_HINT:                   m57TOK_UNUSED, // New in MySQL 8.0
+	DERIVED_MERGE_HINT:                  m57TOK_UNUSED, // Not in MySQL 5.7
+	NO_DERIVED_MERGE_HINT:               m57TOK_UNUSED, // Not in MySQL 5.7
+	JOIN_PREFIX_HINT:                    m57TOK_UNUSED, // Not in MySQL 5.7
+	JOIN_SUFFIX_HINT:                    m57TOK_UNUSED, // Not in MySQL 5.7
+	JOIN_ORDER_HINT:                     m57TOK_UNUSED, // Not in MySQL 5.7
+	JOIN_FIXED_ORDER_HINT:               m57TOK_UNUSED, // Not in MySQL 5.7
+	INDEX_MERGE_HINT:                    m57TOK_UNUSED, // Not in MySQL 5.7
+	NO_INDEX_MERGE_HINT:                 m57TOK_UNUSED, // Not in MySQL 5.7
+	SET_VAR_HINT:                        m57TOK_UNUSED, // Not in MySQL 5.7
+	SKIP_SCAN_HINT:                      m57TOK_UNUSED, // Not in MySQL 5.7
+	NO_SKIP_SCAN_HINT:                   m57TOK_UNUSED, // Not in MySQL 5.7
+	HASH_JOIN_HINT:                      m57TOK_UNUSED, // Not in MySQL 5.7
+	NO_HASH_JOIN_HINT:                   m57TOK_UNUSED, // Not in MySQL 5.7
 	HINT_ARG_NUMBER:                     m57_HINT_ARG_NUMBER,
 	HINT_ARG_IDENT:                      m57_HINT_ARG_IDENT,
 	HINT_ARG_QB_NAME:                    m57_HINT_ARG_QB_NAME,
-	HINT_ARG_TEXT:                       m57TOK_UNUSED, // New in MySQL 8.0
-	HINT_IDENT_OR_NUMBER_WITH_SCALE:     m57TOK_UNUSED, // New in MySQL 8.0
+	HINT_ARG_TEXT:                       m57TOK_UNUSED, // Not in MySQL 5.7
+	HINT_IDENT_OR_NUMBER_WITH_SCALE:     m57TOK_UNUSED, // Not in MySQL 5.7
 	HINT_CLOSE:                          m57_HINT_CLOSE,
-	HINT_ERROR:                          m57TOK_UNUSED, // New in MySQL 8.0
-	INDEX_HINT:                          m57TOK_UNUSED, // New in MySQL 8.0
-	NO_INDEX_HINT:                       m57TOK_UNUSED, // New in MySQL 8.0
-	JOIN_INDEX_HINT:                     m57TOK_UNUSED, // New in MySQL 8.0
-	NO_JOIN_INDEX_HINT:                  m57TOK_UNUSED, // New in MySQL 8.0
-	GROUP_INDEX_HINT:                    m57TOK_UNUSED, // New in MySQL 8.0
-	NO_GROUP_INDEX_HINT:                 m57TOK_UNUSED, // New in MySQL 8.0
-	ORDER_INDEX_HINT:                    m57TOK_UNUSED, // New in MySQL 8.0
-	NO_ORDER_INDEX_HINT:                 m57TOK_UNUSED, // New in MySQL 8.0
-	DERIVED_CONDITION_PUSHDOWN_HINT:     m57TOK_UNUSED, // New in MySQL 8.0
-	NO_DERIVED_CONDITION_PUSHDOWN_HINT:  m57TOK_UNUSED, // New in MySQL 8.0
-	HINT_ARG_FLOATING_POINT_NUMBER:      m57TOK_UNUSED, // New in MySQL 8.0
+	HINT_ERROR:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	INDEX_HINT:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	NO_INDEX_HINT:                       m57TOK_UNUSED, // Not in MySQL 5.7
+	JOIN_INDEX_HINT:                     m57TOK_UNUSED, // Not in MySQL 5.7
+	NO_JOIN_INDEX_HINT:                  m57TOK_UNUSED, // Not in MySQL 5.7
+	GROUP_INDEX_HINT:                    m57TOK_UNUSED, // Not in MySQL 5.7
+	NO_GROUP_INDEX_HINT:                 m57TOK_UNUSED, // Not in MySQL 5.7
+	ORDER_INDEX_HINT:                    m57TOK_UNUSED, // Not in MySQL 5.7
+	NO_ORDER_INDEX_HINT:                 m57TOK_UNUSED, // Not in MySQL 5.7
+	DERIVED_CONDITION_PUSHDOWN_HINT:     m57TOK_UNUSED, // Not in MySQL 5.7
+	NO_DERIVED_CONDITION_PUSHDOWN_HINT:  m57TOK_UNUSED, // Not in MySQL 5.7
+	HINT_ARG_FLOATING_POINT_NUMBER:      m57TOK_UNUSED, // Not in MySQL 5.7
 	TOK_GENERIC_VALUE:                   m57TOK_GENERIC_VALUE,
 	TOK_GENERIC_VALUE_LIST:              m57TOK_GENERIC_VALUE_LIST,
 	TOK_ROW_SINGLE_VALUE:                m57TOK_ROW_SINGLE_VALUE,
@@ -1505,102 +1498,102 @@ var mysql80To57TokenMap = map[int]int{
 	TOK_IDENT_AT:                        m57TOK_IDENT_AT,
 	TOK_HINT_COMMENT_OPEN:               m57TOK_HINT_COMMENT_OPEN,
 	TOK_HINT_COMMENT_CLOSE:              m57TOK_HINT_COMMENT_CLOSE,
-	TOK_IN_GENERIC_VALUE_EXPRESSION:     m57TOK_UNUSED, // New in MySQL 8.0
-	TOK_BY_NUMERIC_COLUMN:               m57TOK_UNUSED, // New in MySQL 8.0
-	TOK_UNUSED:                          m57TOK_UNUSED, // New digest token in 8.0
-	MY_SQL_PARSER_UNDEF:                 m57TOK_UNUSED, // New in MySQL 8.0
-	JSON_VALUE_SYM:                      m57TOK_UNUSED, // New in MySQL 8.0
-	TLS_SYM:                             m57TOK_UNUSED, // New in MySQL 8.0
-	ATTRIBUTE_SYM:                       m57TOK_UNUSED, // New in MySQL 8.0
-	ENGINE_ATTRIBUTE_SYM:                m57TOK_UNUSED, // New in MySQL 8.0
-	SECONDARY_ENGINE_ATTRIBUTE_SYM:      m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_CONNECTION_AUTO_FAILOVER_SYM: m57TOK_UNUSED, // New in MySQL 8.0
-	ZONE_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	GRAMMAR_SELECTOR_DERIVED_EXPR:       m57TOK_UNUSED, // New in MySQL 8.0
-	REPLICA_SYM:                         m57TOK_UNUSED, // New in MySQL 8.0
-	REPLICAS_SYM:                        m57TOK_UNUSED, // New in MySQL 8.0
-	ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_SYM: m57TOK_UNUSED, // New in MySQL 8.0
-	GET_SOURCE_PUBLIC_KEY_SYM:                  m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_AUTO_POSITION_SYM:                   m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_BIND_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_COMPRESSION_ALGORITHM_SYM:           m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_CONNECT_RETRY_SYM:                   m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_DELAY_SYM:                           m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_HEARTBEAT_PERIOD_SYM:                m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_HOST_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_LOG_FILE_SYM:                        m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_LOG_POS_SYM:                         m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_PASSWORD_SYM:                        m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_PORT_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_PUBLIC_KEY_PATH_SYM:                 m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_RETRY_COUNT_SYM:                     m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_SSL_SYM:                             m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_SSL_CA_SYM:                          m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_SSL_CAPATH_SYM:                      m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_SSL_CERT_SYM:                        m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_SSL_CIPHER_SYM:                      m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_SSL_CRL_SYM:                         m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_SSL_CRLPATH_SYM:                     m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_SSL_KEY_SYM:                         m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_SSL_VERIFY_SERVER_CERT_SYM:          m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_TLS_CIPHERSUITES_SYM:                m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_TLS_VERSION_SYM:                     m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_USER_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	SOURCE_ZSTD_COMPRESSION_LEVEL_SYM:          m57TOK_UNUSED, // New in MySQL 8.0
-	ST_COLLECT_SYM:                             m57TOK_UNUSED, // New in MySQL 8.0
-	KEYRING_SYM:                                m57TOK_UNUSED, // New in MySQL 8.0
-	AUTHENTICATION_SYM:                         m57TOK_UNUSED, // New in MySQL 8.0
-	FACTOR_SYM:                                 m57TOK_UNUSED, // New in MySQL 8.0
-	FINISH_SYM:                                 m57TOK_UNUSED, // New in MySQL 8.0
-	INITIATE_SYM:                               m57TOK_UNUSED, // New in MySQL 8.0
-	REGISTRATION_SYM:                           m57TOK_UNUSED, // New in MySQL 8.0
-	UNREGISTER_SYM:                             m57TOK_UNUSED, // New in MySQL 8.0
-	INITIAL_SYM:                                m57TOK_UNUSED, // New in MySQL 8.0
-	CHALLENGE_RESPONSE_SYM:                     m57TOK_UNUSED, // New in MySQL 8.0
-	GTID_ONLY_SYM:                              m57TOK_UNUSED, // New in MySQL 8.0
-	INTERSECT_SYM:                              m57TOK_UNUSED, // New in MySQL 8.0
-	BULK_SYM:                                   m57TOK_UNUSED, // New in MySQL 8.0
-	URL_SYM:                                    m57TOK_UNUSED, // New in MySQL 8.0
-	GENERATE_SYM:                               m57TOK_UNUSED, // New in MySQL 8.0
-	DOLLAR_QUOTED_STRING_SYM:                   m57TOK_UNUSED, // New in MySQL 8.0
-	PARSE_TREE_SYM:                             m57TOK_UNUSED, // New in MySQL 8.0
-	LOG_SYM:                                    m57TOK_UNUSED, // New in MySQL 8.0
-	GTIDS_SYM:                                  m57TOK_UNUSED, // New in MySQL 8.0
-	PARALLEL_SYM:                               m57TOK_UNUSED, // New in MySQL 8.0
-	S3_SYM:                                     m57TOK_UNUSED, // New in MySQL 8.0
-	QUALIFY_SYM:                                m57TOK_UNUSED, // New in MySQL 8.0
-	AUTO_SYM:                                   m57TOK_UNUSED, // New in MySQL 8.0
-	MANUAL_SYM:                                 m57TOK_UNUSED, // New in MySQL 8.0
-	BERNOULLI_SYM:                              m57TOK_UNUSED, // New in MySQL 8.0
-	TABLESAMPLE_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	VECTOR_SYM:                                 m57TOK_UNUSED, // New in MySQL 8.0
-	PARAMETERS_SYM:                             m57TOK_UNUSED, // New in MySQL 8.0
-	HEADER_SYM:                                 m57TOK_UNUSED, // New in MySQL 8.0
-	LIBRARY_SYM:                                m57TOK_UNUSED, // New in MySQL 8.0
-	URI_SYM:                                    m57TOK_UNUSED, // New in MySQL 8.0
-	DUALITY_SYM:                                m57TOK_UNUSED, // New in MySQL 8.0
-	RELATIONAL_SYM:                             m57TOK_UNUSED, // New in MySQL 8.0
-	JSON_DUALITY_OBJECT_SYM:                    m57TOK_UNUSED, // New in MySQL 8.0
-	ABSENT_SYM:                                 m57TOK_UNUSED, // New in MySQL 8.0
-	FILE_FORMAT_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	FILES_SYM:                                  m57TOK_UNUSED, // New in MySQL 8.0
-	FILE_NAME_SYM:                              m57TOK_UNUSED, // New in MySQL 8.0
-	FILE_PATTERN_SYM:                           m57TOK_UNUSED, // New in MySQL 8.0
-	FILE_PREFIX_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	ALLOW_MISSING_FILES_SYM:                    m57TOK_UNUSED, // New in MySQL 8.0
-	AUTO_REFRESH_SYM:                           m57TOK_UNUSED, // New in MySQL 8.0
-	AUTO_REFRESH_SOURCE_SYM:                    m57TOK_UNUSED, // New in MySQL 8.0
-	VERIFY_KEY_CONSTRAINTS_SYM:                 m57TOK_UNUSED, // New in MySQL 8.0
-	STRICT_LOAD_SYM:                            m57TOK_UNUSED, // New in MySQL 8.0
-	EXTERNAL_FORMAT_SYM:                        m57TOK_UNUSED, // New in MySQL 8.0
-	EXTERNAL_SYM:                               m57TOK_UNUSED, // New in MySQL 8.0
-	MATERIALIZED_SYM:                           m57TOK_UNUSED, // New in MySQL 8.0
-	GUIDED_SYM:                                 m57TOK_UNUSED, // New in MySQL 8.0
-	SETS_SYM:                                   m57TOK_UNUSED, // New in MySQL 9.0
-	VALIDATE_SYM:                               m57TOK_UNUSED, // New in MySQL 9.0
-	KEYWORD_USED_AS_IDENT:                      m57TOK_UNUSED, // New in MySQL 8.0
-	KEYWORD_USED_AS_KEYWORD:                    m57TOK_UNUSED, // New in MySQL 8.0
-	CONDITIONLESS_JOIN:                         m57TOK_UNUSED, // New in MySQL 8.0
-	PREFER_PARENTHESES:                         m57TOK_UNUSED, // New in MySQL 8.0
-	EMPTY_FROM_CLAUSE:                          m57TOK_UNUSED, // New in MySQL 8.0
+	TOK_IN_GENERIC_VALUE_EXPRESSION:     m57TOK_UNUSED, // Not in MySQL 5.7
+	TOK_BY_NUMERIC_COLUMN:               m57TOK_UNUSED, // Not in MySQL 5.7
+	TOK_UNUSED:                          m57TOK_UNUSED,
+	MY_SQL_PARSER_UNDEF:                 m57TOK_UNUSED, // Not in MySQL 5.7
+	JSON_VALUE_SYM:                      m57TOK_UNUSED, // Not in MySQL 5.7
+	TLS_SYM:                             m57TOK_UNUSED, // Not in MySQL 5.7
+	ATTRIBUTE_SYM:                       m57TOK_UNUSED, // Not in MySQL 5.7
+	ENGINE_ATTRIBUTE_SYM:                m57TOK_UNUSED, // Not in MySQL 5.7
+	SECONDARY_ENGINE_ATTRIBUTE_SYM:      m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_CONNECTION_AUTO_FAILOVER_SYM: m57TOK_UNUSED, // Not in MySQL 5.7
+	ZONE_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	GRAMMAR_SELECTOR_DERIVED_EXPR:       m57TOK_UNUSED, // Not in MySQL 5.7
+	REPLICA_SYM:                         m57TOK_UNUSED, // Not in MySQL 5.7
+	REPLICAS_SYM:                        m57TOK_UNUSED, // Not in MySQL 5.7
+	ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_SYM: m57TOK_UNUSED, // Not in MySQL 5.7
+	GET_SOURCE_PUBLIC_KEY_SYM:                  m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_AUTO_POSITION_SYM:                   m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_BIND_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_COMPRESSION_ALGORITHM_SYM:           m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_CONNECT_RETRY_SYM:                   m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_DELAY_SYM:                           m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_HEARTBEAT_PERIOD_SYM:                m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_HOST_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_LOG_FILE_SYM:                        m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_LOG_POS_SYM:                         m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_PASSWORD_SYM:                        m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_PORT_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_PUBLIC_KEY_PATH_SYM:                 m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_RETRY_COUNT_SYM:                     m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_SSL_SYM:                             m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_SSL_CA_SYM:                          m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_SSL_CAPATH_SYM:                      m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_SSL_CERT_SYM:                        m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_SSL_CIPHER_SYM:                      m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_SSL_CRL_SYM:                         m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_SSL_CRLPATH_SYM:                     m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_SSL_KEY_SYM:                         m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_SSL_VERIFY_SERVER_CERT_SYM:          m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_TLS_CIPHERSUITES_SYM:                m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_TLS_VERSION_SYM:                     m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_USER_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	SOURCE_ZSTD_COMPRESSION_LEVEL_SYM:          m57TOK_UNUSED, // Not in MySQL 5.7
+	ST_COLLECT_SYM:                             m57TOK_UNUSED, // Not in MySQL 5.7
+	KEYRING_SYM:                                m57TOK_UNUSED, // Not in MySQL 5.7
+	AUTHENTICATION_SYM:                         m57TOK_UNUSED, // Not in MySQL 5.7
+	FACTOR_SYM:                                 m57TOK_UNUSED, // Not in MySQL 5.7
+	FINISH_SYM:                                 m57TOK_UNUSED, // Not in MySQL 5.7
+	INITIATE_SYM:                               m57TOK_UNUSED, // Not in MySQL 5.7
+	REGISTRATION_SYM:                           m57TOK_UNUSED, // Not in MySQL 5.7
+	UNREGISTER_SYM:                             m57TOK_UNUSED, // Not in MySQL 5.7
+	INITIAL_SYM:                                m57TOK_UNUSED, // Not in MySQL 5.7
+	CHALLENGE_RESPONSE_SYM:                     m57TOK_UNUSED, // Not in MySQL 5.7
+	GTID_ONLY_SYM:                              m57TOK_UNUSED, // Not in MySQL 5.7
+	INTERSECT_SYM:                              m57TOK_UNUSED, // Not in MySQL 5.7
+	BULK_SYM:                                   m57TOK_UNUSED, // Not in MySQL 5.7
+	URL_SYM:                                    m57TOK_UNUSED, // Not in MySQL 5.7
+	GENERATE_SYM:                               m57TOK_UNUSED, // Not in MySQL 5.7
+	DOLLAR_QUOTED_STRING_SYM:                   m57TOK_UNUSED, // Not in MySQL 5.7
+	PARSE_TREE_SYM:                             m57TOK_UNUSED, // Not in MySQL 5.7
+	LOG_SYM:                                    m57TOK_UNUSED, // Not in MySQL 5.7
+	GTIDS_SYM:                                  m57TOK_UNUSED, // Not in MySQL 5.7
+	PARALLEL_SYM:                               m57TOK_UNUSED, // Not in MySQL 5.7
+	S3_SYM:                                     m57TOK_UNUSED, // Not in MySQL 5.7
+	QUALIFY_SYM:                                m57TOK_UNUSED, // Not in MySQL 5.7
+	AUTO_SYM:                                   m57TOK_UNUSED, // Not in MySQL 5.7
+	MANUAL_SYM:                                 m57TOK_UNUSED, // Not in MySQL 5.7
+	BERNOULLI_SYM:                              m57TOK_UNUSED, // Not in MySQL 5.7
+	TABLESAMPLE_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	VECTOR_SYM:                                 m57TOK_UNUSED, // Not in MySQL 5.7
+	PARAMETERS_SYM:                             m57TOK_UNUSED, // Not in MySQL 5.7
+	HEADER_SYM:                                 m57TOK_UNUSED, // Not in MySQL 5.7
+	LIBRARY_SYM:                                m57TOK_UNUSED, // Not in MySQL 5.7
+	URI_SYM:                                    m57TOK_UNUSED, // Not in MySQL 5.7
+	DUALITY_SYM:                                m57TOK_UNUSED, // Not in MySQL 5.7
+	RELATIONAL_SYM:                             m57TOK_UNUSED, // Not in MySQL 5.7
+	JSON_DUALITY_OBJECT_SYM:                    m57TOK_UNUSED, // Not in MySQL 5.7
+	ABSENT_SYM:                                 m57TOK_UNUSED, // Not in MySQL 5.7
+	FILE_FORMAT_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	FILES_SYM:                                  m57TOK_UNUSED, // Not in MySQL 5.7
+	FILE_NAME_SYM:                              m57TOK_UNUSED, // Not in MySQL 5.7
+	FILE_PATTERN_SYM:                           m57TOK_UNUSED, // Not in MySQL 5.7
+	FILE_PREFIX_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	ALLOW_MISSING_FILES_SYM:                    m57TOK_UNUSED, // Not in MySQL 5.7
+	AUTO_REFRESH_SYM:                           m57TOK_UNUSED, // Not in MySQL 5.7
+	AUTO_REFRESH_SOURCE_SYM:                    m57TOK_UNUSED, // Not in MySQL 5.7
+	VERIFY_KEY_CONSTRAINTS_SYM:                 m57TOK_UNUSED, // Not in MySQL 5.7
+	STRICT_LOAD_SYM:                            m57TOK_UNUSED, // Not in MySQL 5.7
+	EXTERNAL_FORMAT_SYM:                        m57TOK_UNUSED, // Not in MySQL 5.7
+	EXTERNAL_SYM:                               m57TOK_UNUSED, // Not in MySQL 5.7
+	MATERIALIZED_SYM:                           m57TOK_UNUSED, // Not in MySQL 5.7
+	GUIDED_SYM:                                 m57TOK_UNUSED, // Not in MySQL 5.7
+	SETS_SYM:                                   m57TOK_UNUSED, // Not in MySQL 5.7
+	VALIDATE_SYM:                               m57TOK_UNUSED, // Not in MySQL 5.7
+	KEYWORD_USED_AS_IDENT:                      m57TOK_UNUSED, // Not in MySQL 5.7
+	KEYWORD_USED_AS_KEYWORD:                    m57TOK_UNUSED, // Not in MySQL 5.7
+	CONDITIONLESS_JOIN:                         m57TOK_UNUSED, // Not in MySQL 5.7
+	PREFER_PARENTHESES:                         m57TOK_UNUSED, // Not in MySQL 5.7
+	EMPTY_FROM_CLAUSE:                          m57TOK_UNUSED, // Not in MySQL 5.7
 }
@@ -0,0 +1,122 @@
+package internal
+
+import "strconv"
+
+// TraceKind is the kind of a TraceEvent.
+type TraceKind int
+
+const (
+	TraceLex    TraceKind = iota + 1 // the lexer returned a token
+	TracePush                        // a token was pushed on the digest stack
+	TraceReduce                      // a reducer rule folded the top of the stack
+)
+
+func (k TraceKind) String() string {
+	switch k {
+	case TraceLex:
+		return "lex"
+	case TracePush:
+		return "push"
+	case TraceReduce:
+		return "reduce"
+	}
+	return "unknown"
+}
+
+// Reducer rules, as named in TraceEvent.Rule.
+const (
+	RuleUnarySign = "unary sign"    // + or - absorbed into the number after it
+	RuleValueList = "value list"    // ?, ? -> ?, ...
+	RuleRow       = "row"           // ( ? ) -> (?), ( ?, ... ) -> (...)
+	RuleRowList   = "row list"      // (?), (?) -> (?) /* , ... */
+	RuleInList    = "IN list"       // IN (?) -> IN (...)
+	RuleSemicolon = "end semicolon" // a trailing ; is dropped
+)
+
+// TraceEvent is one step of a traced digest run.
+type TraceEvent struct {
+	Kind TraceKind
+	Type int    // the token lexed, pushed, or left by the reduction
+	Name string // its name in the token tables, e.g. "(num)" or "SELECT"
+
+	// TraceLex: where the token is in the input, and the states the lexer
+	// went through to read it, whitespace and comments before it included.
+	Start, End int
+	Text       string
+	States     []LexState
+
+	Rule   string   // TraceReduce: the rule that fired
+	Record []byte   // TracePush, TraceReduce: the bytes added to the hash input
+	Stack  []string // TracePush, TraceReduce: the digest stack afterwards
+}
+
+// Trace collects the events of a digest run.
+type Trace struct {
+	Events []TraceEvent
+}
+
+// TokenName returns the name of tok in the token tables, or its number for
+// tokens that have none, like END_OF_INPUT.
+func TokenName(tok int) string {
+	if s := TokenString(tok); s != "(unknown)" && s != "" {
+		return s
+	}
+	switch tok {
+	case END_OF_INPUT:
+		return "(end)"
+	case ABORT_SYM:
+		return "(abort)"
+	}
+	return "#" + strconv.Itoa(tok)
+}
+
+func (t *Trace) lex(l *Lexer, tok Token) {
+	e := TraceEvent{
+		Kind:   TraceLex,
+		Type:   tok.Type,
+		Name:   TokenName(tok.Type),
+		Start:  tok.Start,
+		End:    tok.End,
+		States: append([]LexState(nil), l.states...),
+	}
+	if text, err := l.TokenText(tok); err == nil {
+		e.Text = text
+	}
+	t.Events = append(t.Events, e)
+}
+
+// push records the token just pushed on s, whose hash record is the last
+// n bytes of the hash input.
+func (t *Trace) push(s *tokenStore, n int) {
+	t.Events = append(t.Events, t.stackEvent(TracePush, s, n))
+}
+
+// reduce records rule firing on s. A rule that leaves a token on the
+// stack added its 2 bytes to the hash input.
+func (t *Trace) reduce(rule string, s *tokenStore, replaced bool) {
+	n := 0
+	if replaced {
+		n = 2
+	}
+	e := t.stackEvent(TraceReduce, s, n)
+	e.Rule = rule
+	if !replaced {
+		e.Type, e.Name = 0, ""
+	}
+	t.Events = append(t.Events, e)
+}
+
+func (t *Trace) stackEvent(kind TraceKind, s *tokenStore, n int) TraceEvent {
+	e := TraceEvent{Kind: kind, Stack: make([]string, len(s.tokens))}
+	if len(s.tokens) > 0 {
+		e.Type = s.last()
+		e.Name = TokenName(e.Type)
+	}
+	if n > 0 {
+		e.Record = append([]byte(nil), s.tokenArray[len(s.tokenArray)-n:]...)
+	}
+	for i, tok := range s.Tokens() {
+		e.Stack[i] = tok.Text
+	}
+	return e
+}
@@ -9,3 +9,18 @@ const (
 	MySQL90
 	MySQL57
 )
+
+// String returns the release series, e.g. "8.0".
+func (v MySQLVersion) String() string {
+	switch v {
+	case MySQL80:
+		return "8.0"
+	case MySQL84:
+		return "8.4"
+	case MySQL90:
+		return "9.0"
+	case MySQL57:
+		return "5.7"
+	}
+	return "unknown"
+}
@@ -0,0 +1,42 @@
+package digest
+
+import "github.com/rashiq/mysql-digest/internal"
+
+// RedactOptions configures Redact. SQLMode and Version are taken from the
+// embedded Options, which decide how the statement is lexed.
+type RedactOptions struct {
+	Options
+
+	// StripComments drops comments other than optimizer hints and
+	// versioned /*! */ comments.
+	StripComments bool
+
+	// TypedPlaceholders writes ?int, ?dec, ?float, ?str, ?hex or ?bin,
+	// after the kind of literal, instead of ?.
+	TypedPlaceholders bool
+
+	// CollapseInLists shortens a list of two or more literals after IN to
+	// its first placeholder, as in IN (?, ...).
+	CollapseInLists bool
+}
+
+// Redact returns sql with every literal replaced by ?, and everything
+// else, spacing, case and comments included, as it was written:
+//
+//	Redact("select Name from users  where id = 42 -- lookup")
+//	// "select Name from users  where id = ? -- lookup"
+//
+// It is meant for logs that humans read; use Compute to group statements.
+// A statement that cannot be lexed gives an error and no text, so that an
+// unterminated literal never leaks.
+func Redact(sql string, opts ...RedactOptions) (string, error) {
+	var opt RedactOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	return internal.Redact(newLexer(sql, opt.Options), internal.RedactConfig{
+		StripComments:     opt.StripComments,
+		TypedPlaceholders: opt.TypedPlaceholders,
+		CollapseInLists:   opt.CollapseInLists,
+	})
+}
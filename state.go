@@ -0,0 +1,97 @@
+package digest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"unsafe"
+
+	"github.com/rashiq/mysql-digest/internal"
+)
+
+// maxPooledBuf is the largest result buffer kept for reuse.
+const maxPooledBuf = 64 * 1024
+
+// digestState is a reusable internal.State plus the buffer results are
+// built in.
+type digestState struct {
+	state *internal.State
+	buf   []byte
+}
+
+var statePool = sync.Pool{
+	New: func() any { return &digestState{state: internal.NewState()} },
+}
+
+func getState() *digestState {
+	return statePool.Get().(*digestState)
+}
+
+func putState(s *digestState) {
+	s.state.Reset("", MySQL80) // don't keep the statement alive
+	if cap(s.buf) > maxPooledBuf {
+		s.buf = nil
+	}
+	statePool.Put(s)
+}
+
+func (s *digestState) run(ctx context.Context, sql string, opt Options) error {
+	s.state.Reset(sql, opt.Version)
+	if opt.MaxInputBytes > 0 && len(sql) > opt.MaxInputBytes {
+		return &LimitError{Err: ErrInputTooLarge, Limit: opt.MaxInputBytes}
+	}
+	if err := s.configure(ctx, opt); err != nil {
+		return err
+	}
+	return s.state.Run()
+}
+
+// configure applies opt and ctx after a Reset.
+func (s *digestState) configure(ctx context.Context, opt Options) error {
+	configureLexer(s.state.Lexer(), opt)
+	s.state.SetLenient(opt.Lenient)
+	if ctx.Done() == nil {
+		ctx = nil // can never be done; skip the checks
+	}
+	s.state.SetLimits(ctx, opt.MaxTokens)
+	return s.state.SetLiteralMode(opt.LiteralMode, opt.LiteralKey, opt.MaxLength)
+}
+
+// result builds the Digest of the last run, which returned err. Hash and
+// Text share a single string, so this is the only allocation of a
+// computation.
+func (s *digestState) result(opt Options, err error) (Digest, error) {
+	store := s.state.Store()
+	s.buf = store.AppendHash(s.buf[:0])
+	n := len(s.buf)
+	s.buf = store.AppendText(s.buf, opt.MaxLength)
+	str := string(s.buf)
+	d := Digest{Hash: str[:n], Text: str[n:], version: opt.Version}
+	return d, lenientError(&d, err, opt)
+}
+
+// lenientError moves a syntax error into d and drops it if opt.Lenient
+// is set. Other errors are returned as they are.
+func lenientError(d *Digest, err error, opt Options) error {
+	if !truncated(err, opt) {
+		return err
+	}
+	d.Truncated, d.Err = true, err
+	return nil
+}
+
+// truncated reports whether err is a syntax error that opt.Lenient
+// digests up to.
+func truncated(err error, opt Options) bool {
+	if err == nil || !opt.Lenient {
+		return false
+	}
+	var syntaxErr *SyntaxError
+	return errors.As(err, &syntaxErr)
+}
+
+// bytesToString views b as a string without copying. The string must not
+// outlive the call it is passed to, since b may change afterwards.
+func bytesToString(b []byte) string {
+	return unsafe.String(unsafe.SliceData(b), len(b))
+}
@@ -0,0 +1,2 @@
+INSERT INTO events (id, kind, payload) VALUES (1, 'a', '{}'), (2, 'b', '{}')
+ON DUPLICATE KEY UPDATE payload = VALUES(payload)
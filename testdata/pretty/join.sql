@@ -0,0 +1,10 @@
+SELECT o.id, c.name, SUM(i.price * i.qty) AS total
+FROM orders o
+JOIN customers c ON c.id = o.customer_id AND c.active = 1
+LEFT OUTER JOIN items i ON i.order_id = o.id
+WHERE o.created_at BETWEEN '2024-01-01' AND '2024-02-01'
+  AND o.status IN ('paid', 'shipped') OR o.total > 100
+GROUP BY o.id, c.name
+HAVING SUM(i.qty) > 2
+ORDER BY total DESC
+LIMIT 10, 20
@@ -0,0 +1,2 @@
+SELECT * FROM (SELECT a, b FROM t WHERE c = 1) AS x
+WHERE x.a IN (SELECT a FROM u WHERE u.d = 'x' AND u.e = 2) AND x.b = (SELECT MAX(b) FROM v)
@@ -0,0 +1,2 @@
+WITH recent AS (SELECT id FROM logs WHERE ts > NOW() - INTERVAL 1 DAY)
+SELECT id FROM recent UNION ALL SELECT id FROM archive WHERE id = 5 FOR UPDATE
@@ -0,0 +1 @@
+UPDATE accounts a JOIN owners o ON o.id = a.owner_id SET a.balance = a.balance - 10, a.updated = NOW() WHERE o.email = 'x@example.com'
@@ -0,0 +1 @@
+SELECT first_name, last_name, email_address, phone_number, street_address, postal_code, country_code FROM customer_contact_details WHERE customer_identifier = 42
@@ -0,0 +1,59 @@
+package digest
+
+import (
+	"context"
+
+	"github.com/rashiq/mysql-digest/internal"
+)
+
+// TraceEvent is one step of a traced digest: a token returned by the
+// lexer, a token pushed on the digest stack with the bytes it adds to the
+// hash input, or a reduction of the top of the stack.
+type TraceEvent = internal.TraceEvent
+
+// TraceKind says which of the steps a TraceEvent is.
+type TraceKind = internal.TraceKind
+
+const (
+	TraceLex    = internal.TraceLex
+	TracePush   = internal.TracePush
+	TraceReduce = internal.TraceReduce
+)
+
+// Reduction rules, as named in TraceEvent.Rule.
+const (
+	RuleUnarySign = internal.RuleUnarySign
+	RuleValueList = internal.RuleValueList
+	RuleRow       = internal.RuleRow
+	RuleRowList   = internal.RuleRowList
+	RuleInList    = internal.RuleInList
+	RuleSemicolon = internal.RuleSemicolon
+)
+
+// Trace computes the digest of sql like Compute and returns every step
+// taken on the way, for finding out why a digest differs from the
+// server's. The events are returned along with an error, up to the step
+// that failed. Tracing is slow; it is meant for one statement at a time.
+func Trace(sql string, opts ...Options) (Digest, []TraceEvent, error) {
+	var opt Options
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	s := getState()
+	defer putState(s)
+
+	var t internal.Trace
+	s.state.Reset(sql, opt.Version)
+	if opt.MaxInputBytes > 0 && len(sql) > opt.MaxInputBytes {
+		return Digest{}, nil, &LimitError{Err: ErrInputTooLarge, Limit: opt.MaxInputBytes}
+	}
+	if err := s.configure(context.Background(), opt); err != nil {
+		return Digest{}, nil, err
+	}
+	s.state.SetTrace(&t)
+	d, err := s.result(opt, s.state.Run())
+	if err != nil {
+		return Digest{}, t.Events, err
+	}
+	return d, t.Events, nil
+}
@@ -0,0 +1,67 @@
+package digest
+
+import "github.com/rashiq/mysql-digest/internal"
+
+// AllVersions lists every supported MySQL version, oldest first.
+var AllVersions = []MySQLVersion{MySQL57, MySQL80, MySQL84, MySQL90}
+
+// ForVersions computes the digest of sql under each of versions, in the
+// same order. The statement is lexed once and its tokens replayed for every
+// version; only statements using version specific keywords or versioned
+// comments are lexed again. Options.Version is ignored.
+func ForVersions(sql string, versions []MySQLVersion, opts ...Options) ([]Digest, error) {
+	var opt Options
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	out := make([]Digest, len(versions))
+	if len(versions) == 0 {
+		return out, nil
+	}
+
+	if opt.MaxInputBytes > 0 && len(sql) > opt.MaxInputBytes {
+		return out, &LimitError{Err: ErrInputTooLarge, Limit: opt.MaxInputBytes}
+	}
+
+	opt.Version = versions[0]
+	rec := internal.Record(newLexer(sql, opt), opt.MaxTokens)
+
+	var firstErr error
+	for i, v := range versions {
+		var err error
+		if rec.VersionSensitive() && v != versions[0] {
+			o := opt
+			o.Version = v
+			out[i], err = compute(sql, o)
+		} else {
+			out[i], err = replay(rec, v, opt)
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return out, firstErr
+}
+
+// Translate returns the digests sql has under from and to, e.g. to map
+// digests stored by a 5.7 server to the ones 8.0 reports after an upgrade.
+func Translate(sql string, from, to MySQLVersion, opts ...Options) (Digest, Digest, error) {
+	ds, err := ForVersions(sql, []MySQLVersion{from, to}, opts...)
+	return ds[0], ds[1], err
+}
+
+func replay(rec *internal.Recording, version MySQLVersion, opt Options) (Digest, error) {
+	s := getState()
+	defer putState(s)
+
+	opt.Version = version
+	s.state.ResetReplay(rec, version)
+	s.state.SetLenient(opt.Lenient)
+	s.state.SetLimits(nil, opt.MaxTokens)
+	if err := s.state.SetLiteralMode(opt.LiteralMode, opt.LiteralKey, opt.MaxLength); err != nil {
+		return Digest{}, err
+	}
+	err := s.state.Run()
+
+	return s.result(opt, err)
+}